@@ -23,6 +23,16 @@ import (
 	"github.com/google/uuid"
 )
 
+// Endpoints returns the addresses of all instances of the collection module, i.e., its address followed by its
+// additional addresses
+func (cm *CollectionModule) Endpoints() (endpoints []string) {
+	if cm.GetAddress() != "" {
+		endpoints = append(endpoints, cm.Address)
+	}
+
+	return append(endpoints, cm.GetAdditionalAddresses()...)
+}
+
 // Validate validates the StartCollectingRequest
 func (req *StartCollectingRequest) Validate() error {
 	// Check service ID
@@ -57,6 +67,29 @@ func (req *StartCollectingRequest) Validate() error {
 	return nil
 }
 
+// Validate validates the TestCollectionRequest including its service configuration
+func (req *TestCollectionRequest) Validate() error {
+	err := checkServiceID(req.ServiceId)
+	if err != nil {
+		return fmt.Errorf("%s: %w", ErrRequestServiceID, err)
+	}
+
+	if req.Configuration == nil {
+		return ErrMissingServiceConfiguration
+	}
+
+	return req.Configuration.Validate()
+}
+
+// StartCollectingRequest returns the StartCollectingRequest for the service configuration to be tested. It does not
+// contain an evaluation manager, since no evidences are collected.
+func (req *TestCollectionRequest) StartCollectingRequest() *StartCollectingRequest {
+	return &StartCollectingRequest{
+		ServiceId:     req.ServiceId,
+		Configuration: req.Configuration,
+	}
+}
+
 // KubeConfig returns the file content of the kube config file
 func (req *StartCollectingRequest) KubeConfig() (config []byte, err error) {
 	if req.Configuration == nil || req.Configuration.RawConfiguration == nil {
@@ -29,6 +29,7 @@ import (
 	anypb "google.golang.org/protobuf/types/known/anypb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -40,6 +41,68 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type CollectionModule_Status int32
+
+const (
+	// The collection module has not been checked yet
+	CollectionModule_STATUS_UNSPECIFIED CollectionModule_Status = 0
+	// The collection module is reachable and supports the registered metrics
+	// and configuration type
+	CollectionModule_STATUS_HEALTHY CollectionModule_Status = 1
+	// The collection module is reachable, but its capabilities do not match
+	// the registration or could not be determined
+	CollectionModule_STATUS_DEGRADED CollectionModule_Status = 2
+	// The collection module is not reachable
+	CollectionModule_STATUS_UNREACHABLE CollectionModule_Status = 3
+	// The lease of a self-registered collection module has expired
+	CollectionModule_STATUS_UNAVAILABLE CollectionModule_Status = 4
+)
+
+// Enum value maps for CollectionModule_Status.
+var (
+	CollectionModule_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_HEALTHY",
+		2: "STATUS_DEGRADED",
+		3: "STATUS_UNREACHABLE",
+		4: "STATUS_UNAVAILABLE",
+	}
+	CollectionModule_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_HEALTHY":     1,
+		"STATUS_DEGRADED":    2,
+		"STATUS_UNREACHABLE": 3,
+		"STATUS_UNAVAILABLE": 4,
+	}
+)
+
+func (x CollectionModule_Status) Enum() *CollectionModule_Status {
+	p := new(CollectionModule_Status)
+	*p = x
+	return p
+}
+
+func (x CollectionModule_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CollectionModule_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_collection_collection_proto_enumTypes[0].Descriptor()
+}
+
+func (CollectionModule_Status) Type() protoreflect.EnumType {
+	return &file_api_collection_collection_proto_enumTypes[0]
+}
+
+func (x CollectionModule_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CollectionModule_Status.Descriptor instead.
+func (CollectionModule_Status) EnumDescriptor() ([]byte, []int) {
+	return file_api_collection_collection_proto_rawDescGZIP(), []int{8, 0}
+}
+
 type ServiceConfiguration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -57,6 +120,9 @@ type ServiceConfiguration struct {
 	// The raw configuration value. This is most likely a JSON or YAML
 	// representation of a config file specific to a collection module.
 	RawConfiguration *anypb.Any `protobuf:"bytes,2,opt,name=raw_configuration,json=rawConfiguration,proto3" json:"raw_configuration,omitempty" gorm:"serializer:anypb"`
+	// The tenant, i.e., the organisation, which the cloud service belongs to. It
+	// is set by the requirements manager.
+	TenantId string `protobuf:"bytes,8,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
 }
 
 func (x *ServiceConfiguration) Reset() {
@@ -112,6 +178,13 @@ func (x *ServiceConfiguration) GetRawConfiguration() *anypb.Any {
 	return nil
 }
 
+func (x *ServiceConfiguration) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
 type StartCollectingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -124,6 +197,12 @@ type StartCollectingRequest struct {
 	// A service configuration, which is specific to the collection module where
 	// this request is sent to.
 	Configuration *ServiceConfiguration `protobuf:"bytes,10,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	// The tenant of the service. It needs to be set in all evidences of the
+	// service.
+	TenantId string `protobuf:"bytes,11,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// Optional. The ID of the collection run of the Requirements Manager. It is
+	// set in all evidences of the run, so that they are counted for this run.
+	RunId string `protobuf:"bytes,12,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 }
 
 func (x *StartCollectingRequest) Reset() {
@@ -179,6 +258,20 @@ func (x *StartCollectingRequest) GetConfiguration() *ServiceConfiguration {
 	return nil
 }
 
+func (x *StartCollectingRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *StartCollectingRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
 type StartCollectingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -226,6 +319,112 @@ func (x *StartCollectingResponse) GetId() string {
 	return ""
 }
 
+type TestCollectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The service the configuration is tested for
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// The service configuration to test. It is not stored.
+	Configuration *ServiceConfiguration `protobuf:"bytes,2,opt,name=configuration,proto3" json:"configuration,omitempty"`
+}
+
+func (x *TestCollectionRequest) Reset() {
+	*x = TestCollectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_collection_collection_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestCollectionRequest) ProtoMessage() {}
+
+func (x *TestCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_collection_collection_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestCollectionRequest.ProtoReflect.Descriptor instead.
+func (*TestCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_api_collection_collection_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TestCollectionRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *TestCollectionRequest) GetConfiguration() *ServiceConfiguration {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+type TestCollectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The checks that have been performed successfully, e.g., which endpoints
+	// have been reached
+	Checks []string `protobuf:"bytes,1,rep,name=checks,proto3" json:"checks,omitempty"`
+}
+
+func (x *TestCollectionResponse) Reset() {
+	*x = TestCollectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_collection_collection_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestCollectionResponse) ProtoMessage() {}
+
+func (x *TestCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_collection_collection_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestCollectionResponse.ProtoReflect.Descriptor instead.
+func (*TestCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_api_collection_collection_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TestCollectionResponse) GetChecks() []string {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
 type StopCollectingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -237,7 +436,7 @@ type StopCollectingRequest struct {
 func (x *StopCollectingRequest) Reset() {
 	*x = StopCollectingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_collection_collection_proto_msgTypes[3]
+		mi := &file_api_collection_collection_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -250,7 +449,7 @@ func (x *StopCollectingRequest) String() string {
 func (*StopCollectingRequest) ProtoMessage() {}
 
 func (x *StopCollectingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_collection_collection_proto_msgTypes[3]
+	mi := &file_api_collection_collection_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -263,7 +462,7 @@ func (x *StopCollectingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopCollectingRequest.ProtoReflect.Descriptor instead.
 func (*StopCollectingRequest) Descriptor() ([]byte, []int) {
-	return file_api_collection_collection_proto_rawDescGZIP(), []int{3}
+	return file_api_collection_collection_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *StopCollectingRequest) GetId() string {
@@ -273,6 +472,112 @@ func (x *StopCollectingRequest) GetId() string {
 	return ""
 }
 
+type DescribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DescribeRequest) Reset() {
+	*x = DescribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_collection_collection_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeRequest) ProtoMessage() {}
+
+func (x *DescribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_collection_collection_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeRequest.ProtoReflect.Descriptor instead.
+func (*DescribeRequest) Descriptor() ([]byte, []int) {
+	return file_api_collection_collection_proto_rawDescGZIP(), []int{6}
+}
+
+type DescribeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// metric_ids contains the IDs of the metrics that this collection module
+	// can gather evidences for
+	MetricIds []string `protobuf:"bytes,1,rep,name=metric_ids,json=metricIds,proto3" json:"metric_ids,omitempty"`
+	// The type URL of the protobuf message that is used to configure this
+	// collection module
+	ConfigMessageTypeUrl string `protobuf:"bytes,2,opt,name=config_message_type_url,json=configMessageTypeUrl,proto3" json:"config_message_type_url,omitempty"`
+	// The version of the collection module
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *DescribeResponse) Reset() {
+	*x = DescribeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_collection_collection_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeResponse) ProtoMessage() {}
+
+func (x *DescribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_collection_collection_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeResponse.ProtoReflect.Descriptor instead.
+func (*DescribeResponse) Descriptor() ([]byte, []int) {
+	return file_api_collection_collection_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DescribeResponse) GetMetricIds() []string {
+	if x != nil {
+		return x.MetricIds
+	}
+	return nil
+}
+
+func (x *DescribeResponse) GetConfigMessageTypeUrl() string {
+	if x != nil {
+		return x.ConfigMessageTypeUrl
+	}
+	return ""
+}
+
+func (x *DescribeResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
 // A resource representing a collection module which collects technical
 // evidences
 type CollectionModule struct {
@@ -292,12 +597,32 @@ type CollectionModule struct {
 	// this collection module and that needs to be sent with each
 	// StartCollectingRequest's ServiceConfiguration.
 	ConfigMessageTypeUrl string `protobuf:"bytes,6,opt,name=config_message_type_url,json=configMessageTypeUrl,proto3" json:"config_message_type_url,omitempty"`
+	// status contains the result of the last health check of the Requirements
+	// Manager. It is not persisted, but determined at runtime.
+	Status CollectionModule_Status `protobuf:"varint,7,opt,name=status,proto3,enum=cam.CollectionModule_Status" json:"status,omitempty" gorm:"-"`
+	// status_message describes why a collection module is degraded or
+	// unreachable
+	StatusMessage string `protobuf:"bytes,8,opt,name=status_message,json=statusMessage,proto3" json:"status_message,omitempty" gorm:"-"`
+	// version contains the version reported by the collection module
+	Version string `protobuf:"bytes,9,opt,name=version,proto3" json:"version,omitempty" gorm:"-"`
+	// last_health_check contains the time of the last health check
+	LastHealthCheck *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_health_check,json=lastHealthCheck,proto3" json:"last_health_check,omitempty" gorm:"-"`
+	// lease_expires_at contains the time at which the lease of a self-registered
+	// collection module expires. It is empty for collection modules that are
+	// added by AddCollectionModule.
+	LeaseExpiresAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=lease_expires_at,json=leaseExpiresAt,proto3" json:"lease_expires_at,omitempty" gorm:"-"`
+	// additional_addresses contains the addresses of further instances of the
+	// collection module, e.g., if it is scaled to several replicas. Cloud
+	// services are distributed across all instances (address and
+	// additional_addresses) using consistent hashing. If an instance is
+	// unreachable, another instance is used.
+	AdditionalAddresses []string `protobuf:"bytes,12,rep,name=additional_addresses,json=additionalAddresses,proto3" json:"additional_addresses,omitempty" gorm:"serializer:json"`
 }
 
 func (x *CollectionModule) Reset() {
 	*x = CollectionModule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_collection_collection_proto_msgTypes[4]
+		mi := &file_api_collection_collection_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -310,7 +635,7 @@ func (x *CollectionModule) String() string {
 func (*CollectionModule) ProtoMessage() {}
 
 func (x *CollectionModule) ProtoReflect() protoreflect.Message {
-	mi := &file_api_collection_collection_proto_msgTypes[4]
+	mi := &file_api_collection_collection_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -323,7 +648,7 @@ func (x *CollectionModule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollectionModule.ProtoReflect.Descriptor instead.
 func (*CollectionModule) Descriptor() ([]byte, []int) {
-	return file_api_collection_collection_proto_rawDescGZIP(), []int{4}
+	return file_api_collection_collection_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CollectionModule) GetId() string {
@@ -368,6 +693,48 @@ func (x *CollectionModule) GetConfigMessageTypeUrl() string {
 	return ""
 }
 
+func (x *CollectionModule) GetStatus() CollectionModule_Status {
+	if x != nil {
+		return x.Status
+	}
+	return CollectionModule_STATUS_UNSPECIFIED
+}
+
+func (x *CollectionModule) GetStatusMessage() string {
+	if x != nil {
+		return x.StatusMessage
+	}
+	return ""
+}
+
+func (x *CollectionModule) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *CollectionModule) GetLastHealthCheck() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastHealthCheck
+	}
+	return nil
+}
+
+func (x *CollectionModule) GetLeaseExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LeaseExpiresAt
+	}
+	return nil
+}
+
+func (x *CollectionModule) GetAdditionalAddresses() []string {
+	if x != nil {
+		return x.AdditionalAddresses
+	}
+	return nil
+}
+
 type CommunicationSecurityConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -379,7 +746,7 @@ type CommunicationSecurityConfig struct {
 func (x *CommunicationSecurityConfig) Reset() {
 	*x = CommunicationSecurityConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_collection_collection_proto_msgTypes[5]
+		mi := &file_api_collection_collection_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -392,7 +759,7 @@ func (x *CommunicationSecurityConfig) String() string {
 func (*CommunicationSecurityConfig) ProtoMessage() {}
 
 func (x *CommunicationSecurityConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_api_collection_collection_proto_msgTypes[5]
+	mi := &file_api_collection_collection_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -405,7 +772,7 @@ func (x *CommunicationSecurityConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommunicationSecurityConfig.ProtoReflect.Descriptor instead.
 func (*CommunicationSecurityConfig) Descriptor() ([]byte, []int) {
-	return file_api_collection_collection_proto_rawDescGZIP(), []int{5}
+	return file_api_collection_collection_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CommunicationSecurityConfig) GetEndpoint() string {
@@ -437,7 +804,7 @@ type AuthenticationSecurityConfig struct {
 func (x *AuthenticationSecurityConfig) Reset() {
 	*x = AuthenticationSecurityConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_collection_collection_proto_msgTypes[6]
+		mi := &file_api_collection_collection_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -450,7 +817,7 @@ func (x *AuthenticationSecurityConfig) String() string {
 func (*AuthenticationSecurityConfig) ProtoMessage() {}
 
 func (x *AuthenticationSecurityConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_api_collection_collection_proto_msgTypes[6]
+	mi := &file_api_collection_collection_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -463,7 +830,7 @@ func (x *AuthenticationSecurityConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthenticationSecurityConfig.ProtoReflect.Descriptor instead.
 func (*AuthenticationSecurityConfig) Descriptor() ([]byte, []int) {
-	return file_api_collection_collection_proto_rawDescGZIP(), []int{6}
+	return file_api_collection_collection_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *AuthenticationSecurityConfig) GetIssuer() string {
@@ -513,14 +880,22 @@ type RemoteIntegrityConfig struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// A single target (host:port of the attestation interface). Kept for
+	// backwards compatibility, use targets instead.
 	Target      string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
 	Certificate string `protobuf:"bytes,2,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	// A list of targets (host:port of the attestation interface) that are
+	// attested under the cloud service.
+	Targets []string `protobuf:"bytes,3,rep,name=targets,proto3" json:"targets,omitempty"`
+	// Optional. Discover additional targets from the virtual machines reported by
+	// the workload collection module.
+	Discovery *TargetDiscovery `protobuf:"bytes,4,opt,name=discovery,proto3" json:"discovery,omitempty"`
 }
 
 func (x *RemoteIntegrityConfig) Reset() {
 	*x = RemoteIntegrityConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_collection_collection_proto_msgTypes[7]
+		mi := &file_api_collection_collection_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -533,7 +908,7 @@ func (x *RemoteIntegrityConfig) String() string {
 func (*RemoteIntegrityConfig) ProtoMessage() {}
 
 func (x *RemoteIntegrityConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_api_collection_collection_proto_msgTypes[7]
+	mi := &file_api_collection_collection_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -546,7 +921,7 @@ func (x *RemoteIntegrityConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoteIntegrityConfig.ProtoReflect.Descriptor instead.
 func (*RemoteIntegrityConfig) Descriptor() ([]byte, []int) {
-	return file_api_collection_collection_proto_rawDescGZIP(), []int{7}
+	return file_api_collection_collection_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *RemoteIntegrityConfig) GetTarget() string {
@@ -563,6 +938,220 @@ func (x *RemoteIntegrityConfig) GetCertificate() string {
 	return ""
 }
 
+func (x *RemoteIntegrityConfig) GetTargets() []string {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *RemoteIntegrityConfig) GetDiscovery() *TargetDiscovery {
+	if x != nil {
+		return x.Discovery
+	}
+	return nil
+}
+
+type TargetDiscovery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Port of the attestation interface on the discovered virtual machines
+	Port uint32 `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+}
+
+func (x *TargetDiscovery) Reset() {
+	*x = TargetDiscovery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_collection_collection_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TargetDiscovery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetDiscovery) ProtoMessage() {}
+
+func (x *TargetDiscovery) ProtoReflect() protoreflect.Message {
+	mi := &file_api_collection_collection_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetDiscovery.ProtoReflect.Descriptor instead.
+func (*TargetDiscovery) Descriptor() ([]byte, []int) {
+	return file_api_collection_collection_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TargetDiscovery) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+// A resource representing the configuration for the Cyber Security
+// Certification Collection Module
+type CyberSecurityCertificationConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required. The identifier of the provider whose certificates are checked.
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Optional. The certificate registry, either a local file path or an
+	// HTTP(S) URL. If empty, the default registry of the collection module is
+	// used.
+	Registry string `protobuf:"bytes,2,opt,name=registry,proto3" json:"registry,omitempty"`
+	// Optional. Signed certificate documents (JWS compact serialization)
+	// declared by the provider in addition to the registry entries.
+	CertificateDocuments []string `protobuf:"bytes,3,rep,name=certificate_documents,json=certificateDocuments,proto3" json:"certificate_documents,omitempty"`
+	// Optional. The scope (e.g. services or locations) that must be covered by a
+	// certificate.
+	Scope []string `protobuf:"bytes,4,rep,name=scope,proto3" json:"scope,omitempty"`
+	// Optional. The accepted certification schemes (ISO27001, C5, EUCS). If
+	// empty, all schemes are accepted.
+	Schemes []string `protobuf:"bytes,5,rep,name=schemes,proto3" json:"schemes,omitempty"`
+}
+
+func (x *CyberSecurityCertificationConfig) Reset() {
+	*x = CyberSecurityCertificationConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_collection_collection_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CyberSecurityCertificationConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CyberSecurityCertificationConfig) ProtoMessage() {}
+
+func (x *CyberSecurityCertificationConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_collection_collection_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CyberSecurityCertificationConfig.ProtoReflect.Descriptor instead.
+func (*CyberSecurityCertificationConfig) Descriptor() ([]byte, []int) {
+	return file_api_collection_collection_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CyberSecurityCertificationConfig) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *CyberSecurityCertificationConfig) GetRegistry() string {
+	if x != nil {
+		return x.Registry
+	}
+	return ""
+}
+
+func (x *CyberSecurityCertificationConfig) GetCertificateDocuments() []string {
+	if x != nil {
+		return x.CertificateDocuments
+	}
+	return nil
+}
+
+func (x *CyberSecurityCertificationConfig) GetScope() []string {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+func (x *CyberSecurityCertificationConfig) GetSchemes() []string {
+	if x != nil {
+		return x.Schemes
+	}
+	return nil
+}
+
+// A resource representing the configuration for the Gaia-X Self-Description
+// Collection Module
+type GaiaXSelfDescriptionConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required. The URL of the self-description of the service, either a
+	// verifiable presentation or a single verifiable credential.
+	SelfDescription string `protobuf:"bytes,1,opt,name=self_description,json=selfDescription,proto3" json:"self_description,omitempty"`
+	// Optional. The DIDs of the trusted issuers of the credentials. If empty,
+	// every issuer whose DID can be resolved is accepted.
+	TrustedIssuers []string `protobuf:"bytes,2,rep,name=trusted_issuers,json=trustedIssuers,proto3" json:"trusted_issuers,omitempty"`
+}
+
+func (x *GaiaXSelfDescriptionConfig) Reset() {
+	*x = GaiaXSelfDescriptionConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_collection_collection_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GaiaXSelfDescriptionConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GaiaXSelfDescriptionConfig) ProtoMessage() {}
+
+func (x *GaiaXSelfDescriptionConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_collection_collection_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GaiaXSelfDescriptionConfig.ProtoReflect.Descriptor instead.
+func (*GaiaXSelfDescriptionConfig) Descriptor() ([]byte, []int) {
+	return file_api_collection_collection_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GaiaXSelfDescriptionConfig) GetSelfDescription() string {
+	if x != nil {
+		return x.SelfDescription
+	}
+	return ""
+}
+
+func (x *GaiaXSelfDescriptionConfig) GetTrustedIssuers() []string {
+	if x != nil {
+		return x.TrustedIssuers
+	}
+	return nil
+}
+
 type WorkloadSecurityConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -578,7 +1167,7 @@ type WorkloadSecurityConfig struct {
 func (x *WorkloadSecurityConfig) Reset() {
 	*x = WorkloadSecurityConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_collection_collection_proto_msgTypes[8]
+		mi := &file_api_collection_collection_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -591,7 +1180,7 @@ func (x *WorkloadSecurityConfig) String() string {
 func (*WorkloadSecurityConfig) ProtoMessage() {}
 
 func (x *WorkloadSecurityConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_api_collection_collection_proto_msgTypes[8]
+	mi := &file_api_collection_collection_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -604,7 +1193,7 @@ func (x *WorkloadSecurityConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkloadSecurityConfig.ProtoReflect.Descriptor instead.
 func (*WorkloadSecurityConfig) Descriptor() ([]byte, []int) {
-	return file_api_collection_collection_proto_rawDescGZIP(), []int{8}
+	return file_api_collection_collection_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *WorkloadSecurityConfig) GetOpenstack() *structpb.Value {
@@ -640,56 +1229,117 @@ var file_api_collection_collection_proto_rawDesc = []byte{
 	0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
-	0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x74, 0x61, 0x67, 0x67, 0x65,
-	0x72, 0x2f, 0x74, 0x61, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe1,
-	0x01, 0x0a, 0x14, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0x9a, 0x84, 0x9e,
-	0x03, 0x11, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b,
-	0x65, 0x79, 0x22, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x31,
-	0x0a, 0x08, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x16, 0x9a, 0x84, 0x9e, 0x03, 0x11, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x70, 0x72, 0x69,
-	0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x52, 0x07, 0x74, 0x79, 0x70, 0x65, 0x55, 0x72,
-	0x6c, 0x12, 0x5f, 0x0a, 0x11, 0x72, 0x61, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41,
-	0x6e, 0x79, 0x42, 0x1c, 0x9a, 0x84, 0x9e, 0x03, 0x17, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73,
-	0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a, 0x61, 0x6e, 0x79, 0x70, 0x62, 0x22,
-	0x52, 0x10, 0x72, 0x61, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x9b, 0x01, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
-	0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
-	0x65, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x65, 0x76, 0x61, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12,
-	0x3f, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x29, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x27, 0x0a, 0x15, 0x53,
-	0x74, 0x6f, 0x70, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0xa4, 0x02, 0x0a, 0x10, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x79, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x42, 0x4c, 0x9a, 0x84, 0x9e, 0x03, 0x47, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22,
-	0x6d, 0x61, 0x6e, 0x79, 0x32, 0x6d, 0x61, 0x6e, 0x79, 0x3a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x73, 0x3b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x3a, 0x4f,
-	0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x43, 0x41, 0x53, 0x43, 0x41, 0x44, 0x45, 0x3b,
-	0x22, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x35, 0x0a, 0x17, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x55, 0x72, 0x6c, 0x22, 0x39, 0x0a, 0x1b, 0x43,
+	0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x74, 0x61, 0x67, 0x67,
+	0x65, 0x72, 0x2f, 0x74, 0x61, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xfe, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0x9a, 0x84,
+	0x9e, 0x03, 0x11, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
+	0x4b, 0x65, 0x79, 0x22, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x31, 0x0a, 0x08, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x16, 0x9a, 0x84, 0x9e, 0x03, 0x11, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x70, 0x72,
+	0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x52, 0x07, 0x74, 0x79, 0x70, 0x65, 0x55,
+	0x72, 0x6c, 0x12, 0x5f, 0x0a, 0x11, 0x72, 0x61, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x41, 0x6e, 0x79, 0x42, 0x1c, 0x9a, 0x84, 0x9e, 0x03, 0x17, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22,
+	0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a, 0x61, 0x6e, 0x79, 0x70, 0x62,
+	0x22, 0x52, 0x10, 0x72, 0x61, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64,
+	0x22, 0xcf, 0x01, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x76,
+	0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x65, 0x76, 0x61, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x3f, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x72,
+	0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6e,
+	0x49, 0x64, 0x22, 0x29, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x77, 0x0a,
+	0x15, 0x54, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x61, 0x6d, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x30, 0x0a, 0x16, 0x54, 0x65, 0x73, 0x74, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x22, 0x27, 0x0a, 0x15, 0x53, 0x74, 0x6f, 0x70,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x11, 0x0a, 0x0f, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x82, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x49, 0x64, 0x73, 0x12, 0x35, 0x0a, 0x17, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x55, 0x72, 0x6c, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xbf, 0x06, 0x0a, 0x10, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x79, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x69, 0x74, 0x6f,
+	0x72, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x42, 0x4c, 0x9a, 0x84, 0x9e, 0x03, 0x47, 0x67,
+	0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x6d, 0x61, 0x6e, 0x79, 0x32, 0x6d, 0x61, 0x6e, 0x79, 0x3a, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x3b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x74, 0x3a, 0x4f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x43, 0x41, 0x53,
+	0x43, 0x41, 0x44, 0x45, 0x3b, 0x22, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x35, 0x0a, 0x17, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x55, 0x72, 0x6c,
+	0x12, 0x43, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1c, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0d,
+	0x9a, 0x84, 0x9e, 0x03, 0x08, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x2d, 0x22, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x9a,
+	0x84, 0x9e, 0x03, 0x08, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x2d, 0x22, 0x52, 0x0d, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x9a, 0x84,
+	0x9e, 0x03, 0x08, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x2d, 0x22, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x55, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0x9a, 0x84, 0x9e,
+	0x03, 0x08, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x2d, 0x22, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x53, 0x0a, 0x10, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x42, 0x0d, 0x9a, 0x84, 0x9e, 0x03, 0x08, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x2d, 0x22,
+	0x52, 0x0e, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x12, 0x4e, 0x0a, 0x14, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1b,
+	0x9a, 0x84, 0x9e, 0x03, 0x16, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x52, 0x13, 0x61, 0x64, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x22, 0x79, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x48, 0x45, 0x41,
+	0x4c, 0x54, 0x48, 0x59, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x44, 0x45, 0x47, 0x52, 0x41, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x52, 0x45, 0x41, 0x43, 0x48, 0x41, 0x42, 0x4c,
+	0x45, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e,
+	0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x04, 0x22, 0x39, 0x0a, 0x1b, 0x43,
 	0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x75,
 	0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e,
 	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e,
@@ -707,44 +1357,79 @@ var file_api_collection_collection_proto_rawDesc = []byte{
 	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x05, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65,
 	0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22, 0x51, 0x0a, 0x15, 0x52, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65,
-	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0xb0, 0x01, 0x0a,
-	0x16, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
-	0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x34, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x73,
-	0x74, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x36, 0x0a,
-	0x0a, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x6b, 0x75, 0x62, 0x65, 0x72,
-	0x6e, 0x65, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x03, 0x61, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x61, 0x77, 0x73, 0x32,
-	0xf0, 0x01, 0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4c,
-	0x0a, 0x0f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6e,
-	0x67, 0x12, 0x1b, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
-	0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0e,
-	0x53, 0x74, 0x6f, 0x70, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1a,
-	0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x4e, 0x0a, 0x15, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1b, 0x2e, 0x63, 0x61,
+	0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x15, 0x52, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x61, 0x6d,
+	0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x52, 0x09, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x22, 0x25, 0x0a, 0x0f, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x22, 0xbf, 0x01, 0x0a, 0x20, 0x43, 0x79, 0x62, 0x65, 0x72, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12,
+	0x33, 0x0a, 0x15, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14,
+	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x65, 0x73, 0x22, 0x70, 0x0a, 0x1a, 0x47, 0x61, 0x69, 0x61, 0x58, 0x53, 0x65, 0x6c,
+	0x66, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x65,
+	0x6c, 0x66, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a,
+	0x0f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x72, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x16, 0x57, 0x6f, 0x72, 0x6b, 0x6c,
+	0x6f, 0x61, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x34, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x6f, 0x70,
+	0x65, 0x6e, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x36, 0x0a, 0x0a, 0x6b, 0x75, 0x62, 0x65, 0x72,
+	0x6e, 0x65, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0a, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x12,
+	0x28, 0x0a, 0x03, 0x61, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x61, 0x77, 0x73, 0x32, 0xf4, 0x02, 0x0a, 0x0a, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x2e, 0x63, 0x61,
 	0x6d, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x28, 0x01, 0x42, 0x4e, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x67, 0x61, 0x69, 0x61, 0x2d, 0x78, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x2d, 0x69, 0x6e, 0x66,
-	0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2d, 0x66, 0x65, 0x64, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f,
-	0x63, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x70, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x53,
+	0x74, 0x6f, 0x70, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4e, 0x0a, 0x15,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1b, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x28, 0x01, 0x12, 0x37, 0x0a, 0x08,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x14, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x54, 0x65,
+	0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x4e, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67,
+	0x61, 0x69, 0x61, 0x2d, 0x78, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x2d, 0x69, 0x6e, 0x66, 0x72, 0x61,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2d, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x63, 0x61,
+	0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -759,40 +1444,59 @@ func file_api_collection_collection_proto_rawDescGZIP() []byte {
 	return file_api_collection_collection_proto_rawDescData
 }
 
-var file_api_collection_collection_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_collection_collection_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_collection_collection_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_api_collection_collection_proto_goTypes = []interface{}{
-	(*ServiceConfiguration)(nil),         // 0: cam.ServiceConfiguration
-	(*StartCollectingRequest)(nil),       // 1: cam.StartCollectingRequest
-	(*StartCollectingResponse)(nil),      // 2: cam.StartCollectingResponse
-	(*StopCollectingRequest)(nil),        // 3: cam.StopCollectingRequest
-	(*CollectionModule)(nil),             // 4: cam.CollectionModule
-	(*CommunicationSecurityConfig)(nil),  // 5: cam.CommunicationSecurityConfig
-	(*AuthenticationSecurityConfig)(nil), // 6: cam.AuthenticationSecurityConfig
-	(*RemoteIntegrityConfig)(nil),        // 7: cam.RemoteIntegrityConfig
-	(*WorkloadSecurityConfig)(nil),       // 8: cam.WorkloadSecurityConfig
-	(*anypb.Any)(nil),                    // 9: google.protobuf.Any
-	(*assessment.Metric)(nil),            // 10: clouditor.Metric
-	(*structpb.Value)(nil),               // 11: google.protobuf.Value
-	(*emptypb.Empty)(nil),                // 12: google.protobuf.Empty
+	(CollectionModule_Status)(0),             // 0: cam.CollectionModule.Status
+	(*ServiceConfiguration)(nil),             // 1: cam.ServiceConfiguration
+	(*StartCollectingRequest)(nil),           // 2: cam.StartCollectingRequest
+	(*StartCollectingResponse)(nil),          // 3: cam.StartCollectingResponse
+	(*TestCollectionRequest)(nil),            // 4: cam.TestCollectionRequest
+	(*TestCollectionResponse)(nil),           // 5: cam.TestCollectionResponse
+	(*StopCollectingRequest)(nil),            // 6: cam.StopCollectingRequest
+	(*DescribeRequest)(nil),                  // 7: cam.DescribeRequest
+	(*DescribeResponse)(nil),                 // 8: cam.DescribeResponse
+	(*CollectionModule)(nil),                 // 9: cam.CollectionModule
+	(*CommunicationSecurityConfig)(nil),      // 10: cam.CommunicationSecurityConfig
+	(*AuthenticationSecurityConfig)(nil),     // 11: cam.AuthenticationSecurityConfig
+	(*RemoteIntegrityConfig)(nil),            // 12: cam.RemoteIntegrityConfig
+	(*TargetDiscovery)(nil),                  // 13: cam.TargetDiscovery
+	(*CyberSecurityCertificationConfig)(nil), // 14: cam.CyberSecurityCertificationConfig
+	(*GaiaXSelfDescriptionConfig)(nil),       // 15: cam.GaiaXSelfDescriptionConfig
+	(*WorkloadSecurityConfig)(nil),           // 16: cam.WorkloadSecurityConfig
+	(*anypb.Any)(nil),                        // 17: google.protobuf.Any
+	(*assessment.Metric)(nil),                // 18: clouditor.Metric
+	(*timestamppb.Timestamp)(nil),            // 19: google.protobuf.Timestamp
+	(*structpb.Value)(nil),                   // 20: google.protobuf.Value
+	(*emptypb.Empty)(nil),                    // 21: google.protobuf.Empty
 }
 var file_api_collection_collection_proto_depIdxs = []int32{
-	9,  // 0: cam.ServiceConfiguration.raw_configuration:type_name -> google.protobuf.Any
-	0,  // 1: cam.StartCollectingRequest.configuration:type_name -> cam.ServiceConfiguration
-	10, // 2: cam.CollectionModule.metrics:type_name -> clouditor.Metric
-	11, // 3: cam.WorkloadSecurityConfig.openstack:type_name -> google.protobuf.Value
-	11, // 4: cam.WorkloadSecurityConfig.kubernetes:type_name -> google.protobuf.Value
-	11, // 5: cam.WorkloadSecurityConfig.aws:type_name -> google.protobuf.Value
-	1,  // 6: cam.Collection.StartCollecting:input_type -> cam.StartCollectingRequest
-	3,  // 7: cam.Collection.StopCollecting:input_type -> cam.StopCollectingRequest
-	1,  // 8: cam.Collection.StartCollectingStream:input_type -> cam.StartCollectingRequest
-	2,  // 9: cam.Collection.StartCollecting:output_type -> cam.StartCollectingResponse
-	12, // 10: cam.Collection.StopCollecting:output_type -> google.protobuf.Empty
-	12, // 11: cam.Collection.StartCollectingStream:output_type -> google.protobuf.Empty
-	9,  // [9:12] is the sub-list for method output_type
-	6,  // [6:9] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	17, // 0: cam.ServiceConfiguration.raw_configuration:type_name -> google.protobuf.Any
+	1,  // 1: cam.StartCollectingRequest.configuration:type_name -> cam.ServiceConfiguration
+	1,  // 2: cam.TestCollectionRequest.configuration:type_name -> cam.ServiceConfiguration
+	18, // 3: cam.CollectionModule.metrics:type_name -> clouditor.Metric
+	0,  // 4: cam.CollectionModule.status:type_name -> cam.CollectionModule.Status
+	19, // 5: cam.CollectionModule.last_health_check:type_name -> google.protobuf.Timestamp
+	19, // 6: cam.CollectionModule.lease_expires_at:type_name -> google.protobuf.Timestamp
+	13, // 7: cam.RemoteIntegrityConfig.discovery:type_name -> cam.TargetDiscovery
+	20, // 8: cam.WorkloadSecurityConfig.openstack:type_name -> google.protobuf.Value
+	20, // 9: cam.WorkloadSecurityConfig.kubernetes:type_name -> google.protobuf.Value
+	20, // 10: cam.WorkloadSecurityConfig.aws:type_name -> google.protobuf.Value
+	2,  // 11: cam.Collection.StartCollecting:input_type -> cam.StartCollectingRequest
+	6,  // 12: cam.Collection.StopCollecting:input_type -> cam.StopCollectingRequest
+	2,  // 13: cam.Collection.StartCollectingStream:input_type -> cam.StartCollectingRequest
+	7,  // 14: cam.Collection.Describe:input_type -> cam.DescribeRequest
+	4,  // 15: cam.Collection.TestCollection:input_type -> cam.TestCollectionRequest
+	3,  // 16: cam.Collection.StartCollecting:output_type -> cam.StartCollectingResponse
+	21, // 17: cam.Collection.StopCollecting:output_type -> google.protobuf.Empty
+	21, // 18: cam.Collection.StartCollectingStream:output_type -> google.protobuf.Empty
+	8,  // 19: cam.Collection.Describe:output_type -> cam.DescribeResponse
+	5,  // 20: cam.Collection.TestCollection:output_type -> cam.TestCollectionResponse
+	16, // [16:21] is the sub-list for method output_type
+	11, // [11:16] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_api_collection_collection_proto_init() }
@@ -838,7 +1542,7 @@ func file_api_collection_collection_proto_init() {
 			}
 		}
 		file_api_collection_collection_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopCollectingRequest); i {
+			switch v := v.(*TestCollectionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -850,7 +1554,7 @@ func file_api_collection_collection_proto_init() {
 			}
 		}
 		file_api_collection_collection_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CollectionModule); i {
+			switch v := v.(*TestCollectionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -862,7 +1566,7 @@ func file_api_collection_collection_proto_init() {
 			}
 		}
 		file_api_collection_collection_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommunicationSecurityConfig); i {
+			switch v := v.(*StopCollectingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -874,7 +1578,7 @@ func file_api_collection_collection_proto_init() {
 			}
 		}
 		file_api_collection_collection_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthenticationSecurityConfig); i {
+			switch v := v.(*DescribeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -886,7 +1590,7 @@ func file_api_collection_collection_proto_init() {
 			}
 		}
 		file_api_collection_collection_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoteIntegrityConfig); i {
+			switch v := v.(*DescribeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -898,6 +1602,90 @@ func file_api_collection_collection_proto_init() {
 			}
 		}
 		file_api_collection_collection_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionModule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_collection_collection_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommunicationSecurityConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_collection_collection_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthenticationSecurityConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_collection_collection_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoteIntegrityConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_collection_collection_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TargetDiscovery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_collection_collection_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CyberSecurityCertificationConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_collection_collection_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GaiaXSelfDescriptionConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_collection_collection_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WorkloadSecurityConfig); i {
 			case 0:
 				return &v.state
@@ -915,13 +1703,14 @@ func file_api_collection_collection_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_collection_collection_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      1,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_api_collection_collection_proto_goTypes,
 		DependencyIndexes: file_api_collection_collection_proto_depIdxs,
+		EnumInfos:         file_api_collection_collection_proto_enumTypes,
 		MessageInfos:      file_api_collection_collection_proto_msgTypes,
 	}.Build()
 	File_api_collection_collection_proto = out.File
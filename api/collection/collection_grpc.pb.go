@@ -29,6 +29,12 @@ type CollectionClient interface {
 	StopCollecting(ctx context.Context, in *StopCollectingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Set up a stream to a collection module for triggering multiple collections
 	StartCollectingStream(ctx context.Context, opts ...grpc.CallOption) (Collection_StartCollectingStreamClient, error)
+	// Describe returns the capabilities of a collection module. It is also used
+	// by the Requirements Manager to check the health of a collection module.
+	Describe(ctx context.Context, in *DescribeRequest, opts ...grpc.CallOption) (*DescribeResponse, error)
+	// Checks the service configuration and the connectivity to the cloud
+	// service without collecting any evidences (dry-run)
+	TestCollection(ctx context.Context, in *TestCollectionRequest, opts ...grpc.CallOption) (*TestCollectionResponse, error)
 }
 
 type collectionClient struct {
@@ -91,6 +97,24 @@ func (x *collectionStartCollectingStreamClient) CloseAndRecv() (*emptypb.Empty,
 	return m, nil
 }
 
+func (c *collectionClient) Describe(ctx context.Context, in *DescribeRequest, opts ...grpc.CallOption) (*DescribeResponse, error) {
+	out := new(DescribeResponse)
+	err := c.cc.Invoke(ctx, "/cam.Collection/Describe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionClient) TestCollection(ctx context.Context, in *TestCollectionRequest, opts ...grpc.CallOption) (*TestCollectionResponse, error) {
+	out := new(TestCollectionResponse)
+	err := c.cc.Invoke(ctx, "/cam.Collection/TestCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CollectionServer is the server API for Collection service.
 // All implementations must embed UnimplementedCollectionServer
 // for forward compatibility
@@ -101,6 +125,12 @@ type CollectionServer interface {
 	StopCollecting(context.Context, *StopCollectingRequest) (*emptypb.Empty, error)
 	// Set up a stream to a collection module for triggering multiple collections
 	StartCollectingStream(Collection_StartCollectingStreamServer) error
+	// Describe returns the capabilities of a collection module. It is also used
+	// by the Requirements Manager to check the health of a collection module.
+	Describe(context.Context, *DescribeRequest) (*DescribeResponse, error)
+	// Checks the service configuration and the connectivity to the cloud
+	// service without collecting any evidences (dry-run)
+	TestCollection(context.Context, *TestCollectionRequest) (*TestCollectionResponse, error)
 	mustEmbedUnimplementedCollectionServer()
 }
 
@@ -117,6 +147,12 @@ func (UnimplementedCollectionServer) StopCollecting(context.Context, *StopCollec
 func (UnimplementedCollectionServer) StartCollectingStream(Collection_StartCollectingStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method StartCollectingStream not implemented")
 }
+func (UnimplementedCollectionServer) Describe(context.Context, *DescribeRequest) (*DescribeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Describe not implemented")
+}
+func (UnimplementedCollectionServer) TestCollection(context.Context, *TestCollectionRequest) (*TestCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestCollection not implemented")
+}
 func (UnimplementedCollectionServer) mustEmbedUnimplementedCollectionServer() {}
 
 // UnsafeCollectionServer may be embedded to opt out of forward compatibility for this service.
@@ -192,6 +228,42 @@ func (x *collectionStartCollectingStreamServer) Recv() (*StartCollectingRequest,
 	return m, nil
 }
 
+func _Collection_Describe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServer).Describe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cam.Collection/Describe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServer).Describe(ctx, req.(*DescribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Collection_TestCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServer).TestCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cam.Collection/TestCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServer).TestCollection(ctx, req.(*TestCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Collection_ServiceDesc is the grpc.ServiceDesc for Collection service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -207,6 +279,14 @@ var Collection_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StopCollecting",
 			Handler:    _Collection_StopCollecting_Handler,
 		},
+		{
+			MethodName: "Describe",
+			Handler:    _Collection_Describe_Handler,
+		},
+		{
+			MethodName: "TestCollection",
+			Handler:    _Collection_TestCollection_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
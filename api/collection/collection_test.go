@@ -216,6 +216,8 @@ func Test_PersistCollectionModule(t *testing.T) {
 		Description: "Some Description",
 		Metrics:     []*assessment.Metric{metric},
 		Address:     "",
+		// Additional addresses are stored as JSON
+		AdditionalAddresses: []string{"cm-2:50054", "cm-3:50054"},
 	}
 
 	err = storage.Create(&cm)
@@ -28,6 +28,7 @@ var (
 	ErrMissingServiceConfiguration                  = errors.New("service configuration is missing")
 	ErrMissingRawConfiguration                      = errors.New("service configuration is missing")
 	ErrInvalidRemoteIntegrityRawConfiguration       = errors.New("no remote integrity raw configuration")
+	ErrMissingRemoteIntegrityTargets                = errors.New("no remote integrity targets configured or discovered")
 	ErrInvalidWorkloadConfigurationRawConfiguration = errors.New("no workload raw configuration")
 	ErrInvalidKubernetesServiceConfiguration        = errors.New("kubernetes service configuration is invalid")
 	ErrInvalidOpenstackServiceConfiguration         = errors.New("could not store openstack service configuration")
@@ -35,4 +36,12 @@ var (
 	ErrConversionProtobufToByteArray                = errors.New("could not convert protobuf value to byte array")
 	ErrKubernetesClientset                          = errors.New("could not get kubernetes clientset")
 	ErrConversionProtobufToAuthOptions              = errors.New("could not convert protobuf value to openstack.authOptions")
+	ErrInvalidServiceConfiguration                  = errors.New("service configuration is invalid")
+	ErrMissingConfigParameter                       = errors.New("missing required config parameter")
+	ErrInvalidURL                                   = errors.New("invalid URL")
+	ErrInvalidAddress                               = errors.New("invalid address")
+	ErrInvalidCertificate                           = errors.New("invalid PEM-encoded certificate")
+	ErrMissingWorkloadProvider                      = errors.New("neither kubernetes, openstack nor aws is configured")
+	ErrInvalidSecretRef                             = errors.New("invalid secret reference")
+	ErrSecretRefNotFound                            = errors.New("referenced secret not found")
 )
@@ -0,0 +1,145 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package collection
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// SecretRefPrefix marks a value of a service configuration as a reference to an external secret, which is resolved by
+// the collection module at collection time. The prefix is followed by the kind of the reference and its location:
+//
+//   - secretref:file:<absolute path>
+//   - secretref:env:<name of the environment variable>
+//   - secretref:k8s:<namespace>/<name of the secret>/<key>
+const SecretRefPrefix = "secretref:"
+
+// SecretRefKind is the kind of the store of an external secret
+type SecretRefKind string
+
+const (
+	// SecretRefFile references a file of the collection module, e.g., a mounted secret
+	SecretRefFile SecretRefKind = "file"
+	// SecretRefEnv references an environment variable of the collection module
+	SecretRefEnv SecretRefKind = "env"
+	// SecretRefKubernetes references a key of a Kubernetes Secret in the cluster of the collection module
+	SecretRefKubernetes SecretRefKind = "k8s"
+)
+
+// SecretRef is a reference to an external secret
+type SecretRef struct {
+	Kind SecretRefKind
+
+	// Path is the path of the file or the name of the environment variable
+	Path string
+
+	// Namespace, Name and Key identify the value of a Kubernetes Secret
+	Namespace string
+	Name      string
+	Key       string
+}
+
+// SecretRefHolder is implemented by the configuration messages of the collection modules, whose fields may reference
+// external secrets instead of containing them
+type SecretRefHolder interface {
+	// SecretRefFields returns pointers to the (set) values per field name, which may be references
+	SecretRefFields() map[string]*string
+}
+
+// SecretRefFields implements SecretRefHolder
+func (c *AuthenticationSecurityConfig) SecretRefFields() map[string]*string {
+	return c.SecretFields()
+}
+
+// SecretRefFields implements SecretRefHolder
+func (c *WorkloadSecurityConfig) SecretRefFields() map[string]*string {
+	return c.SecretFields()
+}
+
+// SecretRefFields implements SecretRefHolder. The certificate is not a secret, but can be kept outside of CAM as well.
+func (c *RemoteIntegrityConfig) SecretRefFields() map[string]*string {
+	fields := map[string]*string{}
+	if c.Certificate != "" {
+		fields["certificate"] = &c.Certificate
+	}
+
+	return fields
+}
+
+// IsSecretRef returns whether value is a reference to an external secret
+func IsSecretRef(value string) bool {
+	return strings.HasPrefix(value, SecretRefPrefix)
+}
+
+// ParseSecretRef parses a reference to an external secret
+func ParseSecretRef(value string) (ref *SecretRef, err error) {
+	kind, location, ok := strings.Cut(strings.TrimPrefix(value, SecretRefPrefix), ":")
+	if !IsSecretRef(value) || !ok || location == "" {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSecretRef, value)
+	}
+
+	ref = &SecretRef{Kind: SecretRefKind(kind)}
+
+	switch ref.Kind {
+	case SecretRefFile:
+		if !filepath.IsAbs(location) {
+			return nil, fmt.Errorf("%w: file path %s is not absolute", ErrInvalidSecretRef, location)
+		}
+		// Paths like /run/secrets/../../etc/passwd could escape the directories allowed by the collection modules
+		if filepath.Clean(location) != location {
+			return nil, fmt.Errorf("%w: file path %s is not clean", ErrInvalidSecretRef, location)
+		}
+		ref.Path = location
+	case SecretRefEnv:
+		ref.Path = location
+	case SecretRefKubernetes:
+		parts := strings.Split(location, "/")
+		if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+			return nil, fmt.Errorf("%w: %s is not <namespace>/<name>/<key>", ErrInvalidSecretRef, location)
+		}
+		ref.Namespace, ref.Name, ref.Key = parts[0], parts[1], parts[2]
+	default:
+		return nil, fmt.Errorf("%w: unknown kind %s", ErrInvalidSecretRef, kind)
+	}
+
+	return ref, nil
+}
+
+// String returns the reference in the format parsed by ParseSecretRef
+func (ref *SecretRef) String() string {
+	if ref.Kind == SecretRefKubernetes {
+		return fmt.Sprintf("%s%s:%s/%s/%s", SecretRefPrefix, ref.Kind, ref.Namespace, ref.Name, ref.Key)
+	}
+
+	return fmt.Sprintf("%s%s:%s", SecretRefPrefix, ref.Kind, ref.Path)
+}
+
+// checkSecretRefs checks the syntax of all references of the configuration message
+func checkSecretRefs(holder SecretRefHolder) error {
+	for name, value := range holder.SecretRefFields() {
+		if !IsSecretRef(*value) {
+			continue
+		}
+
+		if _, err := ParseSecretRef(*value); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eclipse-xfsc/cam/api/collection"
+)
+
+func TestParseSecretRef(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    *collection.SecretRef
+		wantErr bool
+	}{
+		{
+			name:  "File",
+			value: "secretref:file:/run/secrets/client-secret",
+			want:  &collection.SecretRef{Kind: collection.SecretRefFile, Path: "/run/secrets/client-secret"},
+		},
+		{
+			name:  "Environment variable",
+			value: "secretref:env:CLIENT_SECRET",
+			want:  &collection.SecretRef{Kind: collection.SecretRefEnv, Path: "CLIENT_SECRET"},
+		},
+		{
+			name:  "Kubernetes Secret",
+			value: "secretref:k8s:cam/workload/kubeconfig",
+			want: &collection.SecretRef{Kind: collection.SecretRefKubernetes, Namespace: "cam", Name: "workload",
+				Key: "kubeconfig"},
+		},
+		{
+			name:    "Relative file",
+			value:   "secretref:file:client-secret",
+			wantErr: true,
+		},
+		{
+			name:    "File path with parent directory",
+			value:   "secretref:file:/run/secrets/../../etc/passwd",
+			wantErr: true,
+		},
+		{
+			name:    "Incomplete Kubernetes Secret",
+			value:   "secretref:k8s:workload/kubeconfig",
+			wantErr: true,
+		},
+		{
+			name:    "Unknown kind",
+			value:   "secretref:vault:secret",
+			wantErr: true,
+		},
+		{
+			name:    "Missing location",
+			value:   "secretref:env",
+			wantErr: true,
+		},
+		{
+			name:    "No reference",
+			value:   "secret",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := collection.ParseSecretRef(tt.value)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, collection.ErrInvalidSecretRef)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.value, got.String())
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package collection
+
+import (
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// SecretHolder is implemented by the configuration messages of the collection modules, which contain secrets, e.g.,
+// credentials of the cloud service. The secrets are encrypted at rest and redacted in API responses.
+type SecretHolder interface {
+	// SecretFields returns pointers to the (set) secret values per field name, so that they can be replaced in place
+	SecretFields() map[string]*string
+}
+
+// SecretFields implements SecretHolder
+func (c *AuthenticationSecurityConfig) SecretFields() map[string]*string {
+	fields := map[string]*string{}
+	if c.ClientSecret != "" {
+		fields["client_secret"] = &c.ClientSecret
+	}
+
+	return fields
+}
+
+// SecretFields implements SecretHolder. The kube config contains the credentials of the cluster, so it is a secret
+// as a whole.
+func (c *WorkloadSecurityConfig) SecretFields() map[string]*string {
+	fields := map[string]*string{}
+	if s, ok := c.GetKubernetes().GetKind().(*structpb.Value_StringValue); ok && s.StringValue != "" {
+		fields["kubernetes"] = &s.StringValue
+	}
+
+	addStructSecrets(fields, "openstack", c.GetOpenstack(), "password")
+	addStructSecrets(fields, "aws", c.GetAws(), "secretAccessKey")
+
+	return fields
+}
+
+// addStructSecrets adds the string values of the given keys of a struct value to fields
+func addStructSecrets(fields map[string]*string, prefix string, value *structpb.Value, keys ...string) {
+	strct := value.GetStructValue()
+	if strct == nil {
+		return
+	}
+
+	for _, key := range keys {
+		if s, ok := strct.Fields[key].GetKind().(*structpb.Value_StringValue); ok && s.StringValue != "" {
+			fields[prefix+"."+key] = &s.StringValue
+		}
+	}
+}
@@ -0,0 +1,118 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package collection_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/eclipse-xfsc/cam/api/collection"
+	"github.com/eclipse-xfsc/cam/service/collection/workload/aws/strct"
+	"github.com/eclipse-xfsc/cam/service/collection/workload/openstack"
+)
+
+func TestSecretHolder_SecretFields(t *testing.T) {
+	tests := []struct {
+		name   string
+		holder collection.SecretHolder
+		want   map[string]string
+	}{
+		{
+			name:   "Authentication security without secret",
+			holder: &collection.AuthenticationSecurityConfig{ClientId: "client"},
+			want:   map[string]string{},
+		},
+		{
+			name:   "Authentication security",
+			holder: &collection.AuthenticationSecurityConfig{ClientId: "client", ClientSecret: "secret"},
+			want:   map[string]string{"client_secret": "secret"},
+		},
+		{
+			name: "Workload",
+			holder: &collection.WorkloadSecurityConfig{
+				Kubernetes: mockValue(t, mockKubeConfig),
+				Openstack: mockValue(t, map[string]interface{}{
+					"username": "user",
+					"password": "password",
+				}),
+				Aws: mockValue(t, map[string]interface{}{
+					"accessKeyId":     "key",
+					"secretAccessKey": "secret",
+				}),
+			},
+			want: map[string]string{
+				"kubernetes":          mockKubeConfig,
+				"openstack.password":  "password",
+				"aws.secretAccessKey": "secret",
+			},
+		},
+		{
+			name: "Workload with the configurations of the discoverers",
+			holder: &collection.WorkloadSecurityConfig{
+				Openstack: mockStructValue(t, &openstack.AuthOptions{
+					IdentityEndpoint: "https://identity",
+					Username:         "user",
+					Password:         "password",
+					TenantName:       "tenant",
+					AllowReauth:      true,
+				}),
+				Aws: mockStructValue(t, &strct.AWSConfig{
+					Region:          "eu-central-1",
+					AccessKeyID:     "key",
+					SecretAccessKey: "secret",
+				}),
+			},
+			want: map[string]string{
+				"openstack.password":  "password",
+				"aws.secretAccessKey": "secret",
+			},
+		},
+		{
+			name:   "Workload with kube config as map",
+			holder: &collection.WorkloadSecurityConfig{Kubernetes: mockValue(t, map[string]interface{}{})},
+			want:   map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := map[string]string{}
+			for name, value := range tt.holder.SecretFields() {
+				got[name] = *value
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	// Secrets are replaced in place
+	c := &collection.AuthenticationSecurityConfig{ClientId: "client", ClientSecret: "secret"}
+	*c.SecretFields()["client_secret"] = "other"
+	assert.Equal(t, "other", c.ClientSecret)
+}
+
+// mockStructValue converts v to a struct value in the same way as the discoverers read their configuration
+func mockStructValue(t *testing.T, v interface{}) *structpb.Value {
+	b, err := json.Marshal(v)
+	assert.NoError(t, err)
+
+	var m map[string]interface{}
+	assert.NoError(t, json.Unmarshal(b, &m))
+
+	return mockValue(t, m)
+}
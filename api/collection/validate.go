@@ -0,0 +1,310 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package collection
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/structpb"
+	"k8s.io/client-go/tools/clientcmd"
+
+	awsstrct "github.com/eclipse-xfsc/cam/service/collection/workload/aws/strct"
+	openstackstrct "github.com/eclipse-xfsc/cam/service/collection/workload/openstack/strct"
+)
+
+// ConfigValidator is implemented by the configuration messages of the collection modules, which can be checked
+// statically, i.e., without connecting to the cloud service
+type ConfigValidator interface {
+	Validate() error
+}
+
+// Validate validates the raw configuration of the service configuration against the configuration message of its
+// type. Configurations of types, which are not known here (e.g., of external collection modules), cannot be checked
+// statically and are only checked by the collection module itself.
+func (c *ServiceConfiguration) Validate() (err error) {
+	if c.GetRawConfiguration() == nil {
+		return ErrMissingRawConfiguration
+	}
+
+	msg, err := c.RawConfiguration.UnmarshalNew()
+	if errors.Is(err, protoregistry.NotFound) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("%s: %w", ErrInvalidServiceConfiguration, err)
+	}
+
+	// References to external secrets are resolved by the collection module, so only their syntax can be checked here
+	if h, ok := msg.(SecretRefHolder); ok {
+		if err = checkSecretRefs(h); err != nil {
+			return fmt.Errorf("%s: %w", ErrInvalidServiceConfiguration, err)
+		}
+	}
+
+	if v, ok := msg.(ConfigValidator); ok {
+		if err = v.Validate(); err != nil {
+			return fmt.Errorf("%s: %w", ErrInvalidServiceConfiguration, err)
+		}
+	}
+
+	return nil
+}
+
+// Validate validates the configuration of the Communication Security Collection Module
+func (c *CommunicationSecurityConfig) Validate() error {
+	if c.Endpoint == "" {
+		return fmt.Errorf("%w: endpoint", ErrMissingConfigParameter)
+	}
+
+	return checkAddress("endpoint", c.Endpoint)
+}
+
+// Validate validates the configuration of the Authentication Security Collection Module
+func (c *AuthenticationSecurityConfig) Validate() (err error) {
+	if c.Issuer == "" {
+		return fmt.Errorf("%w: issuer", ErrMissingConfigParameter)
+	}
+	if err = checkURL("issuer", c.Issuer); err != nil {
+		return
+	}
+
+	if c.MetadataDocument != "" {
+		if err = checkURL("metadata_document", c.MetadataDocument); err != nil {
+			return
+		}
+	}
+
+	if c.ApiEndpoint != "" {
+		if err = checkURL("api_endpoint", c.ApiEndpoint); err != nil {
+			return
+		}
+	}
+
+	// A client secret without a client does not make sense
+	if c.ClientSecret != "" && c.ClientId == "" {
+		return fmt.Errorf("%w: client_id", ErrMissingConfigParameter)
+	}
+
+	return nil
+}
+
+// Validate validates the configuration of the Remote Integrity Collection Module. Targets can also be discovered, so
+// only the format of configured targets is checked.
+func (c *RemoteIntegrityConfig) Validate() (err error) {
+	if c.Target == "" && len(c.Targets) == 0 && c.Discovery == nil {
+		return fmt.Errorf("%w: targets or discovery", ErrMissingConfigParameter)
+	}
+
+	if c.Target != "" {
+		if err = checkAddress("target", c.Target); err != nil {
+			return
+		}
+	}
+
+	for _, target := range c.Targets {
+		if err = checkAddress("targets", target); err != nil {
+			return
+		}
+	}
+
+	if c.Discovery != nil && (c.Discovery.Port == 0 || c.Discovery.Port > 65535) {
+		return fmt.Errorf("%w for discovery: port %d is out of range", ErrInvalidAddress, c.Discovery.Port)
+	}
+
+	if c.Certificate != "" && !IsSecretRef(c.Certificate) {
+		if err = checkCertificates("certificate", c.Certificate); err != nil {
+			return
+		}
+	}
+
+	return nil
+}
+
+// Validate validates the configuration of the Cyber Security Certification Collection Module. The certification
+// schemes are checked by the collection module, since it decides which schemes it supports.
+func (c *CyberSecurityCertificationConfig) Validate() error {
+	if c.Provider == "" {
+		return fmt.Errorf("%w: provider", ErrMissingConfigParameter)
+	}
+
+	// The registry is either a URL or a local file path of the collection module
+	if strings.HasPrefix(c.Registry, "http") {
+		return checkURL("registry", c.Registry)
+	}
+
+	return nil
+}
+
+// Validate validates the configuration of the Gaia-X Self-Description Collection Module
+func (c *GaiaXSelfDescriptionConfig) Validate() (err error) {
+	if c.SelfDescription == "" {
+		return fmt.Errorf("%w: self_description", ErrMissingConfigParameter)
+	}
+	if err = checkURL("self_description", c.SelfDescription); err != nil {
+		return
+	}
+
+	for _, issuer := range c.TrustedIssuers {
+		if !strings.HasPrefix(issuer, "did:") {
+			return fmt.Errorf("trusted issuer is not a DID: %s", issuer)
+		}
+	}
+
+	return nil
+}
+
+// Validate validates the configuration of the Workload Security Collection Module. At least one of the providers has
+// to be configured and each configured provider has to be complete.
+func (c *WorkloadSecurityConfig) Validate() (err error) {
+	var configured bool
+
+	if !isEmptyValue(c.Kubernetes) {
+		configured = true
+
+		kubeConfig := c.Kubernetes.GetStringValue()
+		if kubeConfig == "" {
+			return fmt.Errorf("%s: kube config is not a string", ErrInvalidKubernetesServiceConfiguration)
+		}
+
+		// This parses the kube config and checks that its current context is complete, without connecting to the
+		// cluster. A referenced kube config is only available to the collection module.
+		if !IsSecretRef(kubeConfig) {
+			if _, err = clientcmd.RESTConfigFromKubeConfig([]byte(kubeConfig)); err != nil {
+				return fmt.Errorf("%s: %w", ErrInvalidKubernetesServiceConfiguration, err)
+			}
+		}
+	}
+
+	if !isEmptyValue(c.Openstack) {
+		configured = true
+
+		authOpts, err := openstackstrct.ToAuthOptions(c.Openstack)
+		if err != nil {
+			return fmt.Errorf("%s: %w", ErrInvalidOpenstackServiceConfiguration, err)
+		}
+		if authOpts.IdentityEndpoint == "" {
+			return fmt.Errorf("%s: %w: identityEndpoint", ErrInvalidOpenstackServiceConfiguration,
+				ErrMissingConfigParameter)
+		}
+		if err = checkURL("identityEndpoint", authOpts.IdentityEndpoint); err != nil {
+			return fmt.Errorf("%s: %w", ErrInvalidOpenstackServiceConfiguration, err)
+		}
+		if authOpts.Username == "" {
+			return fmt.Errorf("%s: %w: username", ErrInvalidOpenstackServiceConfiguration, ErrMissingConfigParameter)
+		}
+	}
+
+	if !isEmptyValue(c.Aws) {
+		configured = true
+
+		config, err := awsstrct.ToConfig(c.Aws)
+		if err != nil {
+			return fmt.Errorf("%s: %w", ErrInvalidAWSServiceConfiguration, err)
+		}
+		for name, value := range map[string]string{
+			"region":          config.Region,
+			"accessKeyId":     config.AccessKeyID,
+			"secretAccessKey": config.SecretAccessKey,
+		} {
+			if value == "" {
+				return fmt.Errorf("%s: %w: %s", ErrInvalidAWSServiceConfiguration, ErrMissingConfigParameter, name)
+			}
+		}
+	}
+
+	if !configured {
+		return ErrMissingWorkloadProvider
+	}
+
+	return nil
+}
+
+// checkURL checks that value is an absolute HTTP(S) URL
+func checkURL(name string, value string) error {
+	u, err := url.ParseRequestURI(value)
+	if err != nil {
+		return fmt.Errorf("%w for %s: %v", ErrInvalidURL, name, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w for %s: %s is not an HTTP(S) URL", ErrInvalidURL, name, value)
+	}
+
+	return nil
+}
+
+// checkAddress checks that value is a host:port address
+func checkAddress(name string, value string) error {
+	host, port, err := net.SplitHostPort(value)
+	if err != nil {
+		return fmt.Errorf("%w for %s: %v", ErrInvalidAddress, name, err)
+	}
+	if host == "" {
+		return fmt.Errorf("%w for %s: host is missing in %s", ErrInvalidAddress, name, value)
+	}
+	if p, err := strconv.ParseUint(port, 10, 16); err != nil || p == 0 {
+		return fmt.Errorf("%w for %s: invalid port in %s", ErrInvalidAddress, name, value)
+	}
+
+	return nil
+}
+
+// checkCertificates checks that value contains at least one PEM-encoded certificate and nothing else
+func checkCertificates(name string, value string) error {
+	var (
+		rest  = []byte(strings.TrimSpace(value))
+		block *pem.Block
+		certs int
+	)
+
+	for len(rest) > 0 {
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return fmt.Errorf("%w for %s: no PEM data found", ErrInvalidCertificate, name)
+		}
+		if block.Type != "CERTIFICATE" {
+			return fmt.Errorf("%w for %s: unexpected PEM block %s", ErrInvalidCertificate, name, block.Type)
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return fmt.Errorf("%w for %s: %v", ErrInvalidCertificate, name, err)
+		}
+
+		certs++
+		rest = []byte(strings.TrimSpace(string(rest)))
+	}
+
+	if certs == 0 {
+		return fmt.Errorf("%w for %s: no PEM data found", ErrInvalidCertificate, name)
+	}
+
+	return nil
+}
+
+// isEmptyValue returns true, if the value is not set or an empty map, which means that it is not configured
+func isEmptyValue(value *structpb.Value) bool {
+	if value == nil {
+		return true
+	}
+
+	strct := value.GetStructValue()
+	return strct != nil && len(strct.Fields) == 0
+}
@@ -16,11 +16,16 @@
 package common
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var (
@@ -95,3 +100,50 @@ func (evidence *Evidence) ResourceTypes() (types []string, err error) {
 
 	return
 }
+
+// SigningPayload returns the payload, which is signed by the collection module. It is the deterministic protobuf
+// encoding of the evidence without the signature and the fields set by the Evaluation Manager. Timestamps are
+// truncated to microseconds, since databases usually do not store them more precisely.
+func (evidence *Evidence) SigningPayload() ([]byte, error) {
+	e := evidence.normalized()
+	e.Signature = ""
+	e.Sequence = 0
+	e.Hash = ""
+	e.PreviousHash = ""
+	e.ReceivedAt = nil
+
+	return proto.MarshalOptions{Deterministic: true}.Marshal(e)
+}
+
+// ChainHash returns the hex encoded SHA-256 hash of the evidence in the hash chain of its target service. It covers
+// the complete evidence including its signature and the hash of the previous evidence, but not the hash itself.
+func (evidence *Evidence) ChainHash() (string, error) {
+	e := evidence.normalized()
+	e.Hash = ""
+
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(e)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// normalized returns a copy of the evidence with timestamps truncated to microseconds
+func (evidence *Evidence) normalized() *Evidence {
+	e := proto.Clone(evidence).(*Evidence)
+	e.GatheredAt = truncate(e.GatheredAt)
+	e.ReceivedAt = truncate(e.ReceivedAt)
+
+	return e
+}
+
+func truncate(t *timestamppb.Timestamp) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(t.AsTime().Truncate(time.Microsecond))
+}
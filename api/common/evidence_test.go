@@ -17,9 +17,11 @@ package common_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/eclipse-xfsc/cam/api/common"
 	"github.com/eclipse-xfsc/cam/internal/testutil"
@@ -50,3 +52,48 @@ func Test_PersistEvidence(t *testing.T) {
 
 	assert.True(t, proto.Equal(&e, &e2))
 }
+
+func TestEvidence_ChainHash(t *testing.T) {
+	var e2 common.Evidence
+
+	storage := testutil.NewInMemoryStorage(t)
+
+	e := common.Evidence{
+		Id:         MockEvidenceID,
+		Name:       "my evidence",
+		GatheredAt: timestamppb.New(time.Date(2023, 1, 1, 12, 0, 0, 123456789, time.UTC)),
+		Value:      testproto.ToValue(t, struct{ Test string }{Test: "test"}),
+		Signature:  "signature",
+		Sequence:   2,
+		ReceivedAt: timestamppb.Now(),
+	}
+
+	payload, err := e.SigningPayload()
+	assert.NoError(t, err)
+
+	hash, err := e.ChainHash()
+	assert.NoError(t, err)
+	e.Hash = hash
+
+	// Hashes and signatures survive the round trip through the database
+	assert.NoError(t, storage.Save(&e))
+	assert.NoError(t, storage.Get(&e2, "id = ?", MockEvidenceID))
+
+	hash2, err := e2.ChainHash()
+	assert.NoError(t, err)
+	assert.Equal(t, hash, hash2)
+
+	payload2, err := e2.SigningPayload()
+	assert.NoError(t, err)
+	assert.Equal(t, payload, payload2)
+
+	// The signing payload does not depend on the fields set by the Evaluation Manager, but the hash does
+	e2.PreviousHash = "previous"
+	payload2, err = e2.SigningPayload()
+	assert.NoError(t, err)
+	assert.Equal(t, payload, payload2)
+
+	hash2, err = e2.ChainHash()
+	assert.NoError(t, err)
+	assert.NotEqual(t, hash, hash2)
+}
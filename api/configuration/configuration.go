@@ -15,6 +15,14 @@
 
 package configuration
 
+import (
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"strings"
+)
+
 // Validate validates the `StartMonitoringRequest`
 func (req *StartMonitoringRequest) Validate() (err error) {
 	if req == nil {
@@ -29,5 +37,190 @@ func (req *StartMonitoringRequest) Validate() (err error) {
 		err = ErrControlIDsEmpty
 		return
 	}
+	if err = validateSchedules(req.Schedules); err != nil {
+		return
+	}
+	return req.Debounce.Validate()
+}
+
+// Validate validates the `UpdateMonitoringRequest`
+func (req *UpdateMonitoringRequest) Validate() (err error) {
+	if req == nil {
+		return ErrRequestEmpty
+	}
+	if req.ServiceId == "" {
+		return ErrServiceIDMissing
+	}
+	if len(req.ControlIds) == 0 {
+		return ErrControlIDsEmpty
+	}
+	if err = validateSchedules(req.Schedules); err != nil {
+		return
+	}
+
+	return req.Debounce.Validate()
+}
+
+// Validate validates the `DebounceConfig`. An empty configuration is valid.
+func (c *DebounceConfig) Validate() error {
+	if c.GetWindow() < 0 || c.GetThreshold() < 0 {
+		return ErrInvalidDebounceConfig
+	}
+
+	return nil
+}
+
+// Validate validates the `ConfigureCloudServiceRequest` including its service configurations
+func (req *ConfigureCloudServiceRequest) Validate() (err error) {
+	if req == nil {
+		return ErrRequestEmpty
+	}
+	if req.ServiceId == "" {
+		return ErrServiceIDMissing
+	}
+
+	for _, c := range req.GetConfigurations().GetConfigurations() {
+		// Configurations without a raw configuration are not stored anyway
+		if c.GetRawConfiguration() == nil {
+			continue
+		}
+		if err = c.Validate(); err != nil {
+			return fmt.Errorf("%s: %w", c.RawConfiguration.TypeUrl, err)
+		}
+	}
+
+	return
+}
+
+// Validate validates the `ReportEvidencesRequest`
+func (req *ReportEvidencesRequest) Validate() (err error) {
+	if req == nil {
+		return ErrRequestEmpty
+	}
+	if req.ServiceId == "" {
+		return ErrServiceIDMissing
+	}
+	if req.ModuleId == "" {
+		return ErrCollectionModuleIDMissing
+	}
+
+	return
+}
+
+// validateSchedules checks that there is at most one schedule per collection module and that intervals are not
+// negative
+func validateSchedules(schedules []*CollectionSchedule) error {
+	for i, s := range schedules {
+		if s.GetInterval() < 0 {
+			return ErrInvalidInterval
+		}
+		for _, other := range schedules[:i] {
+			if other.GetModuleId() == s.GetModuleId() {
+				return ErrDuplicateSchedule
+			}
+		}
+	}
+
+	return nil
+}
+
+// Validate validates the `AddCollectionModuleRequest`
+func (req *AddCollectionModuleRequest) Validate() (err error) {
+	if req == nil {
+		return ErrRequestEmpty
+	}
+	if req.Module == nil {
+		return ErrCollectionModuleMissing
+	}
+	if req.Module.Address == "" {
+		return ErrCollectionModuleAddressMissing
+	}
+
+	return
+}
+
+// Validate validates the `RegisterCollectionModuleRequest`. In contrast to AddCollectionModule, the ID of the
+// collection module is required, since it identifies the module when renewing its lease.
+func (req *RegisterCollectionModuleRequest) Validate() (err error) {
+	if req == nil {
+		return ErrRequestEmpty
+	}
+	if req.Module == nil {
+		return ErrCollectionModuleMissing
+	}
+	if req.Module.Id == "" {
+		return ErrCollectionModuleIDMissing
+	}
+	if req.Module.Address == "" {
+		return ErrCollectionModuleAddressMissing
+	}
+
+	return
+}
+
+// Validate validates the `StoreAttestationTrustAnchorRequest`. The certificate of the trust anchor must be a valid
+// PEM-encoded X.509 certificate.
+func (req *StoreAttestationTrustAnchorRequest) Validate() (err error) {
+	if req == nil {
+		return ErrRequestEmpty
+	}
+	if req.Anchor == nil {
+		return ErrTrustAnchorMissing
+	}
+
+	block, _ := pem.Decode([]byte(req.Anchor.Certificate))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return ErrInvalidCertificate
+	}
+	if _, err = x509.ParseCertificate(block.Bytes); err != nil {
+		return ErrInvalidCertificate
+	}
+
+	return
+}
+
+// Validate validates the `StoreAttestationReferenceManifestRequest`
+func (req *StoreAttestationReferenceManifestRequest) Validate() (err error) {
+	if req == nil {
+		return ErrRequestEmpty
+	}
+	if req.Manifest == nil {
+		return ErrReferenceManifestMissing
+	}
+	if req.Manifest.Name == "" {
+		return ErrReferenceManifestNameMissing
+	}
+	for _, v := range req.Manifest.ReferenceValues {
+		if v.Sha256 == "" && v.Sha384 == "" {
+			return ErrReferenceValueHashMissing
+		}
+	}
+
+	return
+}
+
+// Validate validates the `RevokeAttestationCertificateRequest`, in particular that the subject key ID is hex-encoded
+func (req *RevokeAttestationCertificateRequest) Validate() (err error) {
+	if req == nil {
+		return ErrRequestEmpty
+	}
+	if req.Certificate == nil || req.Certificate.SubjectKeyId == "" {
+		return ErrSubjectKeyIDMissing
+	}
+	if _, err = NormalizeKeyID(req.Certificate.SubjectKeyId); err != nil {
+		return err
+	}
+
 	return
 }
+
+// NormalizeKeyID normalizes a hex-encoded subject or authority key ID, e.g., "AB:CD:EF", to lower-case hex without
+// separators, e.g., "abcdef". Key IDs are stored and compared in this form.
+func NormalizeKeyID(keyID string) (string, error) {
+	b, err := hex.DecodeString(strings.NewReplacer(":", "", " ", "").Replace(keyID))
+	if err != nil {
+		return "", ErrSubjectKeyIDInvalid
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -49,6 +49,13 @@ type StartMonitoringRequest struct {
 
 	ServiceId  string   `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 	ControlIds []string `protobuf:"bytes,2,rep,name=control_ids,json=controlIds,proto3" json:"control_ids,omitempty"`
+	// schedules override the schedules of the collection modules for this
+	// service. By default, each collection module is scheduled at the smallest
+	// interval of the monitored metrics it collects evidences for.
+	Schedules []*CollectionSchedule `protobuf:"bytes,3,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	// debounce overrides how incoming assessment results of this service are
+	// coalesced into compliance calculations
+	Debounce *DebounceConfig `protobuf:"bytes,4,opt,name=debounce,proto3" json:"debounce,omitempty"`
 }
 
 func (x *StartMonitoringRequest) Reset() {
@@ -97,16 +104,38 @@ func (x *StartMonitoringRequest) GetControlIds() []string {
 	return nil
 }
 
-type StartMonitoringResponse struct {
+func (x *StartMonitoringRequest) GetSchedules() []*CollectionSchedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+func (x *StartMonitoringRequest) GetDebounce() *DebounceConfig {
+	if x != nil {
+		return x.Debounce
+	}
+	return nil
+}
+
+// A schedule for collecting evidences with a collection module
+type CollectionSchedule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status *MonitoringStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// module_id refers to the collection module. If it is empty, the schedule
+	// applies to all collection modules without a schedule of their own.
+	ModuleId string `protobuf:"bytes,1,opt,name=module_id,json=moduleId,proto3" json:"module_id,omitempty"`
+	// interval specifies the interval in seconds
+	Interval int64 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// cron specifies a cron expression (minute, hour, day of month, month, day
+	// of week) in UTC. It takes precedence over interval.
+	Cron string `protobuf:"bytes,3,opt,name=cron,proto3" json:"cron,omitempty"`
 }
 
-func (x *StartMonitoringResponse) Reset() {
-	*x = StartMonitoringResponse{}
+func (x *CollectionSchedule) Reset() {
+	*x = CollectionSchedule{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_configuration_configuration_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -114,13 +143,13 @@ func (x *StartMonitoringResponse) Reset() {
 	}
 }
 
-func (x *StartMonitoringResponse) String() string {
+func (x *CollectionSchedule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartMonitoringResponse) ProtoMessage() {}
+func (*CollectionSchedule) ProtoMessage() {}
 
-func (x *StartMonitoringResponse) ProtoReflect() protoreflect.Message {
+func (x *CollectionSchedule) ProtoReflect() protoreflect.Message {
 	mi := &file_api_configuration_configuration_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -132,28 +161,42 @@ func (x *StartMonitoringResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartMonitoringResponse.ProtoReflect.Descriptor instead.
-func (*StartMonitoringResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CollectionSchedule.ProtoReflect.Descriptor instead.
+func (*CollectionSchedule) Descriptor() ([]byte, []int) {
 	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *StartMonitoringResponse) GetStatus() *MonitoringStatus {
+func (x *CollectionSchedule) GetModuleId() string {
 	if x != nil {
-		return x.Status
+		return x.ModuleId
 	}
-	return nil
+	return ""
 }
 
-type StopMonitoringRequest struct {
+func (x *CollectionSchedule) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *CollectionSchedule) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+type StartMonitoringResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Status *MonitoringStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *StopMonitoringRequest) Reset() {
-	*x = StopMonitoringRequest{}
+func (x *StartMonitoringResponse) Reset() {
+	*x = StartMonitoringResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_configuration_configuration_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -161,13 +204,13 @@ func (x *StopMonitoringRequest) Reset() {
 	}
 }
 
-func (x *StopMonitoringRequest) String() string {
+func (x *StartMonitoringResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopMonitoringRequest) ProtoMessage() {}
+func (*StartMonitoringResponse) ProtoMessage() {}
 
-func (x *StopMonitoringRequest) ProtoReflect() protoreflect.Message {
+func (x *StartMonitoringResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_configuration_configuration_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -179,26 +222,37 @@ func (x *StopMonitoringRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopMonitoringRequest.ProtoReflect.Descriptor instead.
-func (*StopMonitoringRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartMonitoringResponse.ProtoReflect.Descriptor instead.
+func (*StartMonitoringResponse) Descriptor() ([]byte, []int) {
 	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *StopMonitoringRequest) GetServiceId() string {
+func (x *StartMonitoringResponse) GetStatus() *MonitoringStatus {
 	if x != nil {
-		return x.ServiceId
+		return x.Status
 	}
-	return ""
+	return nil
 }
 
-type StopMonitoringResponse struct {
+// DebounceConfig configures how incoming assessment results of a metric are
+// coalesced, before the compliance calculation is triggered. The calculation is
+// triggered, once threshold results have arrived or once the window has passed
+// since the first result, whichever comes first. Fields, which are not set, use
+// the defaults of the server.
+type DebounceConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// window specifies the window in milliseconds
+	Window int64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// threshold specifies the number of results, which trigger the calculation
+	// before the window has passed
+	Threshold int64 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
 }
 
-func (x *StopMonitoringResponse) Reset() {
-	*x = StopMonitoringResponse{}
+func (x *DebounceConfig) Reset() {
+	*x = DebounceConfig{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_configuration_configuration_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -206,13 +260,13 @@ func (x *StopMonitoringResponse) Reset() {
 	}
 }
 
-func (x *StopMonitoringResponse) String() string {
+func (x *DebounceConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopMonitoringResponse) ProtoMessage() {}
+func (*DebounceConfig) ProtoMessage() {}
 
-func (x *StopMonitoringResponse) ProtoReflect() protoreflect.Message {
+func (x *DebounceConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_api_configuration_configuration_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -224,21 +278,42 @@ func (x *StopMonitoringResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopMonitoringResponse.ProtoReflect.Descriptor instead.
-func (*StopMonitoringResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DebounceConfig.ProtoReflect.Descriptor instead.
+func (*DebounceConfig) Descriptor() ([]byte, []int) {
 	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{3}
 }
 
-type GetMonitoringStatusRequest struct {
+func (x *DebounceConfig) GetWindow() int64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *DebounceConfig) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+type UpdateMonitoringRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	ServiceId  string   `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	ControlIds []string `protobuf:"bytes,2,rep,name=control_ids,json=controlIds,proto3" json:"control_ids,omitempty"`
+	// schedules replace the schedules of the collection modules for this service
+	// (see StartMonitoringRequest)
+	Schedules []*CollectionSchedule `protobuf:"bytes,3,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	// debounce replaces the debouncing of the compliance calculation for this
+	// service (see StartMonitoringRequest)
+	Debounce *DebounceConfig `protobuf:"bytes,4,opt,name=debounce,proto3" json:"debounce,omitempty"`
 }
 
-func (x *GetMonitoringStatusRequest) Reset() {
-	*x = GetMonitoringStatusRequest{}
+func (x *UpdateMonitoringRequest) Reset() {
+	*x = UpdateMonitoringRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_configuration_configuration_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -246,13 +321,13 @@ func (x *GetMonitoringStatusRequest) Reset() {
 	}
 }
 
-func (x *GetMonitoringStatusRequest) String() string {
+func (x *UpdateMonitoringRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMonitoringStatusRequest) ProtoMessage() {}
+func (*UpdateMonitoringRequest) ProtoMessage() {}
 
-func (x *GetMonitoringStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateMonitoringRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_configuration_configuration_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -264,28 +339,49 @@ func (x *GetMonitoringStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMonitoringStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetMonitoringStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateMonitoringRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMonitoringRequest) Descriptor() ([]byte, []int) {
 	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetMonitoringStatusRequest) GetServiceId() string {
+func (x *UpdateMonitoringRequest) GetServiceId() string {
 	if x != nil {
 		return x.ServiceId
 	}
 	return ""
 }
 
-type GetMetricRequest struct {
+func (x *UpdateMonitoringRequest) GetControlIds() []string {
+	if x != nil {
+		return x.ControlIds
+	}
+	return nil
+}
+
+func (x *UpdateMonitoringRequest) GetSchedules() []*CollectionSchedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+func (x *UpdateMonitoringRequest) GetDebounce() *DebounceConfig {
+	if x != nil {
+		return x.Debounce
+	}
+	return nil
+}
+
+type UpdateMonitoringResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MetricId int32 `protobuf:"varint,1,opt,name=metric_id,json=metricId,proto3" json:"metric_id,omitempty"`
+	Status *MonitoringStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *GetMetricRequest) Reset() {
-	*x = GetMetricRequest{}
+func (x *UpdateMonitoringResponse) Reset() {
+	*x = UpdateMonitoringResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_configuration_configuration_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -293,13 +389,13 @@ func (x *GetMetricRequest) Reset() {
 	}
 }
 
-func (x *GetMetricRequest) String() string {
+func (x *UpdateMonitoringResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMetricRequest) ProtoMessage() {}
+func (*UpdateMonitoringResponse) ProtoMessage() {}
 
-func (x *GetMetricRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateMonitoringResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_configuration_configuration_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -311,29 +407,35 @@ func (x *GetMetricRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMetricRequest.ProtoReflect.Descriptor instead.
-func (*GetMetricRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateMonitoringResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMonitoringResponse) Descriptor() ([]byte, []int) {
 	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetMetricRequest) GetMetricId() int32 {
+func (x *UpdateMonitoringResponse) GetStatus() *MonitoringStatus {
 	if x != nil {
-		return x.MetricId
+		return x.Status
 	}
-	return 0
+	return nil
 }
 
-type GetControlRequest struct {
+type ReportEvidencesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	ControlId string `protobuf:"bytes,2,opt,name=control_id,json=controlId,proto3" json:"control_id,omitempty"`
+	// The ID of the collection module, i.e., the tool ID of the evidences
+	ModuleId string `protobuf:"bytes,2,opt,name=module_id,json=moduleId,proto3" json:"module_id,omitempty"`
+	// The number of evidences received since the last report
+	Count int64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// The collection run, in which the evidences were gathered. Evidences
+	// without a run are counted for the current run.
+	RunId string `protobuf:"bytes,4,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 }
 
-func (x *GetControlRequest) Reset() {
-	*x = GetControlRequest{}
+func (x *ReportEvidencesRequest) Reset() {
+	*x = ReportEvidencesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_configuration_configuration_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -341,13 +443,13 @@ func (x *GetControlRequest) Reset() {
 	}
 }
 
-func (x *GetControlRequest) String() string {
+func (x *ReportEvidencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetControlRequest) ProtoMessage() {}
+func (*ReportEvidencesRequest) ProtoMessage() {}
 
-func (x *GetControlRequest) ProtoReflect() protoreflect.Message {
+func (x *ReportEvidencesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_configuration_configuration_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -359,33 +461,49 @@ func (x *GetControlRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetControlRequest.ProtoReflect.Descriptor instead.
-func (*GetControlRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReportEvidencesRequest.ProtoReflect.Descriptor instead.
+func (*ReportEvidencesRequest) Descriptor() ([]byte, []int) {
 	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetControlRequest) GetServiceId() string {
+func (x *ReportEvidencesRequest) GetServiceId() string {
 	if x != nil {
 		return x.ServiceId
 	}
 	return ""
 }
 
-func (x *GetControlRequest) GetControlId() string {
+func (x *ReportEvidencesRequest) GetModuleId() string {
 	if x != nil {
-		return x.ControlId
+		return x.ModuleId
 	}
 	return ""
 }
 
-type ListCollectionModulesRequest struct {
+func (x *ReportEvidencesRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ReportEvidencesRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type StopMonitoringRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 }
 
-func (x *ListCollectionModulesRequest) Reset() {
-	*x = ListCollectionModulesRequest{}
+func (x *StopMonitoringRequest) Reset() {
+	*x = StopMonitoringRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_configuration_configuration_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -393,13 +511,13 @@ func (x *ListCollectionModulesRequest) Reset() {
 	}
 }
 
-func (x *ListCollectionModulesRequest) String() string {
+func (x *StopMonitoringRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCollectionModulesRequest) ProtoMessage() {}
+func (*StopMonitoringRequest) ProtoMessage() {}
 
-func (x *ListCollectionModulesRequest) ProtoReflect() protoreflect.Message {
+func (x *StopMonitoringRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_configuration_configuration_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -411,21 +529,26 @@ func (x *ListCollectionModulesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCollectionModulesRequest.ProtoReflect.Descriptor instead.
-func (*ListCollectionModulesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StopMonitoringRequest.ProtoReflect.Descriptor instead.
+func (*StopMonitoringRequest) Descriptor() ([]byte, []int) {
 	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{7}
 }
 
-type ListCollectionModulesResponse struct {
+func (x *StopMonitoringRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+type StopMonitoringResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Modules []*collection.CollectionModule `protobuf:"bytes,1,rep,name=modules,proto3" json:"modules,omitempty"`
 }
 
-func (x *ListCollectionModulesResponse) Reset() {
-	*x = ListCollectionModulesResponse{}
+func (x *StopMonitoringResponse) Reset() {
+	*x = StopMonitoringResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_configuration_configuration_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -433,13 +556,13 @@ func (x *ListCollectionModulesResponse) Reset() {
 	}
 }
 
-func (x *ListCollectionModulesResponse) String() string {
+func (x *StopMonitoringResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCollectionModulesResponse) ProtoMessage() {}
+func (*StopMonitoringResponse) ProtoMessage() {}
 
-func (x *ListCollectionModulesResponse) ProtoReflect() protoreflect.Message {
+func (x *StopMonitoringResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_configuration_configuration_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -451,28 +574,21 @@ func (x *ListCollectionModulesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCollectionModulesResponse.ProtoReflect.Descriptor instead.
-func (*ListCollectionModulesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StopMonitoringResponse.ProtoReflect.Descriptor instead.
+func (*StopMonitoringResponse) Descriptor() ([]byte, []int) {
 	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ListCollectionModulesResponse) GetModules() []*collection.CollectionModule {
-	if x != nil {
-		return x.Modules
-	}
-	return nil
-}
-
-type AddCollectionModuleRequest struct {
+type GetMonitoringStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Module *collection.CollectionModule `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 }
 
-func (x *AddCollectionModuleRequest) Reset() {
-	*x = AddCollectionModuleRequest{}
+func (x *GetMonitoringStatusRequest) Reset() {
+	*x = GetMonitoringStatusRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_configuration_configuration_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -480,13 +596,13 @@ func (x *AddCollectionModuleRequest) Reset() {
 	}
 }
 
-func (x *AddCollectionModuleRequest) String() string {
+func (x *GetMonitoringStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddCollectionModuleRequest) ProtoMessage() {}
+func (*GetMonitoringStatusRequest) ProtoMessage() {}
 
-func (x *AddCollectionModuleRequest) ProtoReflect() protoreflect.Message {
+func (x *GetMonitoringStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_configuration_configuration_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -498,28 +614,28 @@ func (x *AddCollectionModuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddCollectionModuleRequest.ProtoReflect.Descriptor instead.
-func (*AddCollectionModuleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMonitoringStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetMonitoringStatusRequest) Descriptor() ([]byte, []int) {
 	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *AddCollectionModuleRequest) GetModule() *collection.CollectionModule {
+func (x *GetMonitoringStatusRequest) GetServiceId() string {
 	if x != nil {
-		return x.Module
+		return x.ServiceId
 	}
-	return nil
+	return ""
 }
 
-type FindCollectionModuleRequest struct {
+type GetMetricRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MetricId string `protobuf:"bytes,1,opt,name=metric_id,json=metricId,proto3" json:"metric_id,omitempty"`
+	MetricId int32 `protobuf:"varint,1,opt,name=metric_id,json=metricId,proto3" json:"metric_id,omitempty"`
 }
 
-func (x *FindCollectionModuleRequest) Reset() {
-	*x = FindCollectionModuleRequest{}
+func (x *GetMetricRequest) Reset() {
+	*x = GetMetricRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_configuration_configuration_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -527,13 +643,13 @@ func (x *FindCollectionModuleRequest) Reset() {
 	}
 }
 
-func (x *FindCollectionModuleRequest) String() string {
+func (x *GetMetricRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindCollectionModuleRequest) ProtoMessage() {}
+func (*GetMetricRequest) ProtoMessage() {}
 
-func (x *FindCollectionModuleRequest) ProtoReflect() protoreflect.Message {
+func (x *GetMetricRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_configuration_configuration_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -545,28 +661,29 @@ func (x *FindCollectionModuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindCollectionModuleRequest.ProtoReflect.Descriptor instead.
-func (*FindCollectionModuleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMetricRequest.ProtoReflect.Descriptor instead.
+func (*GetMetricRequest) Descriptor() ([]byte, []int) {
 	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *FindCollectionModuleRequest) GetMetricId() string {
+func (x *GetMetricRequest) GetMetricId() int32 {
 	if x != nil {
 		return x.MetricId
 	}
-	return ""
+	return 0
 }
 
-type RemoveCollectionModuleRequest struct {
+type GetControlRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ModuleId string `protobuf:"bytes,1,opt,name=module_id,json=moduleId,proto3" json:"module_id,omitempty"`
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	ControlId string `protobuf:"bytes,2,opt,name=control_id,json=controlId,proto3" json:"control_id,omitempty"`
 }
 
-func (x *RemoveCollectionModuleRequest) Reset() {
-	*x = RemoveCollectionModuleRequest{}
+func (x *GetControlRequest) Reset() {
+	*x = GetControlRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_configuration_configuration_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -574,13 +691,13 @@ func (x *RemoveCollectionModuleRequest) Reset() {
 	}
 }
 
-func (x *RemoveCollectionModuleRequest) String() string {
+func (x *GetControlRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveCollectionModuleRequest) ProtoMessage() {}
+func (*GetControlRequest) ProtoMessage() {}
 
-func (x *RemoveCollectionModuleRequest) ProtoReflect() protoreflect.Message {
+func (x *GetControlRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_configuration_configuration_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -592,29 +709,33 @@ func (x *RemoveCollectionModuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveCollectionModuleRequest.ProtoReflect.Descriptor instead.
-func (*RemoveCollectionModuleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetControlRequest.ProtoReflect.Descriptor instead.
+func (*GetControlRequest) Descriptor() ([]byte, []int) {
 	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *RemoveCollectionModuleRequest) GetModuleId() string {
+func (x *GetControlRequest) GetServiceId() string {
 	if x != nil {
-		return x.ModuleId
+		return x.ServiceId
 	}
 	return ""
 }
 
-type ConfigureCloudServiceRequest struct {
+func (x *GetControlRequest) GetControlId() string {
+	if x != nil {
+		return x.ControlId
+	}
+	return ""
+}
+
+type ListCollectionModulesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ServiceId      string          `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	Configurations *Configurations `protobuf:"bytes,2,opt,name=configurations,proto3" json:"configurations,omitempty"`
 }
 
-func (x *ConfigureCloudServiceRequest) Reset() {
-	*x = ConfigureCloudServiceRequest{}
+func (x *ListCollectionModulesRequest) Reset() {
+	*x = ListCollectionModulesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_configuration_configuration_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -622,13 +743,13 @@ func (x *ConfigureCloudServiceRequest) Reset() {
 	}
 }
 
-func (x *ConfigureCloudServiceRequest) String() string {
+func (x *ListCollectionModulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigureCloudServiceRequest) ProtoMessage() {}
+func (*ListCollectionModulesRequest) ProtoMessage() {}
 
-func (x *ConfigureCloudServiceRequest) ProtoReflect() protoreflect.Message {
+func (x *ListCollectionModulesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_configuration_configuration_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -640,33 +761,21 @@ func (x *ConfigureCloudServiceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigureCloudServiceRequest.ProtoReflect.Descriptor instead.
-func (*ConfigureCloudServiceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListCollectionModulesRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionModulesRequest) Descriptor() ([]byte, []int) {
 	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ConfigureCloudServiceRequest) GetServiceId() string {
-	if x != nil {
-		return x.ServiceId
-	}
-	return ""
-}
-
-func (x *ConfigureCloudServiceRequest) GetConfigurations() *Configurations {
-	if x != nil {
-		return x.Configurations
-	}
-	return nil
-}
-
-type ConfigureCloudServiceResponse struct {
+type ListCollectionModulesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Modules []*collection.CollectionModule `protobuf:"bytes,1,rep,name=modules,proto3" json:"modules,omitempty"`
 }
 
-func (x *ConfigureCloudServiceResponse) Reset() {
-	*x = ConfigureCloudServiceResponse{}
+func (x *ListCollectionModulesResponse) Reset() {
+	*x = ListCollectionModulesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_configuration_configuration_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -674,13 +783,13 @@ func (x *ConfigureCloudServiceResponse) Reset() {
 	}
 }
 
-func (x *ConfigureCloudServiceResponse) String() string {
+func (x *ListCollectionModulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigureCloudServiceResponse) ProtoMessage() {}
+func (*ListCollectionModulesResponse) ProtoMessage() {}
 
-func (x *ConfigureCloudServiceResponse) ProtoReflect() protoreflect.Message {
+func (x *ListCollectionModulesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_configuration_configuration_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -692,21 +801,28 @@ func (x *ConfigureCloudServiceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigureCloudServiceResponse.ProtoReflect.Descriptor instead.
-func (*ConfigureCloudServiceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListCollectionModulesResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionModulesResponse) Descriptor() ([]byte, []int) {
 	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{13}
 }
 
-type ListCloudServiceConfigurationsRequest struct {
+func (x *ListCollectionModulesResponse) GetModules() []*collection.CollectionModule {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+type AddCollectionModuleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Module *collection.CollectionModule `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
 }
 
-func (x *ListCloudServiceConfigurationsRequest) Reset() {
-	*x = ListCloudServiceConfigurationsRequest{}
+func (x *AddCollectionModuleRequest) Reset() {
+	*x = AddCollectionModuleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_configuration_configuration_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -714,13 +830,13 @@ func (x *ListCloudServiceConfigurationsRequest) Reset() {
 	}
 }
 
-func (x *ListCloudServiceConfigurationsRequest) String() string {
+func (x *AddCollectionModuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCloudServiceConfigurationsRequest) ProtoMessage() {}
+func (*AddCollectionModuleRequest) ProtoMessage() {}
 
-func (x *ListCloudServiceConfigurationsRequest) ProtoReflect() protoreflect.Message {
+func (x *AddCollectionModuleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_configuration_configuration_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -732,28 +848,28 @@ func (x *ListCloudServiceConfigurationsRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCloudServiceConfigurationsRequest.ProtoReflect.Descriptor instead.
-func (*ListCloudServiceConfigurationsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddCollectionModuleRequest.ProtoReflect.Descriptor instead.
+func (*AddCollectionModuleRequest) Descriptor() ([]byte, []int) {
 	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ListCloudServiceConfigurationsRequest) GetServiceId() string {
+func (x *AddCollectionModuleRequest) GetModule() *collection.CollectionModule {
 	if x != nil {
-		return x.ServiceId
+		return x.Module
 	}
-	return ""
+	return nil
 }
 
-type ListCloudServiceConfigurationsResponse struct {
+type FindCollectionModuleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Configurations []*collection.ServiceConfiguration `protobuf:"bytes,1,rep,name=configurations,proto3" json:"configurations,omitempty"`
+	MetricId string `protobuf:"bytes,1,opt,name=metric_id,json=metricId,proto3" json:"metric_id,omitempty"`
 }
 
-func (x *ListCloudServiceConfigurationsResponse) Reset() {
-	*x = ListCloudServiceConfigurationsResponse{}
+func (x *FindCollectionModuleRequest) Reset() {
+	*x = FindCollectionModuleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_configuration_configuration_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -761,13 +877,13 @@ func (x *ListCloudServiceConfigurationsResponse) Reset() {
 	}
 }
 
-func (x *ListCloudServiceConfigurationsResponse) String() string {
+func (x *FindCollectionModuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCloudServiceConfigurationsResponse) ProtoMessage() {}
+func (*FindCollectionModuleRequest) ProtoMessage() {}
 
-func (x *ListCloudServiceConfigurationsResponse) ProtoReflect() protoreflect.Message {
+func (x *FindCollectionModuleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_configuration_configuration_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -779,28 +895,28 @@ func (x *ListCloudServiceConfigurationsResponse) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCloudServiceConfigurationsResponse.ProtoReflect.Descriptor instead.
-func (*ListCloudServiceConfigurationsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FindCollectionModuleRequest.ProtoReflect.Descriptor instead.
+func (*FindCollectionModuleRequest) Descriptor() ([]byte, []int) {
 	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ListCloudServiceConfigurationsResponse) GetConfigurations() []*collection.ServiceConfiguration {
+func (x *FindCollectionModuleRequest) GetMetricId() string {
 	if x != nil {
-		return x.Configurations
+		return x.MetricId
 	}
-	return nil
+	return ""
 }
 
-type Configurations struct {
+type RemoveCollectionModuleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Configurations []*collection.ServiceConfiguration `protobuf:"bytes,1,rep,name=configurations,proto3" json:"configurations,omitempty"`
+	ModuleId string `protobuf:"bytes,1,opt,name=module_id,json=moduleId,proto3" json:"module_id,omitempty"`
 }
 
-func (x *Configurations) Reset() {
-	*x = Configurations{}
+func (x *RemoveCollectionModuleRequest) Reset() {
+	*x = RemoveCollectionModuleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_configuration_configuration_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -808,13 +924,13 @@ func (x *Configurations) Reset() {
 	}
 }
 
-func (x *Configurations) String() string {
+func (x *RemoveCollectionModuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Configurations) ProtoMessage() {}
+func (*RemoveCollectionModuleRequest) ProtoMessage() {}
 
-func (x *Configurations) ProtoReflect() protoreflect.Message {
+func (x *RemoveCollectionModuleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_configuration_configuration_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -826,36 +942,28 @@ func (x *Configurations) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Configurations.ProtoReflect.Descriptor instead.
-func (*Configurations) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveCollectionModuleRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCollectionModuleRequest) Descriptor() ([]byte, []int) {
 	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Configurations) GetConfigurations() []*collection.ServiceConfiguration {
+func (x *RemoveCollectionModuleRequest) GetModuleId() string {
 	if x != nil {
-		return x.Configurations
+		return x.ModuleId
 	}
-	return nil
+	return ""
 }
 
-type MonitoringStatus struct {
+type RegisterCollectionModuleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Reference to the service whose status is requested
-	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	// Reference to the controls that are currently being monitored
-	ControlIds []string `protobuf:"bytes,2,rep,name=control_ids,json=controlIds,proto3" json:"control_ids,omitempty"`
-	// Time when the service was last monitored. Empty when it hasn't started yet
-	LastRun *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty"`
-	// Time when the service will be monitored next time. Empty when monitoring is
-	// not running.
-	NextRun *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
+	Module *collection.CollectionModule `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
 }
 
-func (x *MonitoringStatus) Reset() {
-	*x = MonitoringStatus{}
+func (x *RegisterCollectionModuleRequest) Reset() {
+	*x = RegisterCollectionModuleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_configuration_configuration_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -863,13 +971,13 @@ func (x *MonitoringStatus) Reset() {
 	}
 }
 
-func (x *MonitoringStatus) String() string {
+func (x *RegisterCollectionModuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MonitoringStatus) ProtoMessage() {}
+func (*RegisterCollectionModuleRequest) ProtoMessage() {}
 
-func (x *MonitoringStatus) ProtoReflect() protoreflect.Message {
+func (x *RegisterCollectionModuleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_configuration_configuration_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -881,150 +989,2441 @@ func (x *MonitoringStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MonitoringStatus.ProtoReflect.Descriptor instead.
-func (*MonitoringStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegisterCollectionModuleRequest.ProtoReflect.Descriptor instead.
+func (*RegisterCollectionModuleRequest) Descriptor() ([]byte, []int) {
 	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *MonitoringStatus) GetServiceId() string {
+func (x *RegisterCollectionModuleRequest) GetModule() *collection.CollectionModule {
 	if x != nil {
-		return x.ServiceId
+		return x.Module
+	}
+	return nil
+}
+
+type RenewCollectionModuleLeaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModuleId string `protobuf:"bytes,1,opt,name=module_id,json=moduleId,proto3" json:"module_id,omitempty"`
+	// address identifies the instance of the collection module, if several
+	// instances are registered. If it is empty, the leases of all instances are
+	// renewed.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *RenewCollectionModuleLeaseRequest) Reset() {
+	*x = RenewCollectionModuleLeaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenewCollectionModuleLeaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewCollectionModuleLeaseRequest) ProtoMessage() {}
+
+func (x *RenewCollectionModuleLeaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewCollectionModuleLeaseRequest.ProtoReflect.Descriptor instead.
+func (*RenewCollectionModuleLeaseRequest) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RenewCollectionModuleLeaseRequest) GetModuleId() string {
+	if x != nil {
+		return x.ModuleId
 	}
 	return ""
 }
 
-func (x *MonitoringStatus) GetControlIds() []string {
+func (x *RenewCollectionModuleLeaseRequest) GetAddress() string {
 	if x != nil {
-		return x.ControlIds
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-func (x *MonitoringStatus) GetLastRun() *timestamppb.Timestamp {
+// A lease of a self-registered collection module
+type CollectionModuleLease struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModuleId string `protobuf:"bytes,1,opt,name=module_id,json=moduleId,proto3" json:"module_id,omitempty"`
+	// expires_at contains the time at which the lease expires, if it is not
+	// renewed
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// ttl contains the duration in seconds of the lease. The collection module
+	// should renew its lease well before it expires, e.g., after a third of the
+	// duration.
+	Ttl int64 `protobuf:"varint,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *CollectionModuleLease) Reset() {
+	*x = CollectionModuleLease{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionModuleLease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionModuleLease) ProtoMessage() {}
+
+func (x *CollectionModuleLease) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionModuleLease.ProtoReflect.Descriptor instead.
+func (*CollectionModuleLease) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CollectionModuleLease) GetModuleId() string {
 	if x != nil {
-		return x.LastRun
+		return x.ModuleId
 	}
-	return nil
+	return ""
 }
 
-func (x *MonitoringStatus) GetNextRun() *timestamppb.Timestamp {
+func (x *CollectionModuleLease) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.NextRun
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-var File_api_configuration_configuration_proto protoreflect.FileDescriptor
+func (x *CollectionModuleLease) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
 
-var file_api_configuration_configuration_proto_rawDesc = []byte{
-	0x0a, 0x25, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x63, 0x61, 0x6d, 0x1a, 0x1b, 0x61, 0x70,
-	0x69, 0x2f, 0x61, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x6d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x61, 0x70, 0x69, 0x2f, 0x63,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x61, 0x70, 0x69, 0x2f,
-	0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x6f, 0x72, 0x63,
-	0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65,
+type ConfigureCloudServiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId      string          `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Configurations *Configurations `protobuf:"bytes,2,opt,name=configurations,proto3" json:"configurations,omitempty"`
+}
+
+func (x *ConfigureCloudServiceRequest) Reset() {
+	*x = ConfigureCloudServiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigureCloudServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureCloudServiceRequest) ProtoMessage() {}
+
+func (x *ConfigureCloudServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureCloudServiceRequest.ProtoReflect.Descriptor instead.
+func (*ConfigureCloudServiceRequest) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ConfigureCloudServiceRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *ConfigureCloudServiceRequest) GetConfigurations() *Configurations {
+	if x != nil {
+		return x.Configurations
+	}
+	return nil
+}
+
+type ConfigureCloudServiceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ConfigureCloudServiceResponse) Reset() {
+	*x = ConfigureCloudServiceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigureCloudServiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureCloudServiceResponse) ProtoMessage() {}
+
+func (x *ConfigureCloudServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureCloudServiceResponse.ProtoReflect.Descriptor instead.
+func (*ConfigureCloudServiceResponse) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{21}
+}
+
+type ListCloudServiceConfigurationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+}
+
+func (x *ListCloudServiceConfigurationsRequest) Reset() {
+	*x = ListCloudServiceConfigurationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCloudServiceConfigurationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCloudServiceConfigurationsRequest) ProtoMessage() {}
+
+func (x *ListCloudServiceConfigurationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCloudServiceConfigurationsRequest.ProtoReflect.Descriptor instead.
+func (*ListCloudServiceConfigurationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListCloudServiceConfigurationsRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+type ListCloudServiceConfigurationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Configurations []*collection.ServiceConfiguration `protobuf:"bytes,1,rep,name=configurations,proto3" json:"configurations,omitempty"`
+}
+
+func (x *ListCloudServiceConfigurationsResponse) Reset() {
+	*x = ListCloudServiceConfigurationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCloudServiceConfigurationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCloudServiceConfigurationsResponse) ProtoMessage() {}
+
+func (x *ListCloudServiceConfigurationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCloudServiceConfigurationsResponse.ProtoReflect.Descriptor instead.
+func (*ListCloudServiceConfigurationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListCloudServiceConfigurationsResponse) GetConfigurations() []*collection.ServiceConfiguration {
+	if x != nil {
+		return x.Configurations
+	}
+	return nil
+}
+
+type Configurations struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Configurations []*collection.ServiceConfiguration `protobuf:"bytes,1,rep,name=configurations,proto3" json:"configurations,omitempty"`
+}
+
+func (x *Configurations) Reset() {
+	*x = Configurations{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Configurations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Configurations) ProtoMessage() {}
+
+func (x *Configurations) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Configurations.ProtoReflect.Descriptor instead.
+func (*Configurations) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Configurations) GetConfigurations() []*collection.ServiceConfiguration {
+	if x != nil {
+		return x.Configurations
+	}
+	return nil
+}
+
+type MonitoringStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Reference to the service whose status is requested
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// Reference to the controls that are currently being monitored
+	ControlIds []string `protobuf:"bytes,2,rep,name=control_ids,json=controlIds,proto3" json:"control_ids,omitempty"`
+	// Time when the service was last monitored. Empty when it hasn't started yet
+	LastRun *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty" gorm:"serializer:timestamppb;type:time"`
+	// Time when the service will be monitored next time. Empty when monitoring is
+	// not running.
+	NextRun *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty" gorm:"serializer:timestamppb;type:time"`
+	// The scheduled jobs, one per collection module
+	Jobs []*MonitoringJob `protobuf:"bytes,5,rep,name=jobs,proto3" json:"jobs,omitempty" gorm:"-"`
+	// The effective debouncing of the compliance calculation
+	Debounce *DebounceConfig `protobuf:"bytes,6,opt,name=debounce,proto3" json:"debounce,omitempty" gorm:"-"`
+}
+
+func (x *MonitoringStatus) Reset() {
+	*x = MonitoringStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MonitoringStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonitoringStatus) ProtoMessage() {}
+
+func (x *MonitoringStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonitoringStatus.ProtoReflect.Descriptor instead.
+func (*MonitoringStatus) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MonitoringStatus) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *MonitoringStatus) GetControlIds() []string {
+	if x != nil {
+		return x.ControlIds
+	}
+	return nil
+}
+
+func (x *MonitoringStatus) GetLastRun() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRun
+	}
+	return nil
+}
+
+func (x *MonitoringStatus) GetNextRun() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRun
+	}
+	return nil
+}
+
+func (x *MonitoringStatus) GetJobs() []*MonitoringJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *MonitoringStatus) GetDebounce() *DebounceConfig {
+	if x != nil {
+		return x.Debounce
+	}
+	return nil
+}
+
+// A job, which periodically triggers a collection module to collect evidences
+// for a service
+type MonitoringJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModuleId   string `protobuf:"bytes,1,opt,name=module_id,json=moduleId,proto3" json:"module_id,omitempty"`
+	ModuleName string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// The monitored metrics the collection module collects evidences for
+	MetricIds []string `protobuf:"bytes,3,rep,name=metric_ids,json=metricIds,proto3" json:"metric_ids,omitempty"`
+	// The schedule of the job. Its interval is derived from the metrics, unless
+	// it is overridden for the service.
+	Schedule *CollectionSchedule `protobuf:"bytes,4,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// The maximum random delay in seconds of each run to spread the load on the
+	// collection modules
+	Jitter  int64                  `protobuf:"varint,5,opt,name=jitter,proto3" json:"jitter,omitempty"`
+	LastRun *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty"`
+	NextRun *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
+	// The error of the last run, if it failed, e.g., because the collection
+	// module was unreachable
+	LastError string `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// The number of failed runs since the last successful run
+	ConsecutiveFailures int64 `protobuf:"varint,9,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	// The number of evidences the evaluation manager received from the
+	// collection module for the service in the last run
+	EvidenceCount int64 `protobuf:"varint,10,opt,name=evidence_count,json=evidenceCount,proto3" json:"evidence_count,omitempty"`
+}
+
+func (x *MonitoringJob) Reset() {
+	*x = MonitoringJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MonitoringJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonitoringJob) ProtoMessage() {}
+
+func (x *MonitoringJob) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonitoringJob.ProtoReflect.Descriptor instead.
+func (*MonitoringJob) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MonitoringJob) GetModuleId() string {
+	if x != nil {
+		return x.ModuleId
+	}
+	return ""
+}
+
+func (x *MonitoringJob) GetModuleName() string {
+	if x != nil {
+		return x.ModuleName
+	}
+	return ""
+}
+
+func (x *MonitoringJob) GetMetricIds() []string {
+	if x != nil {
+		return x.MetricIds
+	}
+	return nil
+}
+
+func (x *MonitoringJob) GetSchedule() *CollectionSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *MonitoringJob) GetJitter() int64 {
+	if x != nil {
+		return x.Jitter
+	}
+	return 0
+}
+
+func (x *MonitoringJob) GetLastRun() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRun
+	}
+	return nil
+}
+
+func (x *MonitoringJob) GetNextRun() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRun
+	}
+	return nil
+}
+
+func (x *MonitoringJob) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *MonitoringJob) GetConsecutiveFailures() int64 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *MonitoringJob) GetEvidenceCount() int64 {
+	if x != nil {
+		return x.EvidenceCount
+	}
+	return 0
+}
+
+// A trust anchor (CA certificate) for the verification of attestation reports
+// and manifests
+type AttestationTrustAnchor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primaryKey"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The PEM-encoded CA certificate
+	Certificate string `protobuf:"bytes,3,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	// The version of the trust store in which this trust anchor was last changed
+	Version   int64                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" gorm:"serializer:timestamppb;type:time"`
+}
+
+func (x *AttestationTrustAnchor) Reset() {
+	*x = AttestationTrustAnchor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttestationTrustAnchor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttestationTrustAnchor) ProtoMessage() {}
+
+func (x *AttestationTrustAnchor) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttestationTrustAnchor.ProtoReflect.Descriptor instead.
+func (*AttestationTrustAnchor) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AttestationTrustAnchor) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AttestationTrustAnchor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AttestationTrustAnchor) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+func (x *AttestationTrustAnchor) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *AttestationTrustAnchor) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// A reference manifest contains the trusted reference values of a component of
+// an attested device. It is matched against the manifests within the
+// attestation report by its name.
+type AttestationReferenceManifest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primaryKey"`
+	// The name of the RTM, OS or app manifest this reference applies to
+	Name            string                       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ReferenceValues []*AttestationReferenceValue `protobuf:"bytes,3,rep,name=reference_values,json=referenceValues,proto3" json:"reference_values,omitempty" gorm:"serializer:json"`
+	// The version of the trust store in which this manifest was last changed
+	Version   int64                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" gorm:"serializer:timestamppb;type:time"`
+}
+
+func (x *AttestationReferenceManifest) Reset() {
+	*x = AttestationReferenceManifest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttestationReferenceManifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttestationReferenceManifest) ProtoMessage() {}
+
+func (x *AttestationReferenceManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttestationReferenceManifest.ProtoReflect.Descriptor instead.
+func (*AttestationReferenceManifest) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AttestationReferenceManifest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AttestationReferenceManifest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AttestationReferenceManifest) GetReferenceValues() []*AttestationReferenceValue {
+	if x != nil {
+		return x.ReferenceValues
+	}
+	return nil
+}
+
+func (x *AttestationReferenceManifest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *AttestationReferenceManifest) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// A trusted reference value of a single measurement
+type AttestationReferenceValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the verification (e.g. the name of the measured binary)
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The PCR the measurement is extended into (TPM only)
+	Pcr *int32 `protobuf:"varint,2,opt,name=pcr,proto3,oneof" json:"pcr,omitempty"`
+	// The hex-encoded SHA-256 (TPM, SW) or SHA-384 (SNP) reference hash
+	Sha256 string `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	Sha384 string `protobuf:"bytes,4,opt,name=sha384,proto3" json:"sha384,omitempty"`
+}
+
+func (x *AttestationReferenceValue) Reset() {
+	*x = AttestationReferenceValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttestationReferenceValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttestationReferenceValue) ProtoMessage() {}
+
+func (x *AttestationReferenceValue) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttestationReferenceValue.ProtoReflect.Descriptor instead.
+func (*AttestationReferenceValue) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AttestationReferenceValue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AttestationReferenceValue) GetPcr() int32 {
+	if x != nil && x.Pcr != nil {
+		return *x.Pcr
+	}
+	return 0
+}
+
+func (x *AttestationReferenceValue) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *AttestationReferenceValue) GetSha384() string {
+	if x != nil {
+		return x.Sha384
+	}
+	return ""
+}
+
+// A revoked certificate, identified by its subject key identifier
+type RevokedCertificate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hex-encoded subject key identifier of the certificate
+	SubjectKeyId string `protobuf:"bytes,1,opt,name=subject_key_id,json=subjectKeyId,proto3" json:"subject_key_id,omitempty" gorm:"primaryKey"`
+	Reason       string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The version of the trust store in which the certificate was revoked
+	Version   int64                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	RevokedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty" gorm:"serializer:timestamppb;type:time"`
+}
+
+func (x *RevokedCertificate) Reset() {
+	*x = RevokedCertificate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokedCertificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokedCertificate) ProtoMessage() {}
+
+func (x *RevokedCertificate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokedCertificate.ProtoReflect.Descriptor instead.
+func (*RevokedCertificate) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RevokedCertificate) GetSubjectKeyId() string {
+	if x != nil {
+		return x.SubjectKeyId
+	}
+	return ""
+}
+
+func (x *RevokedCertificate) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RevokedCertificate) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RevokedCertificate) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+// The attestation trust store contains everything needed to verify attestation
+// reports
+type AttestationTrustStore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The version of the trust store. It is increased on every change.
+	Version             int64                           `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	TrustAnchors        []*AttestationTrustAnchor       `protobuf:"bytes,2,rep,name=trust_anchors,json=trustAnchors,proto3" json:"trust_anchors,omitempty"`
+	ReferenceManifests  []*AttestationReferenceManifest `protobuf:"bytes,3,rep,name=reference_manifests,json=referenceManifests,proto3" json:"reference_manifests,omitempty"`
+	RevokedCertificates []*RevokedCertificate           `protobuf:"bytes,4,rep,name=revoked_certificates,json=revokedCertificates,proto3" json:"revoked_certificates,omitempty"`
+}
+
+func (x *AttestationTrustStore) Reset() {
+	*x = AttestationTrustStore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttestationTrustStore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttestationTrustStore) ProtoMessage() {}
+
+func (x *AttestationTrustStore) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttestationTrustStore.ProtoReflect.Descriptor instead.
+func (*AttestationTrustStore) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AttestationTrustStore) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *AttestationTrustStore) GetTrustAnchors() []*AttestationTrustAnchor {
+	if x != nil {
+		return x.TrustAnchors
+	}
+	return nil
+}
+
+func (x *AttestationTrustStore) GetReferenceManifests() []*AttestationReferenceManifest {
+	if x != nil {
+		return x.ReferenceManifests
+	}
+	return nil
+}
+
+func (x *AttestationTrustStore) GetRevokedCertificates() []*RevokedCertificate {
+	if x != nil {
+		return x.RevokedCertificates
+	}
+	return nil
+}
+
+// The tenant, i.e., the organisation, a cloud service belongs to. Services
+// without tenant are only visible to callers without tenant.
+type CloudServiceTenant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty" gorm:"primaryKey"`
+	TenantId  string `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+}
+
+func (x *CloudServiceTenant) Reset() {
+	*x = CloudServiceTenant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloudServiceTenant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloudServiceTenant) ProtoMessage() {}
+
+func (x *CloudServiceTenant) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloudServiceTenant.ProtoReflect.Descriptor instead.
+func (*CloudServiceTenant) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CloudServiceTenant) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *CloudServiceTenant) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// The persisted version of the attestation trust store. There is only a single
+// entry.
+type AttestationTrustStoreVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primaryKey"`
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *AttestationTrustStoreVersion) Reset() {
+	*x = AttestationTrustStoreVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttestationTrustStoreVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttestationTrustStoreVersion) ProtoMessage() {}
+
+func (x *AttestationTrustStoreVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttestationTrustStoreVersion.ProtoReflect.Descriptor instead.
+func (*AttestationTrustStoreVersion) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AttestationTrustStoreVersion) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AttestationTrustStoreVersion) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type StoreAttestationTrustAnchorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Anchor *AttestationTrustAnchor `protobuf:"bytes,1,opt,name=anchor,proto3" json:"anchor,omitempty"`
+}
+
+func (x *StoreAttestationTrustAnchorRequest) Reset() {
+	*x = StoreAttestationTrustAnchorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreAttestationTrustAnchorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreAttestationTrustAnchorRequest) ProtoMessage() {}
+
+func (x *StoreAttestationTrustAnchorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreAttestationTrustAnchorRequest.ProtoReflect.Descriptor instead.
+func (*StoreAttestationTrustAnchorRequest) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *StoreAttestationTrustAnchorRequest) GetAnchor() *AttestationTrustAnchor {
+	if x != nil {
+		return x.Anchor
+	}
+	return nil
+}
+
+type ListAttestationTrustAnchorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListAttestationTrustAnchorsRequest) Reset() {
+	*x = ListAttestationTrustAnchorsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAttestationTrustAnchorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttestationTrustAnchorsRequest) ProtoMessage() {}
+
+func (x *ListAttestationTrustAnchorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttestationTrustAnchorsRequest.ProtoReflect.Descriptor instead.
+func (*ListAttestationTrustAnchorsRequest) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{35}
+}
+
+type ListAttestationTrustAnchorsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Anchors []*AttestationTrustAnchor `protobuf:"bytes,1,rep,name=anchors,proto3" json:"anchors,omitempty"`
+}
+
+func (x *ListAttestationTrustAnchorsResponse) Reset() {
+	*x = ListAttestationTrustAnchorsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAttestationTrustAnchorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttestationTrustAnchorsResponse) ProtoMessage() {}
+
+func (x *ListAttestationTrustAnchorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttestationTrustAnchorsResponse.ProtoReflect.Descriptor instead.
+func (*ListAttestationTrustAnchorsResponse) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListAttestationTrustAnchorsResponse) GetAnchors() []*AttestationTrustAnchor {
+	if x != nil {
+		return x.Anchors
+	}
+	return nil
+}
+
+type RemoveAttestationTrustAnchorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AnchorId string `protobuf:"bytes,1,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+}
+
+func (x *RemoveAttestationTrustAnchorRequest) Reset() {
+	*x = RemoveAttestationTrustAnchorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveAttestationTrustAnchorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAttestationTrustAnchorRequest) ProtoMessage() {}
+
+func (x *RemoveAttestationTrustAnchorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAttestationTrustAnchorRequest.ProtoReflect.Descriptor instead.
+func (*RemoveAttestationTrustAnchorRequest) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RemoveAttestationTrustAnchorRequest) GetAnchorId() string {
+	if x != nil {
+		return x.AnchorId
+	}
+	return ""
+}
+
+type StoreAttestationReferenceManifestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Manifest *AttestationReferenceManifest `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
+}
+
+func (x *StoreAttestationReferenceManifestRequest) Reset() {
+	*x = StoreAttestationReferenceManifestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreAttestationReferenceManifestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreAttestationReferenceManifestRequest) ProtoMessage() {}
+
+func (x *StoreAttestationReferenceManifestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreAttestationReferenceManifestRequest.ProtoReflect.Descriptor instead.
+func (*StoreAttestationReferenceManifestRequest) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *StoreAttestationReferenceManifestRequest) GetManifest() *AttestationReferenceManifest {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+type ListAttestationReferenceManifestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListAttestationReferenceManifestsRequest) Reset() {
+	*x = ListAttestationReferenceManifestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAttestationReferenceManifestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttestationReferenceManifestsRequest) ProtoMessage() {}
+
+func (x *ListAttestationReferenceManifestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttestationReferenceManifestsRequest.ProtoReflect.Descriptor instead.
+func (*ListAttestationReferenceManifestsRequest) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{39}
+}
+
+type ListAttestationReferenceManifestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Manifests []*AttestationReferenceManifest `protobuf:"bytes,1,rep,name=manifests,proto3" json:"manifests,omitempty"`
+}
+
+func (x *ListAttestationReferenceManifestsResponse) Reset() {
+	*x = ListAttestationReferenceManifestsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAttestationReferenceManifestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttestationReferenceManifestsResponse) ProtoMessage() {}
+
+func (x *ListAttestationReferenceManifestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttestationReferenceManifestsResponse.ProtoReflect.Descriptor instead.
+func (*ListAttestationReferenceManifestsResponse) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListAttestationReferenceManifestsResponse) GetManifests() []*AttestationReferenceManifest {
+	if x != nil {
+		return x.Manifests
+	}
+	return nil
+}
+
+type RemoveAttestationReferenceManifestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ManifestId string `protobuf:"bytes,1,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
+}
+
+func (x *RemoveAttestationReferenceManifestRequest) Reset() {
+	*x = RemoveAttestationReferenceManifestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveAttestationReferenceManifestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAttestationReferenceManifestRequest) ProtoMessage() {}
+
+func (x *RemoveAttestationReferenceManifestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAttestationReferenceManifestRequest.ProtoReflect.Descriptor instead.
+func (*RemoveAttestationReferenceManifestRequest) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RemoveAttestationReferenceManifestRequest) GetManifestId() string {
+	if x != nil {
+		return x.ManifestId
+	}
+	return ""
+}
+
+type RevokeAttestationCertificateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Certificate *RevokedCertificate `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+}
+
+func (x *RevokeAttestationCertificateRequest) Reset() {
+	*x = RevokeAttestationCertificateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeAttestationCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAttestationCertificateRequest) ProtoMessage() {}
+
+func (x *RevokeAttestationCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAttestationCertificateRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAttestationCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RevokeAttestationCertificateRequest) GetCertificate() *RevokedCertificate {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+type ListRevokedAttestationCertificatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListRevokedAttestationCertificatesRequest) Reset() {
+	*x = ListRevokedAttestationCertificatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRevokedAttestationCertificatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRevokedAttestationCertificatesRequest) ProtoMessage() {}
+
+func (x *ListRevokedAttestationCertificatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRevokedAttestationCertificatesRequest.ProtoReflect.Descriptor instead.
+func (*ListRevokedAttestationCertificatesRequest) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{43}
+}
+
+type ListRevokedAttestationCertificatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Certificates []*RevokedCertificate `protobuf:"bytes,1,rep,name=certificates,proto3" json:"certificates,omitempty"`
+}
+
+func (x *ListRevokedAttestationCertificatesResponse) Reset() {
+	*x = ListRevokedAttestationCertificatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRevokedAttestationCertificatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRevokedAttestationCertificatesResponse) ProtoMessage() {}
+
+func (x *ListRevokedAttestationCertificatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRevokedAttestationCertificatesResponse.ProtoReflect.Descriptor instead.
+func (*ListRevokedAttestationCertificatesResponse) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListRevokedAttestationCertificatesResponse) GetCertificates() []*RevokedCertificate {
+	if x != nil {
+		return x.Certificates
+	}
+	return nil
+}
+
+type GetAttestationTrustStoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional. The version of the trust store known to the caller. If the trust
+	// store has not changed since, only the version is returned.
+	KnownVersion int64 `protobuf:"varint,1,opt,name=known_version,json=knownVersion,proto3" json:"known_version,omitempty"`
+}
+
+func (x *GetAttestationTrustStoreRequest) Reset() {
+	*x = GetAttestationTrustStoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAttestationTrustStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttestationTrustStoreRequest) ProtoMessage() {}
+
+func (x *GetAttestationTrustStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttestationTrustStoreRequest.ProtoReflect.Descriptor instead.
+func (*GetAttestationTrustStoreRequest) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetAttestationTrustStoreRequest) GetKnownVersion() int64 {
+	if x != nil {
+		return x.KnownVersion
+	}
+	return 0
+}
+
+// An audit event records a call of a modifying RPC of the Configuration
+// service
+type AuditEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primaryKey"`
+	// Time of the call
+	Time *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty" gorm:"serializer:timestamppb;type:time;index"`
+	// The subject of the token of the caller. Empty, if the API is not secured
+	Actor string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty" gorm:"index"`
+	// The name of the RPC, e.g., UpdateMetricConfiguration
+	Action string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty" gorm:"index"`
+	// The cloud service affected by the call, if any
+	ServiceId string `protobuf:"bytes,5,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty" gorm:"index"`
+	// The target of the call within the service or the CAM, e.g., the metric ID
+	// or the collection module ID
+	Target string `protobuf:"bytes,6,opt,name=target,proto3" json:"target,omitempty"`
+	// The gRPC status code of the call, e.g., OK or PermissionDenied
+	Status string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	// The tenant of the service or, if there is no service, of the caller
+	TenantId string `protobuf:"bytes,8,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// JSON representation of the target before and after the call. Secrets are
+	// redacted.
+	Before string `protobuf:"bytes,9,opt,name=before,proto3" json:"before,omitempty"`
+	After  string `protobuf:"bytes,10,opt,name=after,proto3" json:"after,omitempty"`
+	// The changes between before and after
+	Changes []*AuditChange `protobuf:"bytes,11,rep,name=changes,proto3" json:"changes,omitempty" gorm:"serializer:json"`
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AuditEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetChanges() []*AuditChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// A changed field of the target of an audit event
+type AuditChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The path of the field, e.g., interval or configurations.0.serviceId
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// JSON representation of the value before and after the change. Empty, if
+	// the field has been added or removed.
+	Before string `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After  string `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (x *AuditChange) Reset() {
+	*x = AuditChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditChange) ProtoMessage() {}
+
+func (x *AuditChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditChange.ProtoReflect.Descriptor instead.
+func (*AuditChange) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AuditChange) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *AuditChange) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditChange) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+type ListAuditEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional. Only events of the service
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// Optional. Only events of the actor
+	Actor string `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Optional. Only events of the action
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// Optional. Only events at or after this time
+	From *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	// Optional. Only events at or before this time
+	To        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	PageToken string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32                  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	OrderBy   string                 `protobuf:"bytes,8,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Asc       bool                   `protobuf:"varint,9,opt,name=asc,proto3" json:"asc,omitempty"`
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListAuditEventsRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetAsc() bool {
+	if x != nil {
+		return x.Asc
+	}
+	return false
+}
+
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events        []*AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextPageToken string        `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_configuration_configuration_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_configuration_configuration_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_api_configuration_configuration_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_api_configuration_configuration_proto protoreflect.FileDescriptor
+
+var file_api_configuration_configuration_proto_rawDesc = []byte{
+	0x0a, 0x25, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x63, 0x61, 0x6d, 0x1a, 0x1b, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x61, 0x70, 0x69, 0x2f, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x61, 0x70, 0x69, 0x2f,
+	0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x6f, 0x72, 0x63,
+	0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65,
 	0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
 	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x74, 0x61, 0x67,
 	0x67, 0x65, 0x72, 0x2f, 0x74, 0x61, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x58, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x49, 0x64, 0x73, 0x22, 0x48, 0x0a, 0x17, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x4d, 0x6f, 0x6e, 0x69,
-	0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x22, 0x36, 0x0a, 0x15, 0x53, 0x74, 0x6f, 0x70, 0x4d, 0x6f, 0x6e, 0x69,
-	0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
-	0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0x18, 0x0a, 0x16,
-	0x53, 0x74, 0x6f, 0x70, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x6e,
-	0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x22, 0xc0, 0x01, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x73, 0x12, 0x2f, 0x0a, 0x08, 0x64, 0x65, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x44, 0x65, 0x62, 0x6f, 0x75,
+	0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x64, 0x65, 0x62, 0x6f, 0x75,
+	0x6e, 0x63, 0x65, 0x22, 0x61, 0x0a, 0x12, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x22, 0x48, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x46, 0x0a, 0x0e, 0x44, 0x65, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0xc1, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
 	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x49, 0x64, 0x22, 0x2f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x49, 0x64, 0x22, 0x51, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x49, 0x64, 0x22, 0x1e, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x50, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x75,
-	0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x61, 0x6d, 0x2e,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-	0x52, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x1a, 0x41, 0x64, 0x64,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06,
-	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x3a, 0x0a, 0x1b, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x49, 0x64, 0x22, 0x3c, 0x0a, 0x1d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64,
-	0x22, 0x7a, 0x0a, 0x1c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x43, 0x6c, 0x6f,
-	0x75, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x49, 0x64, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x08, 0x64,
+	0x65, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x63, 0x61, 0x6d, 0x2e, 0x44, 0x65, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x08, 0x64, 0x65, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x22, 0x49, 0x0a, 0x18,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x22, 0x36, 0x0a, 0x15, 0x53,
+	0x74, 0x6f, 0x70, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x53, 0x74, 0x6f, 0x70, 0x4d, 0x6f, 0x6e, 0x69, 0x74,
+	0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x0a,
+	0x1a, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0x2f, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x49, 0x64, 0x22, 0x51, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12,
-	0x3b, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0e, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x1f, 0x0a, 0x1d,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x0a,
-	0x25, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0x6b, 0x0a, 0x26, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x6f,
-	0x75, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x41, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x22, 0x53, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x41, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
-	0x61, 0x6d, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9c, 0x02, 0x0a, 0x10, 0x4d, 0x6f, 0x6e, 0x69,
-	0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x63, 0x0a, 0x08,
-	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x2c, 0x9a, 0x84, 0x9e, 0x03,
-	0x27, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65,
-	0x72, 0x3a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x70, 0x62, 0x3b, 0x74, 0x79,
-	0x70, 0x65, 0x3a, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x75,
-	0x6e, 0x12, 0x63, 0x0a, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x04, 0x20,
+	0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x49, 0x64, 0x22, 0x1e,
+	0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x50,
+	0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2f, 0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73,
+	0x22, 0x4b, 0x0a, 0x1a, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d,
+	0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x3a, 0x0a,
+	0x1b, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x49, 0x64, 0x22, 0x3c, 0x0a, 0x1d, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x50, 0x0a, 0x1f, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x61, 0x6d,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x5a, 0x0a, 0x21, 0x52, 0x65, 0x6e,
+	0x65, 0x77, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x7a, 0x0a, 0x1c, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x1f, 0x0a, 0x1d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x65, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x0a, 0x25, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c,
+	0x6f, 0x75, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0x6b,
+	0x0a, 0x26, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x53, 0x0a, 0x0e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x41, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x93, 0x03, 0x0a, 0x10, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x63, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x75,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x2c, 0x9a, 0x84, 0x9e, 0x03, 0x27, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22,
+	0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x70, 0x62, 0x3b, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x74, 0x69, 0x6d, 0x65,
+	0x22, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x12, 0x63, 0x0a, 0x08, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x2c, 0x9a, 0x84, 0x9e, 0x03, 0x27, 0x67,
+	0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x70, 0x62, 0x3b, 0x74, 0x79, 0x70, 0x65,
+	0x3a, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x12,
+	0x35, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x63, 0x61, 0x6d, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4a, 0x6f,
+	0x62, 0x42, 0x0d, 0x9a, 0x84, 0x9e, 0x03, 0x08, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x2d, 0x22,
+	0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x3e, 0x0a, 0x08, 0x64, 0x65, 0x62, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x44,
+	0x65, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x0d, 0x9a,
+	0x84, 0x9e, 0x03, 0x08, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x2d, 0x22, 0x52, 0x08, 0x64, 0x65,
+	0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x22, 0xa0, 0x03, 0x0a, 0x0d, 0x4d, 0x6f, 0x6e, 0x69, 0x74,
+	0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x49, 0x64, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6a, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x12, 0x35, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x12, 0x35, 0x0a, 0x08, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x31, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x63,
+	0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x65, 0x76, 0x69, 0x64,
+	0x65, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf9, 0x01, 0x0a, 0x16, 0x41, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x41, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x16, 0x9a, 0x84, 0x9e, 0x03, 0x11, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x70, 0x72, 0x69,
+	0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x67, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x2c, 0x9a, 0x84,
+	0x9e, 0x03, 0x27, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x72, 0x3a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x70, 0x62, 0x3b,
+	0x74, 0x79, 0x70, 0x65, 0x3a, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xc5, 0x02, 0x0a, 0x1c, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x16, 0x9a, 0x84, 0x9e, 0x03, 0x11, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x70,
+	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x66, 0x0a, 0x10, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63,
+	0x61, 0x6d, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x1b, 0x9a, 0x84,
+	0x9e, 0x03, 0x16, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x72, 0x3a, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x52, 0x0f, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x67, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x2c, 0x9a, 0x84, 0x9e, 0x03, 0x27, 0x67, 0x6f, 0x72, 0x6d, 0x3a,
+	0x22, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x70, 0x62, 0x3b, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x74, 0x69, 0x6d,
+	0x65, 0x22, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x7e, 0x0a,
+	0x19, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x15,
+	0x0a, 0x03, 0x70, 0x63, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x03, 0x70,
+	0x63, 0x72, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x68, 0x61, 0x33, 0x38, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x68, 0x61, 0x33, 0x38, 0x34, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x70, 0x63, 0x72, 0x22, 0xed, 0x01,
+	0x0a, 0x12, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0x9a, 0x84,
+	0x9e, 0x03, 0x11, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
+	0x4b, 0x65, 0x79, 0x22, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4b, 0x65, 0x79,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x67, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x2c, 0x9a, 0x84, 0x9e, 0x03, 0x27, 0x67, 0x6f, 0x72, 0x6d, 0x3a,
+	0x22, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x70, 0x62, 0x3b, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x74, 0x69, 0x6d,
+	0x65, 0x22, 0x52, 0x09, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x22, 0x93, 0x02,
+	0x0a, 0x15, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x75,
+	0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x40, 0x0a, 0x0d, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x61, 0x6e, 0x63, 0x68, 0x6f,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x41,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x41,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x52, 0x0c, 0x74, 0x72, 0x75, 0x73, 0x74, 0x41, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x73, 0x12, 0x52, 0x0a, 0x13, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x5f, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x52, 0x12, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x12, 0x4a, 0x0a, 0x14, 0x72, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x64, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x13,
+	0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x73, 0x22, 0x68, 0x0a, 0x12, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x0a, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0x9a,
+	0x84, 0x9e, 0x03, 0x11, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72,
+	0x79, 0x4b, 0x65, 0x79, 0x22, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x60, 0x0a,
+	0x1c, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x75, 0x73,
+	0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x16, 0x9a, 0x84, 0x9e, 0x03, 0x11,
+	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79,
+	0x22, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x59, 0x0a, 0x22, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x41, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x41, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x52, 0x06, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x22, 0x24, 0x0a, 0x22, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x75,
+	0x73, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x5c, 0x0a, 0x23, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x61, 0x6e, 0x63, 0x68, 0x6f,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x41,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x41,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x52, 0x07, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x22, 0x42,
+	0x0a, 0x23, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x49, 0x64, 0x22, 0x69, 0x0a, 0x28, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4d,
+	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d,
+	0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x22, 0x2a, 0x0a,
+	0x28, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6c, 0x0a, 0x29, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x61, 0x6d, 0x2e,
+	0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x09, 0x6d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x22, 0x4c, 0x0a, 0x29, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x6e, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x60, 0x0a, 0x23, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0b,
+	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0x2b, 0x0a, 0x29, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x69, 0x0a, 0x2a, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x64, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x52, 0x0c, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x22,
+	0x46, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xe2, 0x03, 0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x16, 0x9a, 0x84, 0x9e, 0x03, 0x11, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x70,
+	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x52, 0x02, 0x69, 0x64, 0x12, 0x62,
+	0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x32, 0x9a, 0x84, 0x9e, 0x03, 0x2d, 0x67,
+	0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x70, 0x62, 0x3b, 0x74, 0x79, 0x70, 0x65,
+	0x3a, 0x74, 0x69, 0x6d, 0x65, 0x3b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x11, 0x9a, 0x84, 0x9e, 0x03, 0x0c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x22, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x9a, 0x84, 0x9e,
+	0x03, 0x0c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x9a, 0x84, 0x9e, 0x03,
+	0x0c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x09, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x12, 0x47, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x1b, 0x9a, 0x84, 0x9e, 0x03, 0x16, 0x67, 0x6f, 0x72,
+	0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a, 0x6a, 0x73,
+	0x6f, 0x6e, 0x22, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x4f, 0x0a, 0x0b,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0xaa, 0x02,
+	0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
-	0x2c, 0x9a, 0x84, 0x9e, 0x03, 0x27, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69,
-	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x70, 0x62, 0x3b, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x07, 0x6e,
-	0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x32, 0xcb, 0x13, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74,
+	0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x63, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x73, 0x63, 0x22, 0x6a, 0x0a, 0x17, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x32, 0x9e, 0x25, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x66, 0x69,
 	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x88, 0x01, 0x0a, 0x0f, 0x53, 0x74, 0x61,
 	0x72, 0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x2e, 0x63,
 	0x61, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69,
@@ -1034,23 +3433,36 @@ var file_api_configuration_configuration_proto_rawDesc = []byte{
 	0x2f, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x2f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x7b, 0x73,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x3a, 0x01, 0x2a, 0x12, 0x81, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x70, 0x4d, 0x6f, 0x6e, 0x69,
-	0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x53, 0x74, 0x6f,
-	0x70, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x4d, 0x6f, 0x6e,
-	0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x22, 0x2e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e,
+	0x3a, 0x01, 0x2a, 0x12, 0x85, 0x01, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x1a, 0x29, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x7b, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x81, 0x01, 0x0a, 0x0e,
+	0x53, 0x74, 0x6f, 0x70, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x1a,
+	0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x61, 0x6d,
+	0x2e, 0x53, 0x74, 0x6f, 0x70, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x22,
+	0x2e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x7b, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x6f, 0x70, 0x12,
+	0x80, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e,
 	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6d, 0x6f, 0x6e, 0x69, 0x74,
 	0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69,
-	0x64, 0x7d, 0x2f, 0x73, 0x74, 0x6f, 0x70, 0x12, 0x80, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d,
-	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x1f, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
-	0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x15, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e,
-	0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12,
-	0x29, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x7b, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x6f, 0x0a, 0x0b, 0x4c, 0x69,
+	0x64, 0x7d, 0x12, 0x46, 0x0a, 0x0f, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x69, 0x64,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x6f, 0x0a, 0x0b, 0x4c, 0x69,
 	0x73, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x1d, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
 	0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
 	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
@@ -1119,74 +3531,203 @@ var file_api_configuration_configuration_proto_rawDesc = []byte{
 	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
 	0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x73, 0x3a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0xbf, 0x01, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x6f, 0x75,
-	0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x12, 0x3c, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x66,
+	0x6e, 0x73, 0x12, 0xa3, 0x01, 0x0a, 0x0e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x54, 0x65, 0x73, 0x74,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x58,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x52, 0x22, 0x41, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x66,
 	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f,
 	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
 	0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x84, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x6f, 0x75,
-	0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x21, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
-	0x69, 0x74, 0x6f, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x6c,
-	0x6f, 0x75, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x76,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x3a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xbf, 0x01, 0x0a, 0x1e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x2e, 0x63, 0x61,
+	0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x12, 0x3c, 0x2f, 0x76,
 	0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
 	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x88, 0x01, 0x0a, 0x11,
-	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x12, 0x23, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x69, 0x74,
-	0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x89, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x24, 0x2e,
-	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x35, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x2f, 0x2a, 0x2d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69,
-	0x64, 0x7d, 0x12, 0x7b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x73, 0x12, 0x22, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x69, 0x74,
-	0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x12,
-	0x5e, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x61,
-	0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x4d, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x41, 0x64, 0x64,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x54,
-	0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x42, 0x51, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x67, 0x61, 0x69, 0x61, 0x2d, 0x78, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x2d, 0x69,
-	0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2d, 0x66, 0x65,
-	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2f, 0x63, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x84, 0x01, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x21,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c,
+	0x6f, 0x75, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6c,
+	0x6f, 0x75, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x7d, 0x12, 0x88, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x69,
+	0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x6f,
+	0x75, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x89, 0x01, 0x0a,
+	0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x24, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x2a, 0x2d, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x7b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x12, 0x22, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x69, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x73, 0x12, 0x5e, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x21,
+	0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x63,
+	0x61, 0x6d, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x63, 0x61, 0x6d, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x12, 0x54, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x22,
+	0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5c, 0x0a, 0x18, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x24, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63,
+	0x61, 0x6d, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x1a, 0x52, 0x65, 0x6e, 0x65,
+	0x77, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x26, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x52, 0x65, 0x6e,
+	0x65, 0x77, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0xa0, 0x01, 0x0a, 0x1b, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x72, 0x75, 0x73, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12, 0x27, 0x2e, 0x63, 0x61, 0x6d,
+	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x22, 0x2b, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x61, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x73, 0x3a, 0x06, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12, 0xa5, 0x01,
+	0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x12, 0x27, 0x2e,
+	0x63, 0x61, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x75, 0x73,
+	0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x61, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x73, 0x12, 0xa1, 0x01, 0x0a, 0x1c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x75, 0x73, 0x74,
+	0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12, 0x28, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72,
+	0x75, 0x73, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x39,
+	0x2a, 0x37, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x61,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xba, 0x01, 0x0a, 0x21, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12,
+	0x2d, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4d,
+	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x22, 0x43, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3d, 0x22, 0x31, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x3a, 0x08, 0x6d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0xbd, 0x01, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x12, 0x2d, 0x2e, 0x63,
+	0x61, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63, 0x61,
+	0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x33, 0x12, 0x31, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x12, 0xb5, 0x01, 0x0a, 0x22, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x2e,
+	0x63, 0x61, 0x6d, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x47, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x2a, 0x3f, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73,
+	0x2f, 0x7b, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xaa,
+	0x01, 0x0a, 0x1c, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12,
+	0x28, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x61, 0x6d, 0x2e,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x22, 0x47, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x22, 0x32, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x64, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x3a, 0x0b,
+	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0xc1, 0x01, 0x0a, 0x22,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x2e, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x12, 0x32, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x72, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x64, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12,
+	0x8f, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x24, 0x2e, 0x63,
+	0x61, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x31,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x12, 0x74, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x61, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x74,
+	0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x51, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x6c, 0x61,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x61, 0x69, 0x61, 0x2d, 0x78, 0x2f, 0x64, 0x61, 0x74,
+	0x61, 0x2d, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x2d, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2f, 0x63, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -1201,97 +3742,191 @@ func file_api_configuration_configuration_proto_rawDescGZIP() []byte {
 	return file_api_configuration_configuration_proto_rawDescData
 }
 
-var file_api_configuration_configuration_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_api_configuration_configuration_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
 var file_api_configuration_configuration_proto_goTypes = []interface{}{
 	(*StartMonitoringRequest)(nil),                        // 0: cam.StartMonitoringRequest
-	(*StartMonitoringResponse)(nil),                       // 1: cam.StartMonitoringResponse
-	(*StopMonitoringRequest)(nil),                         // 2: cam.StopMonitoringRequest
-	(*StopMonitoringResponse)(nil),                        // 3: cam.StopMonitoringResponse
-	(*GetMonitoringStatusRequest)(nil),                    // 4: cam.GetMonitoringStatusRequest
-	(*GetMetricRequest)(nil),                              // 5: cam.GetMetricRequest
-	(*GetControlRequest)(nil),                             // 6: cam.GetControlRequest
-	(*ListCollectionModulesRequest)(nil),                  // 7: cam.ListCollectionModulesRequest
-	(*ListCollectionModulesResponse)(nil),                 // 8: cam.ListCollectionModulesResponse
-	(*AddCollectionModuleRequest)(nil),                    // 9: cam.AddCollectionModuleRequest
-	(*FindCollectionModuleRequest)(nil),                   // 10: cam.FindCollectionModuleRequest
-	(*RemoveCollectionModuleRequest)(nil),                 // 11: cam.RemoveCollectionModuleRequest
-	(*ConfigureCloudServiceRequest)(nil),                  // 12: cam.ConfigureCloudServiceRequest
-	(*ConfigureCloudServiceResponse)(nil),                 // 13: cam.ConfigureCloudServiceResponse
-	(*ListCloudServiceConfigurationsRequest)(nil),         // 14: cam.ListCloudServiceConfigurationsRequest
-	(*ListCloudServiceConfigurationsResponse)(nil),        // 15: cam.ListCloudServiceConfigurationsResponse
-	(*Configurations)(nil),                                // 16: cam.Configurations
-	(*MonitoringStatus)(nil),                              // 17: cam.MonitoringStatus
-	(*collection.CollectionModule)(nil),                   // 18: cam.CollectionModule
-	(*collection.ServiceConfiguration)(nil),               // 19: cam.ServiceConfiguration
-	(*timestamppb.Timestamp)(nil),                         // 20: google.protobuf.Timestamp
-	(*orchestrator.ListMetricsRequest)(nil),               // 21: clouditor.ListMetricsRequest
-	(*orchestrator.GetMetricRequest)(nil),                 // 22: clouditor.GetMetricRequest
-	(*orchestrator.GetMetricConfigurationRequest)(nil),    // 23: clouditor.GetMetricConfigurationRequest
-	(*orchestrator.UpdateMetricConfigurationRequest)(nil), // 24: clouditor.UpdateMetricConfigurationRequest
-	(*orchestrator.RegisterCloudServiceRequest)(nil),      // 25: clouditor.RegisterCloudServiceRequest
-	(*orchestrator.UpdateCloudServiceRequest)(nil),        // 26: clouditor.UpdateCloudServiceRequest
-	(*orchestrator.GetCloudServiceRequest)(nil),           // 27: clouditor.GetCloudServiceRequest
-	(*orchestrator.ListCloudServicesRequest)(nil),         // 28: clouditor.ListCloudServicesRequest
-	(*orchestrator.RemoveCloudServiceRequest)(nil),        // 29: clouditor.RemoveCloudServiceRequest
-	(*orchestrator.ListRequirementsRequest)(nil),          // 30: clouditor.ListRequirementsRequest
-	(*orchestrator.ListMetricsResponse)(nil),              // 31: clouditor.ListMetricsResponse
-	(*assessment.Metric)(nil),                             // 32: clouditor.Metric
-	(*assessment.MetricConfiguration)(nil),                // 33: clouditor.MetricConfiguration
-	(*orchestrator.CloudService)(nil),                     // 34: clouditor.CloudService
-	(*orchestrator.ListCloudServicesResponse)(nil),        // 35: clouditor.ListCloudServicesResponse
-	(*emptypb.Empty)(nil),                                 // 36: google.protobuf.Empty
-	(*orchestrator.ListRequirementsResponse)(nil),         // 37: clouditor.ListRequirementsResponse
+	(*CollectionSchedule)(nil),                            // 1: cam.CollectionSchedule
+	(*StartMonitoringResponse)(nil),                       // 2: cam.StartMonitoringResponse
+	(*DebounceConfig)(nil),                                // 3: cam.DebounceConfig
+	(*UpdateMonitoringRequest)(nil),                       // 4: cam.UpdateMonitoringRequest
+	(*UpdateMonitoringResponse)(nil),                      // 5: cam.UpdateMonitoringResponse
+	(*ReportEvidencesRequest)(nil),                        // 6: cam.ReportEvidencesRequest
+	(*StopMonitoringRequest)(nil),                         // 7: cam.StopMonitoringRequest
+	(*StopMonitoringResponse)(nil),                        // 8: cam.StopMonitoringResponse
+	(*GetMonitoringStatusRequest)(nil),                    // 9: cam.GetMonitoringStatusRequest
+	(*GetMetricRequest)(nil),                              // 10: cam.GetMetricRequest
+	(*GetControlRequest)(nil),                             // 11: cam.GetControlRequest
+	(*ListCollectionModulesRequest)(nil),                  // 12: cam.ListCollectionModulesRequest
+	(*ListCollectionModulesResponse)(nil),                 // 13: cam.ListCollectionModulesResponse
+	(*AddCollectionModuleRequest)(nil),                    // 14: cam.AddCollectionModuleRequest
+	(*FindCollectionModuleRequest)(nil),                   // 15: cam.FindCollectionModuleRequest
+	(*RemoveCollectionModuleRequest)(nil),                 // 16: cam.RemoveCollectionModuleRequest
+	(*RegisterCollectionModuleRequest)(nil),               // 17: cam.RegisterCollectionModuleRequest
+	(*RenewCollectionModuleLeaseRequest)(nil),             // 18: cam.RenewCollectionModuleLeaseRequest
+	(*CollectionModuleLease)(nil),                         // 19: cam.CollectionModuleLease
+	(*ConfigureCloudServiceRequest)(nil),                  // 20: cam.ConfigureCloudServiceRequest
+	(*ConfigureCloudServiceResponse)(nil),                 // 21: cam.ConfigureCloudServiceResponse
+	(*ListCloudServiceConfigurationsRequest)(nil),         // 22: cam.ListCloudServiceConfigurationsRequest
+	(*ListCloudServiceConfigurationsResponse)(nil),        // 23: cam.ListCloudServiceConfigurationsResponse
+	(*Configurations)(nil),                                // 24: cam.Configurations
+	(*MonitoringStatus)(nil),                              // 25: cam.MonitoringStatus
+	(*MonitoringJob)(nil),                                 // 26: cam.MonitoringJob
+	(*AttestationTrustAnchor)(nil),                        // 27: cam.AttestationTrustAnchor
+	(*AttestationReferenceManifest)(nil),                  // 28: cam.AttestationReferenceManifest
+	(*AttestationReferenceValue)(nil),                     // 29: cam.AttestationReferenceValue
+	(*RevokedCertificate)(nil),                            // 30: cam.RevokedCertificate
+	(*AttestationTrustStore)(nil),                         // 31: cam.AttestationTrustStore
+	(*CloudServiceTenant)(nil),                            // 32: cam.CloudServiceTenant
+	(*AttestationTrustStoreVersion)(nil),                  // 33: cam.AttestationTrustStoreVersion
+	(*StoreAttestationTrustAnchorRequest)(nil),            // 34: cam.StoreAttestationTrustAnchorRequest
+	(*ListAttestationTrustAnchorsRequest)(nil),            // 35: cam.ListAttestationTrustAnchorsRequest
+	(*ListAttestationTrustAnchorsResponse)(nil),           // 36: cam.ListAttestationTrustAnchorsResponse
+	(*RemoveAttestationTrustAnchorRequest)(nil),           // 37: cam.RemoveAttestationTrustAnchorRequest
+	(*StoreAttestationReferenceManifestRequest)(nil),      // 38: cam.StoreAttestationReferenceManifestRequest
+	(*ListAttestationReferenceManifestsRequest)(nil),      // 39: cam.ListAttestationReferenceManifestsRequest
+	(*ListAttestationReferenceManifestsResponse)(nil),     // 40: cam.ListAttestationReferenceManifestsResponse
+	(*RemoveAttestationReferenceManifestRequest)(nil),     // 41: cam.RemoveAttestationReferenceManifestRequest
+	(*RevokeAttestationCertificateRequest)(nil),           // 42: cam.RevokeAttestationCertificateRequest
+	(*ListRevokedAttestationCertificatesRequest)(nil),     // 43: cam.ListRevokedAttestationCertificatesRequest
+	(*ListRevokedAttestationCertificatesResponse)(nil),    // 44: cam.ListRevokedAttestationCertificatesResponse
+	(*GetAttestationTrustStoreRequest)(nil),               // 45: cam.GetAttestationTrustStoreRequest
+	(*AuditEvent)(nil),                                    // 46: cam.AuditEvent
+	(*AuditChange)(nil),                                   // 47: cam.AuditChange
+	(*ListAuditEventsRequest)(nil),                        // 48: cam.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),                       // 49: cam.ListAuditEventsResponse
+	(*collection.CollectionModule)(nil),                   // 50: cam.CollectionModule
+	(*timestamppb.Timestamp)(nil),                         // 51: google.protobuf.Timestamp
+	(*collection.ServiceConfiguration)(nil),               // 52: cam.ServiceConfiguration
+	(*orchestrator.ListMetricsRequest)(nil),               // 53: clouditor.ListMetricsRequest
+	(*orchestrator.GetMetricRequest)(nil),                 // 54: clouditor.GetMetricRequest
+	(*orchestrator.GetMetricConfigurationRequest)(nil),    // 55: clouditor.GetMetricConfigurationRequest
+	(*orchestrator.UpdateMetricConfigurationRequest)(nil), // 56: clouditor.UpdateMetricConfigurationRequest
+	(*orchestrator.RegisterCloudServiceRequest)(nil),      // 57: clouditor.RegisterCloudServiceRequest
+	(*orchestrator.UpdateCloudServiceRequest)(nil),        // 58: clouditor.UpdateCloudServiceRequest
+	(*collection.TestCollectionRequest)(nil),              // 59: cam.TestCollectionRequest
+	(*orchestrator.GetCloudServiceRequest)(nil),           // 60: clouditor.GetCloudServiceRequest
+	(*orchestrator.ListCloudServicesRequest)(nil),         // 61: clouditor.ListCloudServicesRequest
+	(*orchestrator.RemoveCloudServiceRequest)(nil),        // 62: clouditor.RemoveCloudServiceRequest
+	(*orchestrator.ListRequirementsRequest)(nil),          // 63: clouditor.ListRequirementsRequest
+	(*emptypb.Empty)(nil),                                 // 64: google.protobuf.Empty
+	(*orchestrator.ListMetricsResponse)(nil),              // 65: clouditor.ListMetricsResponse
+	(*assessment.Metric)(nil),                             // 66: clouditor.Metric
+	(*assessment.MetricConfiguration)(nil),                // 67: clouditor.MetricConfiguration
+	(*orchestrator.CloudService)(nil),                     // 68: clouditor.CloudService
+	(*collection.TestCollectionResponse)(nil),             // 69: cam.TestCollectionResponse
+	(*orchestrator.ListCloudServicesResponse)(nil),        // 70: clouditor.ListCloudServicesResponse
+	(*orchestrator.ListRequirementsResponse)(nil),         // 71: clouditor.ListRequirementsResponse
 }
 var file_api_configuration_configuration_proto_depIdxs = []int32{
-	17, // 0: cam.StartMonitoringResponse.status:type_name -> cam.MonitoringStatus
-	18, // 1: cam.ListCollectionModulesResponse.modules:type_name -> cam.CollectionModule
-	18, // 2: cam.AddCollectionModuleRequest.module:type_name -> cam.CollectionModule
-	16, // 3: cam.ConfigureCloudServiceRequest.configurations:type_name -> cam.Configurations
-	19, // 4: cam.ListCloudServiceConfigurationsResponse.configurations:type_name -> cam.ServiceConfiguration
-	19, // 5: cam.Configurations.configurations:type_name -> cam.ServiceConfiguration
-	20, // 6: cam.MonitoringStatus.last_run:type_name -> google.protobuf.Timestamp
-	20, // 7: cam.MonitoringStatus.next_run:type_name -> google.protobuf.Timestamp
-	0,  // 8: cam.Configuration.StartMonitoring:input_type -> cam.StartMonitoringRequest
-	2,  // 9: cam.Configuration.StopMonitoring:input_type -> cam.StopMonitoringRequest
-	4,  // 10: cam.Configuration.GetMonitoringStatus:input_type -> cam.GetMonitoringStatusRequest
-	21, // 11: cam.Configuration.ListMetrics:input_type -> clouditor.ListMetricsRequest
-	22, // 12: cam.Configuration.GetMetric:input_type -> clouditor.GetMetricRequest
-	23, // 13: cam.Configuration.GetMetricConfiguration:input_type -> clouditor.GetMetricConfigurationRequest
-	24, // 14: cam.Configuration.UpdateMetricConfiguration:input_type -> clouditor.UpdateMetricConfigurationRequest
-	25, // 15: cam.Configuration.RegisterCloudService:input_type -> clouditor.RegisterCloudServiceRequest
-	26, // 16: cam.Configuration.UpdateCloudService:input_type -> clouditor.UpdateCloudServiceRequest
-	12, // 17: cam.Configuration.ConfigureCloudService:input_type -> cam.ConfigureCloudServiceRequest
-	14, // 18: cam.Configuration.ListCloudServiceConfigurations:input_type -> cam.ListCloudServiceConfigurationsRequest
-	27, // 19: cam.Configuration.GetCloudService:input_type -> clouditor.GetCloudServiceRequest
-	28, // 20: cam.Configuration.ListCloudServices:input_type -> clouditor.ListCloudServicesRequest
-	29, // 21: cam.Configuration.RemoveCloudService:input_type -> clouditor.RemoveCloudServiceRequest
-	30, // 22: cam.Configuration.ListControls:input_type -> clouditor.ListRequirementsRequest
-	7,  // 23: cam.Configuration.ListCollectionModules:input_type -> cam.ListCollectionModulesRequest
-	9,  // 24: cam.Configuration.AddCollectionModule:input_type -> cam.AddCollectionModuleRequest
-	11, // 25: cam.Configuration.RemoveCollectionModule:input_type -> cam.RemoveCollectionModuleRequest
-	1,  // 26: cam.Configuration.StartMonitoring:output_type -> cam.StartMonitoringResponse
-	3,  // 27: cam.Configuration.StopMonitoring:output_type -> cam.StopMonitoringResponse
-	17, // 28: cam.Configuration.GetMonitoringStatus:output_type -> cam.MonitoringStatus
-	31, // 29: cam.Configuration.ListMetrics:output_type -> clouditor.ListMetricsResponse
-	32, // 30: cam.Configuration.GetMetric:output_type -> clouditor.Metric
-	33, // 31: cam.Configuration.GetMetricConfiguration:output_type -> clouditor.MetricConfiguration
-	33, // 32: cam.Configuration.UpdateMetricConfiguration:output_type -> clouditor.MetricConfiguration
-	34, // 33: cam.Configuration.RegisterCloudService:output_type -> clouditor.CloudService
-	34, // 34: cam.Configuration.UpdateCloudService:output_type -> clouditor.CloudService
-	13, // 35: cam.Configuration.ConfigureCloudService:output_type -> cam.ConfigureCloudServiceResponse
-	15, // 36: cam.Configuration.ListCloudServiceConfigurations:output_type -> cam.ListCloudServiceConfigurationsResponse
-	34, // 37: cam.Configuration.GetCloudService:output_type -> clouditor.CloudService
-	35, // 38: cam.Configuration.ListCloudServices:output_type -> clouditor.ListCloudServicesResponse
-	36, // 39: cam.Configuration.RemoveCloudService:output_type -> google.protobuf.Empty
-	37, // 40: cam.Configuration.ListControls:output_type -> clouditor.ListRequirementsResponse
-	8,  // 41: cam.Configuration.ListCollectionModules:output_type -> cam.ListCollectionModulesResponse
-	18, // 42: cam.Configuration.AddCollectionModule:output_type -> cam.CollectionModule
-	36, // 43: cam.Configuration.RemoveCollectionModule:output_type -> google.protobuf.Empty
-	26, // [26:44] is the sub-list for method output_type
-	8,  // [8:26] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	1,  // 0: cam.StartMonitoringRequest.schedules:type_name -> cam.CollectionSchedule
+	3,  // 1: cam.StartMonitoringRequest.debounce:type_name -> cam.DebounceConfig
+	25, // 2: cam.StartMonitoringResponse.status:type_name -> cam.MonitoringStatus
+	1,  // 3: cam.UpdateMonitoringRequest.schedules:type_name -> cam.CollectionSchedule
+	3,  // 4: cam.UpdateMonitoringRequest.debounce:type_name -> cam.DebounceConfig
+	25, // 5: cam.UpdateMonitoringResponse.status:type_name -> cam.MonitoringStatus
+	50, // 6: cam.ListCollectionModulesResponse.modules:type_name -> cam.CollectionModule
+	50, // 7: cam.AddCollectionModuleRequest.module:type_name -> cam.CollectionModule
+	50, // 8: cam.RegisterCollectionModuleRequest.module:type_name -> cam.CollectionModule
+	51, // 9: cam.CollectionModuleLease.expires_at:type_name -> google.protobuf.Timestamp
+	24, // 10: cam.ConfigureCloudServiceRequest.configurations:type_name -> cam.Configurations
+	52, // 11: cam.ListCloudServiceConfigurationsResponse.configurations:type_name -> cam.ServiceConfiguration
+	52, // 12: cam.Configurations.configurations:type_name -> cam.ServiceConfiguration
+	51, // 13: cam.MonitoringStatus.last_run:type_name -> google.protobuf.Timestamp
+	51, // 14: cam.MonitoringStatus.next_run:type_name -> google.protobuf.Timestamp
+	26, // 15: cam.MonitoringStatus.jobs:type_name -> cam.MonitoringJob
+	3,  // 16: cam.MonitoringStatus.debounce:type_name -> cam.DebounceConfig
+	1,  // 17: cam.MonitoringJob.schedule:type_name -> cam.CollectionSchedule
+	51, // 18: cam.MonitoringJob.last_run:type_name -> google.protobuf.Timestamp
+	51, // 19: cam.MonitoringJob.next_run:type_name -> google.protobuf.Timestamp
+	51, // 20: cam.AttestationTrustAnchor.updated_at:type_name -> google.protobuf.Timestamp
+	29, // 21: cam.AttestationReferenceManifest.reference_values:type_name -> cam.AttestationReferenceValue
+	51, // 22: cam.AttestationReferenceManifest.updated_at:type_name -> google.protobuf.Timestamp
+	51, // 23: cam.RevokedCertificate.revoked_at:type_name -> google.protobuf.Timestamp
+	27, // 24: cam.AttestationTrustStore.trust_anchors:type_name -> cam.AttestationTrustAnchor
+	28, // 25: cam.AttestationTrustStore.reference_manifests:type_name -> cam.AttestationReferenceManifest
+	30, // 26: cam.AttestationTrustStore.revoked_certificates:type_name -> cam.RevokedCertificate
+	27, // 27: cam.StoreAttestationTrustAnchorRequest.anchor:type_name -> cam.AttestationTrustAnchor
+	27, // 28: cam.ListAttestationTrustAnchorsResponse.anchors:type_name -> cam.AttestationTrustAnchor
+	28, // 29: cam.StoreAttestationReferenceManifestRequest.manifest:type_name -> cam.AttestationReferenceManifest
+	28, // 30: cam.ListAttestationReferenceManifestsResponse.manifests:type_name -> cam.AttestationReferenceManifest
+	30, // 31: cam.RevokeAttestationCertificateRequest.certificate:type_name -> cam.RevokedCertificate
+	30, // 32: cam.ListRevokedAttestationCertificatesResponse.certificates:type_name -> cam.RevokedCertificate
+	51, // 33: cam.AuditEvent.time:type_name -> google.protobuf.Timestamp
+	47, // 34: cam.AuditEvent.changes:type_name -> cam.AuditChange
+	51, // 35: cam.ListAuditEventsRequest.from:type_name -> google.protobuf.Timestamp
+	51, // 36: cam.ListAuditEventsRequest.to:type_name -> google.protobuf.Timestamp
+	46, // 37: cam.ListAuditEventsResponse.events:type_name -> cam.AuditEvent
+	0,  // 38: cam.Configuration.StartMonitoring:input_type -> cam.StartMonitoringRequest
+	4,  // 39: cam.Configuration.UpdateMonitoring:input_type -> cam.UpdateMonitoringRequest
+	7,  // 40: cam.Configuration.StopMonitoring:input_type -> cam.StopMonitoringRequest
+	9,  // 41: cam.Configuration.GetMonitoringStatus:input_type -> cam.GetMonitoringStatusRequest
+	6,  // 42: cam.Configuration.ReportEvidences:input_type -> cam.ReportEvidencesRequest
+	53, // 43: cam.Configuration.ListMetrics:input_type -> clouditor.ListMetricsRequest
+	54, // 44: cam.Configuration.GetMetric:input_type -> clouditor.GetMetricRequest
+	55, // 45: cam.Configuration.GetMetricConfiguration:input_type -> clouditor.GetMetricConfigurationRequest
+	56, // 46: cam.Configuration.UpdateMetricConfiguration:input_type -> clouditor.UpdateMetricConfigurationRequest
+	57, // 47: cam.Configuration.RegisterCloudService:input_type -> clouditor.RegisterCloudServiceRequest
+	58, // 48: cam.Configuration.UpdateCloudService:input_type -> clouditor.UpdateCloudServiceRequest
+	20, // 49: cam.Configuration.ConfigureCloudService:input_type -> cam.ConfigureCloudServiceRequest
+	59, // 50: cam.Configuration.TestCollection:input_type -> cam.TestCollectionRequest
+	22, // 51: cam.Configuration.ListCloudServiceConfigurations:input_type -> cam.ListCloudServiceConfigurationsRequest
+	60, // 52: cam.Configuration.GetCloudService:input_type -> clouditor.GetCloudServiceRequest
+	61, // 53: cam.Configuration.ListCloudServices:input_type -> clouditor.ListCloudServicesRequest
+	62, // 54: cam.Configuration.RemoveCloudService:input_type -> clouditor.RemoveCloudServiceRequest
+	63, // 55: cam.Configuration.ListControls:input_type -> clouditor.ListRequirementsRequest
+	12, // 56: cam.Configuration.ListCollectionModules:input_type -> cam.ListCollectionModulesRequest
+	14, // 57: cam.Configuration.AddCollectionModule:input_type -> cam.AddCollectionModuleRequest
+	16, // 58: cam.Configuration.RemoveCollectionModule:input_type -> cam.RemoveCollectionModuleRequest
+	17, // 59: cam.Configuration.RegisterCollectionModule:input_type -> cam.RegisterCollectionModuleRequest
+	18, // 60: cam.Configuration.RenewCollectionModuleLease:input_type -> cam.RenewCollectionModuleLeaseRequest
+	34, // 61: cam.Configuration.StoreAttestationTrustAnchor:input_type -> cam.StoreAttestationTrustAnchorRequest
+	35, // 62: cam.Configuration.ListAttestationTrustAnchors:input_type -> cam.ListAttestationTrustAnchorsRequest
+	37, // 63: cam.Configuration.RemoveAttestationTrustAnchor:input_type -> cam.RemoveAttestationTrustAnchorRequest
+	38, // 64: cam.Configuration.StoreAttestationReferenceManifest:input_type -> cam.StoreAttestationReferenceManifestRequest
+	39, // 65: cam.Configuration.ListAttestationReferenceManifests:input_type -> cam.ListAttestationReferenceManifestsRequest
+	41, // 66: cam.Configuration.RemoveAttestationReferenceManifest:input_type -> cam.RemoveAttestationReferenceManifestRequest
+	42, // 67: cam.Configuration.RevokeAttestationCertificate:input_type -> cam.RevokeAttestationCertificateRequest
+	43, // 68: cam.Configuration.ListRevokedAttestationCertificates:input_type -> cam.ListRevokedAttestationCertificatesRequest
+	45, // 69: cam.Configuration.GetAttestationTrustStore:input_type -> cam.GetAttestationTrustStoreRequest
+	48, // 70: cam.Configuration.ListAuditEvents:input_type -> cam.ListAuditEventsRequest
+	2,  // 71: cam.Configuration.StartMonitoring:output_type -> cam.StartMonitoringResponse
+	5,  // 72: cam.Configuration.UpdateMonitoring:output_type -> cam.UpdateMonitoringResponse
+	8,  // 73: cam.Configuration.StopMonitoring:output_type -> cam.StopMonitoringResponse
+	25, // 74: cam.Configuration.GetMonitoringStatus:output_type -> cam.MonitoringStatus
+	64, // 75: cam.Configuration.ReportEvidences:output_type -> google.protobuf.Empty
+	65, // 76: cam.Configuration.ListMetrics:output_type -> clouditor.ListMetricsResponse
+	66, // 77: cam.Configuration.GetMetric:output_type -> clouditor.Metric
+	67, // 78: cam.Configuration.GetMetricConfiguration:output_type -> clouditor.MetricConfiguration
+	67, // 79: cam.Configuration.UpdateMetricConfiguration:output_type -> clouditor.MetricConfiguration
+	68, // 80: cam.Configuration.RegisterCloudService:output_type -> clouditor.CloudService
+	68, // 81: cam.Configuration.UpdateCloudService:output_type -> clouditor.CloudService
+	21, // 82: cam.Configuration.ConfigureCloudService:output_type -> cam.ConfigureCloudServiceResponse
+	69, // 83: cam.Configuration.TestCollection:output_type -> cam.TestCollectionResponse
+	23, // 84: cam.Configuration.ListCloudServiceConfigurations:output_type -> cam.ListCloudServiceConfigurationsResponse
+	68, // 85: cam.Configuration.GetCloudService:output_type -> clouditor.CloudService
+	70, // 86: cam.Configuration.ListCloudServices:output_type -> clouditor.ListCloudServicesResponse
+	64, // 87: cam.Configuration.RemoveCloudService:output_type -> google.protobuf.Empty
+	71, // 88: cam.Configuration.ListControls:output_type -> clouditor.ListRequirementsResponse
+	13, // 89: cam.Configuration.ListCollectionModules:output_type -> cam.ListCollectionModulesResponse
+	50, // 90: cam.Configuration.AddCollectionModule:output_type -> cam.CollectionModule
+	64, // 91: cam.Configuration.RemoveCollectionModule:output_type -> google.protobuf.Empty
+	19, // 92: cam.Configuration.RegisterCollectionModule:output_type -> cam.CollectionModuleLease
+	19, // 93: cam.Configuration.RenewCollectionModuleLease:output_type -> cam.CollectionModuleLease
+	27, // 94: cam.Configuration.StoreAttestationTrustAnchor:output_type -> cam.AttestationTrustAnchor
+	36, // 95: cam.Configuration.ListAttestationTrustAnchors:output_type -> cam.ListAttestationTrustAnchorsResponse
+	64, // 96: cam.Configuration.RemoveAttestationTrustAnchor:output_type -> google.protobuf.Empty
+	28, // 97: cam.Configuration.StoreAttestationReferenceManifest:output_type -> cam.AttestationReferenceManifest
+	40, // 98: cam.Configuration.ListAttestationReferenceManifests:output_type -> cam.ListAttestationReferenceManifestsResponse
+	64, // 99: cam.Configuration.RemoveAttestationReferenceManifest:output_type -> google.protobuf.Empty
+	30, // 100: cam.Configuration.RevokeAttestationCertificate:output_type -> cam.RevokedCertificate
+	44, // 101: cam.Configuration.ListRevokedAttestationCertificates:output_type -> cam.ListRevokedAttestationCertificatesResponse
+	31, // 102: cam.Configuration.GetAttestationTrustStore:output_type -> cam.AttestationTrustStore
+	49, // 103: cam.Configuration.ListAuditEvents:output_type -> cam.ListAuditEventsResponse
+	71, // [71:104] is the sub-list for method output_type
+	38, // [38:71] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_api_configuration_configuration_proto_init() }
@@ -1312,8 +3947,152 @@ func file_api_configuration_configuration_proto_init() {
 				return nil
 			}
 		}
-		file_api_configuration_configuration_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartMonitoringResponse); i {
+		file_api_configuration_configuration_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartMonitoringResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebounceConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMonitoringRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMonitoringResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportEvidencesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopMonitoringRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopMonitoringResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMonitoringStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMetricRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetControlRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCollectionModulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCollectionModulesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1324,8 +4103,8 @@ func file_api_configuration_configuration_proto_init() {
 				return nil
 			}
 		}
-		file_api_configuration_configuration_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopMonitoringRequest); i {
+		file_api_configuration_configuration_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCollectionModuleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1336,8 +4115,8 @@ func file_api_configuration_configuration_proto_init() {
 				return nil
 			}
 		}
-		file_api_configuration_configuration_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopMonitoringResponse); i {
+		file_api_configuration_configuration_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindCollectionModuleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1348,8 +4127,8 @@ func file_api_configuration_configuration_proto_init() {
 				return nil
 			}
 		}
-		file_api_configuration_configuration_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMonitoringStatusRequest); i {
+		file_api_configuration_configuration_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCollectionModuleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1360,8 +4139,8 @@ func file_api_configuration_configuration_proto_init() {
 				return nil
 			}
 		}
-		file_api_configuration_configuration_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMetricRequest); i {
+		file_api_configuration_configuration_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterCollectionModuleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1372,8 +4151,8 @@ func file_api_configuration_configuration_proto_init() {
 				return nil
 			}
 		}
-		file_api_configuration_configuration_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetControlRequest); i {
+		file_api_configuration_configuration_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewCollectionModuleLeaseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1384,8 +4163,8 @@ func file_api_configuration_configuration_proto_init() {
 				return nil
 			}
 		}
-		file_api_configuration_configuration_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListCollectionModulesRequest); i {
+		file_api_configuration_configuration_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionModuleLease); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1396,8 +4175,8 @@ func file_api_configuration_configuration_proto_init() {
 				return nil
 			}
 		}
-		file_api_configuration_configuration_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListCollectionModulesResponse); i {
+		file_api_configuration_configuration_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigureCloudServiceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1408,8 +4187,8 @@ func file_api_configuration_configuration_proto_init() {
 				return nil
 			}
 		}
-		file_api_configuration_configuration_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddCollectionModuleRequest); i {
+		file_api_configuration_configuration_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigureCloudServiceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1420,8 +4199,8 @@ func file_api_configuration_configuration_proto_init() {
 				return nil
 			}
 		}
-		file_api_configuration_configuration_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindCollectionModuleRequest); i {
+		file_api_configuration_configuration_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCloudServiceConfigurationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1432,8 +4211,8 @@ func file_api_configuration_configuration_proto_init() {
 				return nil
 			}
 		}
-		file_api_configuration_configuration_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveCollectionModuleRequest); i {
+		file_api_configuration_configuration_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCloudServiceConfigurationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1444,8 +4223,8 @@ func file_api_configuration_configuration_proto_init() {
 				return nil
 			}
 		}
-		file_api_configuration_configuration_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigureCloudServiceRequest); i {
+		file_api_configuration_configuration_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Configurations); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1456,8 +4235,8 @@ func file_api_configuration_configuration_proto_init() {
 				return nil
 			}
 		}
-		file_api_configuration_configuration_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigureCloudServiceResponse); i {
+		file_api_configuration_configuration_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MonitoringStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1468,8 +4247,8 @@ func file_api_configuration_configuration_proto_init() {
 				return nil
 			}
 		}
-		file_api_configuration_configuration_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListCloudServiceConfigurationsRequest); i {
+		file_api_configuration_configuration_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MonitoringJob); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1480,8 +4259,8 @@ func file_api_configuration_configuration_proto_init() {
 				return nil
 			}
 		}
-		file_api_configuration_configuration_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListCloudServiceConfigurationsResponse); i {
+		file_api_configuration_configuration_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttestationTrustAnchor); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1492,8 +4271,8 @@ func file_api_configuration_configuration_proto_init() {
 				return nil
 			}
 		}
-		file_api_configuration_configuration_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Configurations); i {
+		file_api_configuration_configuration_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttestationReferenceManifest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1504,8 +4283,248 @@ func file_api_configuration_configuration_proto_init() {
 				return nil
 			}
 		}
-		file_api_configuration_configuration_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MonitoringStatus); i {
+		file_api_configuration_configuration_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttestationReferenceValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokedCertificate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttestationTrustStore); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloudServiceTenant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttestationTrustStoreVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreAttestationTrustAnchorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAttestationTrustAnchorsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAttestationTrustAnchorsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveAttestationTrustAnchorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreAttestationReferenceManifestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAttestationReferenceManifestsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAttestationReferenceManifestsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveAttestationReferenceManifestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeAttestationCertificateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRevokedAttestationCertificatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRevokedAttestationCertificatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAttestationTrustStoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuditEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_configuration_configuration_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuditEventsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1517,13 +4536,14 @@ func file_api_configuration_configuration_proto_init() {
 			}
 		}
 	}
+	file_api_configuration_configuration_proto_msgTypes[29].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_configuration_configuration_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   50,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -27,13 +27,23 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ConfigurationClient interface {
 	StartMonitoring(ctx context.Context, in *StartMonitoringRequest, opts ...grpc.CallOption) (*StartMonitoringResponse, error)
+	// Updates the monitored controls and the schedules of a service, which is
+	// monitored already. Only the jobs of collection modules, which are affected
+	// by the change, are added or removed. All other jobs keep their schedule
+	// and the time of their last run.
+	UpdateMonitoring(ctx context.Context, in *UpdateMonitoringRequest, opts ...grpc.CallOption) (*UpdateMonitoringResponse, error)
 	StopMonitoring(ctx context.Context, in *StopMonitoringRequest, opts ...grpc.CallOption) (*StopMonitoringResponse, error)
 	// Retrieves the current monitoring status of the given service. The status
-	// includes the controls which are currently being monitored and the times of
-	// the last and next scheduled monitoring run. Returns 404 error when
-	// monitoring for this service wasn't started yet. Returns empty list when
-	// monitoring was started but is currently stopped/paused.
+	// includes the controls which are currently being monitored, the times of
+	// the last and next scheduled monitoring run and the schedule of each
+	// collection module. Returns 404 error when monitoring for this service
+	// wasn't started yet. Returns empty list when monitoring was started but is
+	// currently stopped/paused.
 	GetMonitoringStatus(ctx context.Context, in *GetMonitoringStatusRequest, opts ...grpc.CallOption) (*MonitoringStatus, error)
+	// Reports the number of evidences, which the evaluation manager received
+	// from a collection module for a service. It is called periodically by the
+	// evaluation manager and the count is shown in the monitoring status.
+	ReportEvidences(ctx context.Context, in *ReportEvidencesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Lists metrics. This function is a wrapper around the
 	// Clouditor Orchestrator's identical function, but we explicitly specify it
 	// here so that we can expose it via the configuration interfaces's REST API.
@@ -61,6 +71,10 @@ type ConfigurationClient interface {
 	// here so that we can expose it via the configuration interfaces's REST API.
 	UpdateCloudService(ctx context.Context, in *orchestrator.UpdateCloudServiceRequest, opts ...grpc.CallOption) (*orchestrator.CloudService, error)
 	ConfigureCloudService(ctx context.Context, in *ConfigureCloudServiceRequest, opts ...grpc.CallOption) (*ConfigureCloudServiceResponse, error)
+	// Tests a service configuration without storing it. The configuration is
+	// validated and the collection module of its type checks that it can
+	// connect to the cloud service, without collecting any evidences.
+	TestCollection(ctx context.Context, in *collection.TestCollectionRequest, opts ...grpc.CallOption) (*collection.TestCollectionResponse, error)
 	ListCloudServiceConfigurations(ctx context.Context, in *ListCloudServiceConfigurationsRequest, opts ...grpc.CallOption) (*ListCloudServiceConfigurationsResponse, error)
 	// Retrieves a target cloud service. This function is a wrapper around the
 	// Clouditor Orchestrator's identical function, but we explicitly specify it
@@ -78,6 +92,43 @@ type ConfigurationClient interface {
 	ListCollectionModules(ctx context.Context, in *ListCollectionModulesRequest, opts ...grpc.CallOption) (*ListCollectionModulesResponse, error)
 	AddCollectionModule(ctx context.Context, in *AddCollectionModuleRequest, opts ...grpc.CallOption) (*collection.CollectionModule, error)
 	RemoveCollectionModule(ctx context.Context, in *RemoveCollectionModuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Registers a collection module, which announces itself on startup. The
+	// module is added or updated and a lease is granted to the instance, i.e.,
+	// its address. Several instances of a collection module register with the
+	// same ID and different addresses. Each instance needs to renew its lease
+	// with RenewCollectionModuleLease before it expires. Instances with an
+	// expired lease are not used for monitoring until they renew their lease or
+	// register again. A collection module is unavailable, if the leases of all
+	// its instances have expired.
+	RegisterCollectionModule(ctx context.Context, in *RegisterCollectionModuleRequest, opts ...grpc.CallOption) (*CollectionModuleLease, error)
+	// Renews the lease of a registered collection module (heartbeat). Returns a
+	// not found error, if the collection module needs to register again, e.g.,
+	// after a restart of the Requirements Manager.
+	RenewCollectionModuleLease(ctx context.Context, in *RenewCollectionModuleLeaseRequest, opts ...grpc.CallOption) (*CollectionModuleLease, error)
+	// Stores (adds or updates) a trust anchor, i.e. a CA certificate that is
+	// used to verify the certificate chains of attestation reports and
+	// manifests. Each change increases the version of the trust store.
+	StoreAttestationTrustAnchor(ctx context.Context, in *StoreAttestationTrustAnchorRequest, opts ...grpc.CallOption) (*AttestationTrustAnchor, error)
+	ListAttestationTrustAnchors(ctx context.Context, in *ListAttestationTrustAnchorsRequest, opts ...grpc.CallOption) (*ListAttestationTrustAnchorsResponse, error)
+	RemoveAttestationTrustAnchor(ctx context.Context, in *RemoveAttestationTrustAnchorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Stores (adds or updates) a reference manifest, i.e. the trusted reference
+	// values of a component (firmware, OS or app) of an attested device. Each
+	// change increases the version of the trust store.
+	StoreAttestationReferenceManifest(ctx context.Context, in *StoreAttestationReferenceManifestRequest, opts ...grpc.CallOption) (*AttestationReferenceManifest, error)
+	ListAttestationReferenceManifests(ctx context.Context, in *ListAttestationReferenceManifestsRequest, opts ...grpc.CallOption) (*ListAttestationReferenceManifestsResponse, error)
+	RemoveAttestationReferenceManifest(ctx context.Context, in *RemoveAttestationReferenceManifestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Revokes a certificate used in attestation. Signatures created with the
+	// certificate (or any certificate issued by it) are no longer trusted.
+	RevokeAttestationCertificate(ctx context.Context, in *RevokeAttestationCertificateRequest, opts ...grpc.CallOption) (*RevokedCertificate, error)
+	ListRevokedAttestationCertificates(ctx context.Context, in *ListRevokedAttestationCertificatesRequest, opts ...grpc.CallOption) (*ListRevokedAttestationCertificatesResponse, error)
+	// Retrieves the current version of the attestation trust store, i.e. all
+	// trust anchors, reference manifests and revoked certificates. This is used
+	// by the integrity collection module.
+	GetAttestationTrustStore(ctx context.Context, in *GetAttestationTrustStoreRequest, opts ...grpc.CallOption) (*AttestationTrustStore, error)
+	// Lists the audit events of the modifying RPCs of this service, e.g., who
+	// registered a cloud service or changed a metric configuration. The audit
+	// log is append-only.
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
 }
 
 type configurationClient struct {
@@ -97,6 +148,15 @@ func (c *configurationClient) StartMonitoring(ctx context.Context, in *StartMoni
 	return out, nil
 }
 
+func (c *configurationClient) UpdateMonitoring(ctx context.Context, in *UpdateMonitoringRequest, opts ...grpc.CallOption) (*UpdateMonitoringResponse, error) {
+	out := new(UpdateMonitoringResponse)
+	err := c.cc.Invoke(ctx, "/cam.Configuration/UpdateMonitoring", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *configurationClient) StopMonitoring(ctx context.Context, in *StopMonitoringRequest, opts ...grpc.CallOption) (*StopMonitoringResponse, error) {
 	out := new(StopMonitoringResponse)
 	err := c.cc.Invoke(ctx, "/cam.Configuration/StopMonitoring", in, out, opts...)
@@ -115,6 +175,15 @@ func (c *configurationClient) GetMonitoringStatus(ctx context.Context, in *GetMo
 	return out, nil
 }
 
+func (c *configurationClient) ReportEvidences(ctx context.Context, in *ReportEvidencesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/cam.Configuration/ReportEvidences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *configurationClient) ListMetrics(ctx context.Context, in *orchestrator.ListMetricsRequest, opts ...grpc.CallOption) (*orchestrator.ListMetricsResponse, error) {
 	out := new(orchestrator.ListMetricsResponse)
 	err := c.cc.Invoke(ctx, "/cam.Configuration/ListMetrics", in, out, opts...)
@@ -178,6 +247,15 @@ func (c *configurationClient) ConfigureCloudService(ctx context.Context, in *Con
 	return out, nil
 }
 
+func (c *configurationClient) TestCollection(ctx context.Context, in *collection.TestCollectionRequest, opts ...grpc.CallOption) (*collection.TestCollectionResponse, error) {
+	out := new(collection.TestCollectionResponse)
+	err := c.cc.Invoke(ctx, "/cam.Configuration/TestCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *configurationClient) ListCloudServiceConfigurations(ctx context.Context, in *ListCloudServiceConfigurationsRequest, opts ...grpc.CallOption) (*ListCloudServiceConfigurationsResponse, error) {
 	out := new(ListCloudServiceConfigurationsResponse)
 	err := c.cc.Invoke(ctx, "/cam.Configuration/ListCloudServiceConfigurations", in, out, opts...)
@@ -250,18 +328,136 @@ func (c *configurationClient) RemoveCollectionModule(ctx context.Context, in *Re
 	return out, nil
 }
 
+func (c *configurationClient) RegisterCollectionModule(ctx context.Context, in *RegisterCollectionModuleRequest, opts ...grpc.CallOption) (*CollectionModuleLease, error) {
+	out := new(CollectionModuleLease)
+	err := c.cc.Invoke(ctx, "/cam.Configuration/RegisterCollectionModule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationClient) RenewCollectionModuleLease(ctx context.Context, in *RenewCollectionModuleLeaseRequest, opts ...grpc.CallOption) (*CollectionModuleLease, error) {
+	out := new(CollectionModuleLease)
+	err := c.cc.Invoke(ctx, "/cam.Configuration/RenewCollectionModuleLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationClient) StoreAttestationTrustAnchor(ctx context.Context, in *StoreAttestationTrustAnchorRequest, opts ...grpc.CallOption) (*AttestationTrustAnchor, error) {
+	out := new(AttestationTrustAnchor)
+	err := c.cc.Invoke(ctx, "/cam.Configuration/StoreAttestationTrustAnchor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationClient) ListAttestationTrustAnchors(ctx context.Context, in *ListAttestationTrustAnchorsRequest, opts ...grpc.CallOption) (*ListAttestationTrustAnchorsResponse, error) {
+	out := new(ListAttestationTrustAnchorsResponse)
+	err := c.cc.Invoke(ctx, "/cam.Configuration/ListAttestationTrustAnchors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationClient) RemoveAttestationTrustAnchor(ctx context.Context, in *RemoveAttestationTrustAnchorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/cam.Configuration/RemoveAttestationTrustAnchor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationClient) StoreAttestationReferenceManifest(ctx context.Context, in *StoreAttestationReferenceManifestRequest, opts ...grpc.CallOption) (*AttestationReferenceManifest, error) {
+	out := new(AttestationReferenceManifest)
+	err := c.cc.Invoke(ctx, "/cam.Configuration/StoreAttestationReferenceManifest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationClient) ListAttestationReferenceManifests(ctx context.Context, in *ListAttestationReferenceManifestsRequest, opts ...grpc.CallOption) (*ListAttestationReferenceManifestsResponse, error) {
+	out := new(ListAttestationReferenceManifestsResponse)
+	err := c.cc.Invoke(ctx, "/cam.Configuration/ListAttestationReferenceManifests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationClient) RemoveAttestationReferenceManifest(ctx context.Context, in *RemoveAttestationReferenceManifestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/cam.Configuration/RemoveAttestationReferenceManifest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationClient) RevokeAttestationCertificate(ctx context.Context, in *RevokeAttestationCertificateRequest, opts ...grpc.CallOption) (*RevokedCertificate, error) {
+	out := new(RevokedCertificate)
+	err := c.cc.Invoke(ctx, "/cam.Configuration/RevokeAttestationCertificate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationClient) ListRevokedAttestationCertificates(ctx context.Context, in *ListRevokedAttestationCertificatesRequest, opts ...grpc.CallOption) (*ListRevokedAttestationCertificatesResponse, error) {
+	out := new(ListRevokedAttestationCertificatesResponse)
+	err := c.cc.Invoke(ctx, "/cam.Configuration/ListRevokedAttestationCertificates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationClient) GetAttestationTrustStore(ctx context.Context, in *GetAttestationTrustStoreRequest, opts ...grpc.CallOption) (*AttestationTrustStore, error) {
+	out := new(AttestationTrustStore)
+	err := c.cc.Invoke(ctx, "/cam.Configuration/GetAttestationTrustStore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, "/cam.Configuration/ListAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ConfigurationServer is the server API for Configuration service.
 // All implementations must embed UnimplementedConfigurationServer
 // for forward compatibility
 type ConfigurationServer interface {
 	StartMonitoring(context.Context, *StartMonitoringRequest) (*StartMonitoringResponse, error)
+	// Updates the monitored controls and the schedules of a service, which is
+	// monitored already. Only the jobs of collection modules, which are affected
+	// by the change, are added or removed. All other jobs keep their schedule
+	// and the time of their last run.
+	UpdateMonitoring(context.Context, *UpdateMonitoringRequest) (*UpdateMonitoringResponse, error)
 	StopMonitoring(context.Context, *StopMonitoringRequest) (*StopMonitoringResponse, error)
 	// Retrieves the current monitoring status of the given service. The status
-	// includes the controls which are currently being monitored and the times of
-	// the last and next scheduled monitoring run. Returns 404 error when
-	// monitoring for this service wasn't started yet. Returns empty list when
-	// monitoring was started but is currently stopped/paused.
+	// includes the controls which are currently being monitored, the times of
+	// the last and next scheduled monitoring run and the schedule of each
+	// collection module. Returns 404 error when monitoring for this service
+	// wasn't started yet. Returns empty list when monitoring was started but is
+	// currently stopped/paused.
 	GetMonitoringStatus(context.Context, *GetMonitoringStatusRequest) (*MonitoringStatus, error)
+	// Reports the number of evidences, which the evaluation manager received
+	// from a collection module for a service. It is called periodically by the
+	// evaluation manager and the count is shown in the monitoring status.
+	ReportEvidences(context.Context, *ReportEvidencesRequest) (*emptypb.Empty, error)
 	// Lists metrics. This function is a wrapper around the
 	// Clouditor Orchestrator's identical function, but we explicitly specify it
 	// here so that we can expose it via the configuration interfaces's REST API.
@@ -289,6 +485,10 @@ type ConfigurationServer interface {
 	// here so that we can expose it via the configuration interfaces's REST API.
 	UpdateCloudService(context.Context, *orchestrator.UpdateCloudServiceRequest) (*orchestrator.CloudService, error)
 	ConfigureCloudService(context.Context, *ConfigureCloudServiceRequest) (*ConfigureCloudServiceResponse, error)
+	// Tests a service configuration without storing it. The configuration is
+	// validated and the collection module of its type checks that it can
+	// connect to the cloud service, without collecting any evidences.
+	TestCollection(context.Context, *collection.TestCollectionRequest) (*collection.TestCollectionResponse, error)
 	ListCloudServiceConfigurations(context.Context, *ListCloudServiceConfigurationsRequest) (*ListCloudServiceConfigurationsResponse, error)
 	// Retrieves a target cloud service. This function is a wrapper around the
 	// Clouditor Orchestrator's identical function, but we explicitly specify it
@@ -306,6 +506,43 @@ type ConfigurationServer interface {
 	ListCollectionModules(context.Context, *ListCollectionModulesRequest) (*ListCollectionModulesResponse, error)
 	AddCollectionModule(context.Context, *AddCollectionModuleRequest) (*collection.CollectionModule, error)
 	RemoveCollectionModule(context.Context, *RemoveCollectionModuleRequest) (*emptypb.Empty, error)
+	// Registers a collection module, which announces itself on startup. The
+	// module is added or updated and a lease is granted to the instance, i.e.,
+	// its address. Several instances of a collection module register with the
+	// same ID and different addresses. Each instance needs to renew its lease
+	// with RenewCollectionModuleLease before it expires. Instances with an
+	// expired lease are not used for monitoring until they renew their lease or
+	// register again. A collection module is unavailable, if the leases of all
+	// its instances have expired.
+	RegisterCollectionModule(context.Context, *RegisterCollectionModuleRequest) (*CollectionModuleLease, error)
+	// Renews the lease of a registered collection module (heartbeat). Returns a
+	// not found error, if the collection module needs to register again, e.g.,
+	// after a restart of the Requirements Manager.
+	RenewCollectionModuleLease(context.Context, *RenewCollectionModuleLeaseRequest) (*CollectionModuleLease, error)
+	// Stores (adds or updates) a trust anchor, i.e. a CA certificate that is
+	// used to verify the certificate chains of attestation reports and
+	// manifests. Each change increases the version of the trust store.
+	StoreAttestationTrustAnchor(context.Context, *StoreAttestationTrustAnchorRequest) (*AttestationTrustAnchor, error)
+	ListAttestationTrustAnchors(context.Context, *ListAttestationTrustAnchorsRequest) (*ListAttestationTrustAnchorsResponse, error)
+	RemoveAttestationTrustAnchor(context.Context, *RemoveAttestationTrustAnchorRequest) (*emptypb.Empty, error)
+	// Stores (adds or updates) a reference manifest, i.e. the trusted reference
+	// values of a component (firmware, OS or app) of an attested device. Each
+	// change increases the version of the trust store.
+	StoreAttestationReferenceManifest(context.Context, *StoreAttestationReferenceManifestRequest) (*AttestationReferenceManifest, error)
+	ListAttestationReferenceManifests(context.Context, *ListAttestationReferenceManifestsRequest) (*ListAttestationReferenceManifestsResponse, error)
+	RemoveAttestationReferenceManifest(context.Context, *RemoveAttestationReferenceManifestRequest) (*emptypb.Empty, error)
+	// Revokes a certificate used in attestation. Signatures created with the
+	// certificate (or any certificate issued by it) are no longer trusted.
+	RevokeAttestationCertificate(context.Context, *RevokeAttestationCertificateRequest) (*RevokedCertificate, error)
+	ListRevokedAttestationCertificates(context.Context, *ListRevokedAttestationCertificatesRequest) (*ListRevokedAttestationCertificatesResponse, error)
+	// Retrieves the current version of the attestation trust store, i.e. all
+	// trust anchors, reference manifests and revoked certificates. This is used
+	// by the integrity collection module.
+	GetAttestationTrustStore(context.Context, *GetAttestationTrustStoreRequest) (*AttestationTrustStore, error)
+	// Lists the audit events of the modifying RPCs of this service, e.g., who
+	// registered a cloud service or changed a metric configuration. The audit
+	// log is append-only.
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
 	mustEmbedUnimplementedConfigurationServer()
 }
 
@@ -316,12 +553,18 @@ type UnimplementedConfigurationServer struct {
 func (UnimplementedConfigurationServer) StartMonitoring(context.Context, *StartMonitoringRequest) (*StartMonitoringResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartMonitoring not implemented")
 }
+func (UnimplementedConfigurationServer) UpdateMonitoring(context.Context, *UpdateMonitoringRequest) (*UpdateMonitoringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMonitoring not implemented")
+}
 func (UnimplementedConfigurationServer) StopMonitoring(context.Context, *StopMonitoringRequest) (*StopMonitoringResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopMonitoring not implemented")
 }
 func (UnimplementedConfigurationServer) GetMonitoringStatus(context.Context, *GetMonitoringStatusRequest) (*MonitoringStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMonitoringStatus not implemented")
 }
+func (UnimplementedConfigurationServer) ReportEvidences(context.Context, *ReportEvidencesRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportEvidences not implemented")
+}
 func (UnimplementedConfigurationServer) ListMetrics(context.Context, *orchestrator.ListMetricsRequest) (*orchestrator.ListMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMetrics not implemented")
 }
@@ -343,6 +586,9 @@ func (UnimplementedConfigurationServer) UpdateCloudService(context.Context, *orc
 func (UnimplementedConfigurationServer) ConfigureCloudService(context.Context, *ConfigureCloudServiceRequest) (*ConfigureCloudServiceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConfigureCloudService not implemented")
 }
+func (UnimplementedConfigurationServer) TestCollection(context.Context, *collection.TestCollectionRequest) (*collection.TestCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestCollection not implemented")
+}
 func (UnimplementedConfigurationServer) ListCloudServiceConfigurations(context.Context, *ListCloudServiceConfigurationsRequest) (*ListCloudServiceConfigurationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCloudServiceConfigurations not implemented")
 }
@@ -367,6 +613,42 @@ func (UnimplementedConfigurationServer) AddCollectionModule(context.Context, *Ad
 func (UnimplementedConfigurationServer) RemoveCollectionModule(context.Context, *RemoveCollectionModuleRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveCollectionModule not implemented")
 }
+func (UnimplementedConfigurationServer) RegisterCollectionModule(context.Context, *RegisterCollectionModuleRequest) (*CollectionModuleLease, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCollectionModule not implemented")
+}
+func (UnimplementedConfigurationServer) RenewCollectionModuleLease(context.Context, *RenewCollectionModuleLeaseRequest) (*CollectionModuleLease, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewCollectionModuleLease not implemented")
+}
+func (UnimplementedConfigurationServer) StoreAttestationTrustAnchor(context.Context, *StoreAttestationTrustAnchorRequest) (*AttestationTrustAnchor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreAttestationTrustAnchor not implemented")
+}
+func (UnimplementedConfigurationServer) ListAttestationTrustAnchors(context.Context, *ListAttestationTrustAnchorsRequest) (*ListAttestationTrustAnchorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttestationTrustAnchors not implemented")
+}
+func (UnimplementedConfigurationServer) RemoveAttestationTrustAnchor(context.Context, *RemoveAttestationTrustAnchorRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAttestationTrustAnchor not implemented")
+}
+func (UnimplementedConfigurationServer) StoreAttestationReferenceManifest(context.Context, *StoreAttestationReferenceManifestRequest) (*AttestationReferenceManifest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreAttestationReferenceManifest not implemented")
+}
+func (UnimplementedConfigurationServer) ListAttestationReferenceManifests(context.Context, *ListAttestationReferenceManifestsRequest) (*ListAttestationReferenceManifestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttestationReferenceManifests not implemented")
+}
+func (UnimplementedConfigurationServer) RemoveAttestationReferenceManifest(context.Context, *RemoveAttestationReferenceManifestRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAttestationReferenceManifest not implemented")
+}
+func (UnimplementedConfigurationServer) RevokeAttestationCertificate(context.Context, *RevokeAttestationCertificateRequest) (*RevokedCertificate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAttestationCertificate not implemented")
+}
+func (UnimplementedConfigurationServer) ListRevokedAttestationCertificates(context.Context, *ListRevokedAttestationCertificatesRequest) (*ListRevokedAttestationCertificatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRevokedAttestationCertificates not implemented")
+}
+func (UnimplementedConfigurationServer) GetAttestationTrustStore(context.Context, *GetAttestationTrustStoreRequest) (*AttestationTrustStore, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttestationTrustStore not implemented")
+}
+func (UnimplementedConfigurationServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
 func (UnimplementedConfigurationServer) mustEmbedUnimplementedConfigurationServer() {}
 
 // UnsafeConfigurationServer may be embedded to opt out of forward compatibility for this service.
@@ -398,6 +680,24 @@ func _Configuration_StartMonitoring_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Configuration_UpdateMonitoring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMonitoringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServer).UpdateMonitoring(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cam.Configuration/UpdateMonitoring",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServer).UpdateMonitoring(ctx, req.(*UpdateMonitoringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Configuration_StopMonitoring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StopMonitoringRequest)
 	if err := dec(in); err != nil {
@@ -434,6 +734,24 @@ func _Configuration_GetMonitoringStatus_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Configuration_ReportEvidences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportEvidencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServer).ReportEvidences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cam.Configuration/ReportEvidences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServer).ReportEvidences(ctx, req.(*ReportEvidencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Configuration_ListMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(orchestrator.ListMetricsRequest)
 	if err := dec(in); err != nil {
@@ -560,6 +878,24 @@ func _Configuration_ConfigureCloudService_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Configuration_TestCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(collection.TestCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServer).TestCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cam.Configuration/TestCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServer).TestCollection(ctx, req.(*collection.TestCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Configuration_ListCloudServiceConfigurations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListCloudServiceConfigurationsRequest)
 	if err := dec(in); err != nil {
@@ -704,6 +1040,222 @@ func _Configuration_RemoveCollectionModule_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Configuration_RegisterCollectionModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterCollectionModuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServer).RegisterCollectionModule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cam.Configuration/RegisterCollectionModule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServer).RegisterCollectionModule(ctx, req.(*RegisterCollectionModuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Configuration_RenewCollectionModuleLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewCollectionModuleLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServer).RenewCollectionModuleLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cam.Configuration/RenewCollectionModuleLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServer).RenewCollectionModuleLease(ctx, req.(*RenewCollectionModuleLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Configuration_StoreAttestationTrustAnchor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreAttestationTrustAnchorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServer).StoreAttestationTrustAnchor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cam.Configuration/StoreAttestationTrustAnchor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServer).StoreAttestationTrustAnchor(ctx, req.(*StoreAttestationTrustAnchorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Configuration_ListAttestationTrustAnchors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttestationTrustAnchorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServer).ListAttestationTrustAnchors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cam.Configuration/ListAttestationTrustAnchors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServer).ListAttestationTrustAnchors(ctx, req.(*ListAttestationTrustAnchorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Configuration_RemoveAttestationTrustAnchor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAttestationTrustAnchorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServer).RemoveAttestationTrustAnchor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cam.Configuration/RemoveAttestationTrustAnchor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServer).RemoveAttestationTrustAnchor(ctx, req.(*RemoveAttestationTrustAnchorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Configuration_StoreAttestationReferenceManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreAttestationReferenceManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServer).StoreAttestationReferenceManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cam.Configuration/StoreAttestationReferenceManifest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServer).StoreAttestationReferenceManifest(ctx, req.(*StoreAttestationReferenceManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Configuration_ListAttestationReferenceManifests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttestationReferenceManifestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServer).ListAttestationReferenceManifests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cam.Configuration/ListAttestationReferenceManifests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServer).ListAttestationReferenceManifests(ctx, req.(*ListAttestationReferenceManifestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Configuration_RemoveAttestationReferenceManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAttestationReferenceManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServer).RemoveAttestationReferenceManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cam.Configuration/RemoveAttestationReferenceManifest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServer).RemoveAttestationReferenceManifest(ctx, req.(*RemoveAttestationReferenceManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Configuration_RevokeAttestationCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAttestationCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServer).RevokeAttestationCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cam.Configuration/RevokeAttestationCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServer).RevokeAttestationCertificate(ctx, req.(*RevokeAttestationCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Configuration_ListRevokedAttestationCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRevokedAttestationCertificatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServer).ListRevokedAttestationCertificates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cam.Configuration/ListRevokedAttestationCertificates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServer).ListRevokedAttestationCertificates(ctx, req.(*ListRevokedAttestationCertificatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Configuration_GetAttestationTrustStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttestationTrustStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServer).GetAttestationTrustStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cam.Configuration/GetAttestationTrustStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServer).GetAttestationTrustStore(ctx, req.(*GetAttestationTrustStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Configuration_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cam.Configuration/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Configuration_ServiceDesc is the grpc.ServiceDesc for Configuration service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -715,6 +1267,10 @@ var Configuration_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StartMonitoring",
 			Handler:    _Configuration_StartMonitoring_Handler,
 		},
+		{
+			MethodName: "UpdateMonitoring",
+			Handler:    _Configuration_UpdateMonitoring_Handler,
+		},
 		{
 			MethodName: "StopMonitoring",
 			Handler:    _Configuration_StopMonitoring_Handler,
@@ -723,6 +1279,10 @@ var Configuration_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMonitoringStatus",
 			Handler:    _Configuration_GetMonitoringStatus_Handler,
 		},
+		{
+			MethodName: "ReportEvidences",
+			Handler:    _Configuration_ReportEvidences_Handler,
+		},
 		{
 			MethodName: "ListMetrics",
 			Handler:    _Configuration_ListMetrics_Handler,
@@ -751,6 +1311,10 @@ var Configuration_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConfigureCloudService",
 			Handler:    _Configuration_ConfigureCloudService_Handler,
 		},
+		{
+			MethodName: "TestCollection",
+			Handler:    _Configuration_TestCollection_Handler,
+		},
 		{
 			MethodName: "ListCloudServiceConfigurations",
 			Handler:    _Configuration_ListCloudServiceConfigurations_Handler,
@@ -783,6 +1347,54 @@ var Configuration_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveCollectionModule",
 			Handler:    _Configuration_RemoveCollectionModule_Handler,
 		},
+		{
+			MethodName: "RegisterCollectionModule",
+			Handler:    _Configuration_RegisterCollectionModule_Handler,
+		},
+		{
+			MethodName: "RenewCollectionModuleLease",
+			Handler:    _Configuration_RenewCollectionModuleLease_Handler,
+		},
+		{
+			MethodName: "StoreAttestationTrustAnchor",
+			Handler:    _Configuration_StoreAttestationTrustAnchor_Handler,
+		},
+		{
+			MethodName: "ListAttestationTrustAnchors",
+			Handler:    _Configuration_ListAttestationTrustAnchors_Handler,
+		},
+		{
+			MethodName: "RemoveAttestationTrustAnchor",
+			Handler:    _Configuration_RemoveAttestationTrustAnchor_Handler,
+		},
+		{
+			MethodName: "StoreAttestationReferenceManifest",
+			Handler:    _Configuration_StoreAttestationReferenceManifest_Handler,
+		},
+		{
+			MethodName: "ListAttestationReferenceManifests",
+			Handler:    _Configuration_ListAttestationReferenceManifests_Handler,
+		},
+		{
+			MethodName: "RemoveAttestationReferenceManifest",
+			Handler:    _Configuration_RemoveAttestationReferenceManifest_Handler,
+		},
+		{
+			MethodName: "RevokeAttestationCertificate",
+			Handler:    _Configuration_RevokeAttestationCertificate_Handler,
+		},
+		{
+			MethodName: "ListRevokedAttestationCertificates",
+			Handler:    _Configuration_ListRevokedAttestationCertificates_Handler,
+		},
+		{
+			MethodName: "GetAttestationTrustStore",
+			Handler:    _Configuration_GetAttestationTrustStore_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _Configuration_ListAuditEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/configuration/configuration.proto",
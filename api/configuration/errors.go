@@ -20,8 +20,36 @@ import "errors"
 var (
 	// ErrControlIDsEmpty indicates the request doesn't include any control IDs
 	ErrControlIDsEmpty = errors.New("control IDs must be specified")
+	// ErrInvalidInterval indicates a schedule with a negative interval
+	ErrInvalidInterval = errors.New("interval must not be negative")
+	// ErrDuplicateSchedule indicates several schedules for the same collection module
+	ErrDuplicateSchedule = errors.New("there must be at most one schedule per collection module")
+	// ErrInvalidDebounceConfig indicates a debounce configuration with a negative window or threshold
+	ErrInvalidDebounceConfig = errors.New("debounce window and threshold must not be negative")
 	// ErrRequestEmpty indicates the request is empty
 	ErrRequestEmpty = errors.New("request is empty")
 	// ErrServiceIDMissing indicates the request doesn't include a service ID
 	ErrServiceIDMissing = errors.New("serviceID is missing")
+	// ErrTrustAnchorMissing indicates the request doesn't include a trust anchor
+	ErrTrustAnchorMissing = errors.New("trust anchor is missing")
+	// ErrInvalidCertificate indicates that a certificate is not a valid PEM-encoded X.509 certificate
+	ErrInvalidCertificate = errors.New("certificate is not a valid PEM-encoded X.509 certificate")
+	// ErrReferenceManifestMissing indicates the request doesn't include a reference manifest
+	ErrReferenceManifestMissing = errors.New("reference manifest is missing")
+	// ErrReferenceManifestNameMissing indicates the reference manifest doesn't have a name
+	ErrReferenceManifestNameMissing = errors.New("name of reference manifest is missing")
+	// ErrReferenceValueHashMissing indicates a reference value without a reference hash
+	ErrReferenceValueHashMissing = errors.New("reference value does not contain a hash")
+	// ErrCollectionModuleMissing indicates the request doesn't include a collection module
+	ErrCollectionModuleMissing = errors.New("collection module is missing")
+	// ErrCollectionModuleAddressMissing indicates the collection module doesn't have an address
+	ErrCollectionModuleAddressMissing = errors.New("address of collection module is missing")
+	// ErrCollectionModuleIDMissing indicates the request doesn't include the ID of a collection module
+	ErrCollectionModuleIDMissing = errors.New("ID of collection module is missing")
+	// ErrSubjectKeyIDMissing indicates the request doesn't include the subject key ID of the revoked certificate
+	ErrSubjectKeyIDMissing = errors.New("subject key ID of revoked certificate is missing")
+	// ErrSubjectKeyIDInvalid indicates a subject key ID of a revoked certificate, which is not hex-encoded
+	ErrSubjectKeyIDInvalid = errors.New("subject key ID of revoked certificate is not hex-encoded")
+	// ErrAuditEventImmutable indicates an attempt to change or delete a recorded audit event
+	ErrAuditEventImmutable = errors.New("audit events are append-only")
 )
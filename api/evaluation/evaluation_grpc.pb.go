@@ -27,11 +27,21 @@ type EvaluationClient interface {
 	SendEvidences(ctx context.Context, opts ...grpc.CallOption) (Evaluation_SendEvidencesClient, error)
 	GetEvidence(ctx context.Context, in *GetEvidenceRequest, opts ...grpc.CallOption) (*common.Evidence, error)
 	ListEvidences(ctx context.Context, in *ListEvidencesRequest, opts ...grpc.CallOption) (*ListEvidencesResponse, error)
+	// Verifies the hash chain and the signatures of the stored evidences of a
+	// service, which have been received in the given time range
+	VerifyEvidenceChain(ctx context.Context, in *VerifyEvidenceChainRequest, opts ...grpc.CallOption) (*VerifyEvidenceChainResponse, error)
 	GetEvaluation(ctx context.Context, in *GetEvaluationRequest, opts ...grpc.CallOption) (*EvaluationResult, error)
 	StreamEvaluations(ctx context.Context, in *StreamEvaluationsRequest, opts ...grpc.CallOption) (Evaluation_StreamEvaluationsClient, error)
 	CalculateCompliance(ctx context.Context, in *CalculateComplianceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetCompliance(ctx context.Context, in *GetComplianceRequest, opts ...grpc.CallOption) (*Compliance, error)
 	ListCompliance(ctx context.Context, in *ListComplianceRequest, opts ...grpc.CallOption) (*ListComplianceResponse, error)
+	// Issues a signed compliance credential over the current compliance of a
+	// service, which the provider can present to its customers
+	IssueComplianceCredential(ctx context.Context, in *IssueComplianceCredentialRequest, opts ...grpc.CallOption) (*ComplianceCredential, error)
+	// Verifies the signature and the validity period of a compliance credential
+	VerifyComplianceCredential(ctx context.Context, in *VerifyComplianceCredentialRequest, opts ...grpc.CallOption) (*VerifyComplianceCredentialResponse, error)
+	// Lists the public keys of the credential issuer as JSON Web Key Set
+	ListCredentialIssuerKeys(ctx context.Context, in *ListCredentialIssuerKeysRequest, opts ...grpc.CallOption) (*JSONWebKeySet, error)
 }
 
 type evaluationClient struct {
@@ -94,6 +104,15 @@ func (c *evaluationClient) ListEvidences(ctx context.Context, in *ListEvidencesR
 	return out, nil
 }
 
+func (c *evaluationClient) VerifyEvidenceChain(ctx context.Context, in *VerifyEvidenceChainRequest, opts ...grpc.CallOption) (*VerifyEvidenceChainResponse, error) {
+	out := new(VerifyEvidenceChainResponse)
+	err := c.cc.Invoke(ctx, "/cam.Evaluation/VerifyEvidenceChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *evaluationClient) GetEvaluation(ctx context.Context, in *GetEvaluationRequest, opts ...grpc.CallOption) (*EvaluationResult, error) {
 	out := new(EvaluationResult)
 	err := c.cc.Invoke(ctx, "/cam.Evaluation/GetEvaluation", in, out, opts...)
@@ -162,6 +181,33 @@ func (c *evaluationClient) ListCompliance(ctx context.Context, in *ListComplianc
 	return out, nil
 }
 
+func (c *evaluationClient) IssueComplianceCredential(ctx context.Context, in *IssueComplianceCredentialRequest, opts ...grpc.CallOption) (*ComplianceCredential, error) {
+	out := new(ComplianceCredential)
+	err := c.cc.Invoke(ctx, "/cam.Evaluation/IssueComplianceCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *evaluationClient) VerifyComplianceCredential(ctx context.Context, in *VerifyComplianceCredentialRequest, opts ...grpc.CallOption) (*VerifyComplianceCredentialResponse, error) {
+	out := new(VerifyComplianceCredentialResponse)
+	err := c.cc.Invoke(ctx, "/cam.Evaluation/VerifyComplianceCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *evaluationClient) ListCredentialIssuerKeys(ctx context.Context, in *ListCredentialIssuerKeysRequest, opts ...grpc.CallOption) (*JSONWebKeySet, error) {
+	out := new(JSONWebKeySet)
+	err := c.cc.Invoke(ctx, "/cam.Evaluation/ListCredentialIssuerKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EvaluationServer is the server API for Evaluation service.
 // All implementations must embed UnimplementedEvaluationServer
 // for forward compatibility
@@ -169,11 +215,21 @@ type EvaluationServer interface {
 	SendEvidences(Evaluation_SendEvidencesServer) error
 	GetEvidence(context.Context, *GetEvidenceRequest) (*common.Evidence, error)
 	ListEvidences(context.Context, *ListEvidencesRequest) (*ListEvidencesResponse, error)
+	// Verifies the hash chain and the signatures of the stored evidences of a
+	// service, which have been received in the given time range
+	VerifyEvidenceChain(context.Context, *VerifyEvidenceChainRequest) (*VerifyEvidenceChainResponse, error)
 	GetEvaluation(context.Context, *GetEvaluationRequest) (*EvaluationResult, error)
 	StreamEvaluations(*StreamEvaluationsRequest, Evaluation_StreamEvaluationsServer) error
 	CalculateCompliance(context.Context, *CalculateComplianceRequest) (*emptypb.Empty, error)
 	GetCompliance(context.Context, *GetComplianceRequest) (*Compliance, error)
 	ListCompliance(context.Context, *ListComplianceRequest) (*ListComplianceResponse, error)
+	// Issues a signed compliance credential over the current compliance of a
+	// service, which the provider can present to its customers
+	IssueComplianceCredential(context.Context, *IssueComplianceCredentialRequest) (*ComplianceCredential, error)
+	// Verifies the signature and the validity period of a compliance credential
+	VerifyComplianceCredential(context.Context, *VerifyComplianceCredentialRequest) (*VerifyComplianceCredentialResponse, error)
+	// Lists the public keys of the credential issuer as JSON Web Key Set
+	ListCredentialIssuerKeys(context.Context, *ListCredentialIssuerKeysRequest) (*JSONWebKeySet, error)
 	mustEmbedUnimplementedEvaluationServer()
 }
 
@@ -190,6 +246,9 @@ func (UnimplementedEvaluationServer) GetEvidence(context.Context, *GetEvidenceRe
 func (UnimplementedEvaluationServer) ListEvidences(context.Context, *ListEvidencesRequest) (*ListEvidencesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListEvidences not implemented")
 }
+func (UnimplementedEvaluationServer) VerifyEvidenceChain(context.Context, *VerifyEvidenceChainRequest) (*VerifyEvidenceChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEvidenceChain not implemented")
+}
 func (UnimplementedEvaluationServer) GetEvaluation(context.Context, *GetEvaluationRequest) (*EvaluationResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEvaluation not implemented")
 }
@@ -205,6 +264,15 @@ func (UnimplementedEvaluationServer) GetCompliance(context.Context, *GetComplian
 func (UnimplementedEvaluationServer) ListCompliance(context.Context, *ListComplianceRequest) (*ListComplianceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCompliance not implemented")
 }
+func (UnimplementedEvaluationServer) IssueComplianceCredential(context.Context, *IssueComplianceCredentialRequest) (*ComplianceCredential, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueComplianceCredential not implemented")
+}
+func (UnimplementedEvaluationServer) VerifyComplianceCredential(context.Context, *VerifyComplianceCredentialRequest) (*VerifyComplianceCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyComplianceCredential not implemented")
+}
+func (UnimplementedEvaluationServer) ListCredentialIssuerKeys(context.Context, *ListCredentialIssuerKeysRequest) (*JSONWebKeySet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCredentialIssuerKeys not implemented")
+}
 func (UnimplementedEvaluationServer) mustEmbedUnimplementedEvaluationServer() {}
 
 // UnsafeEvaluationServer may be embedded to opt out of forward compatibility for this service.
@@ -280,6 +348,24 @@ func _Evaluation_ListEvidences_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Evaluation_VerifyEvidenceChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEvidenceChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvaluationServer).VerifyEvidenceChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cam.Evaluation/VerifyEvidenceChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvaluationServer).VerifyEvidenceChain(ctx, req.(*VerifyEvidenceChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Evaluation_GetEvaluation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetEvaluationRequest)
 	if err := dec(in); err != nil {
@@ -373,6 +459,60 @@ func _Evaluation_ListCompliance_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Evaluation_IssueComplianceCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueComplianceCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvaluationServer).IssueComplianceCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cam.Evaluation/IssueComplianceCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvaluationServer).IssueComplianceCredential(ctx, req.(*IssueComplianceCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Evaluation_VerifyComplianceCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyComplianceCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvaluationServer).VerifyComplianceCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cam.Evaluation/VerifyComplianceCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvaluationServer).VerifyComplianceCredential(ctx, req.(*VerifyComplianceCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Evaluation_ListCredentialIssuerKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCredentialIssuerKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvaluationServer).ListCredentialIssuerKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cam.Evaluation/ListCredentialIssuerKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvaluationServer).ListCredentialIssuerKeys(ctx, req.(*ListCredentialIssuerKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Evaluation_ServiceDesc is the grpc.ServiceDesc for Evaluation service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -388,6 +528,10 @@ var Evaluation_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListEvidences",
 			Handler:    _Evaluation_ListEvidences_Handler,
 		},
+		{
+			MethodName: "VerifyEvidenceChain",
+			Handler:    _Evaluation_VerifyEvidenceChain_Handler,
+		},
 		{
 			MethodName: "GetEvaluation",
 			Handler:    _Evaluation_GetEvaluation_Handler,
@@ -404,6 +548,18 @@ var Evaluation_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListCompliance",
 			Handler:    _Evaluation_ListCompliance_Handler,
 		},
+		{
+			MethodName: "IssueComplianceCredential",
+			Handler:    _Evaluation_IssueComplianceCredential_Handler,
+		},
+		{
+			MethodName: "VerifyComplianceCredential",
+			Handler:    _Evaluation_VerifyComplianceCredential_Handler,
+		},
+		{
+			MethodName: "ListCredentialIssuerKeys",
+			Handler:    _Evaluation_ListCredentialIssuerKeys_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
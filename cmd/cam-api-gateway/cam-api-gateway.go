@@ -21,6 +21,8 @@ import (
 
 	"github.com/eclipse-xfsc/cam"
 	"github.com/eclipse-xfsc/cam/internal/config"
+	"github.com/eclipse-xfsc/cam/internal/metrics"
+	"github.com/eclipse-xfsc/cam/internal/tracing"
 
 	"clouditor.io/clouditor/logging/formatter"
 	"github.com/sirupsen/logrus"
@@ -39,6 +41,12 @@ const (
 	OAuth2DashboardRedirectURI           = "oauth2-dashboard-redirect-uri"
 	OAuth2DashboardPostLogoutRedirectURI = "oauth2-dashboard-post-logout-redirect-uri"
 
+	// MetricsPortFlag specifies the port, on which the metrics are exposed. Setting this to zero disables the metrics.
+	MetricsPortFlag = "metrics-port"
+	// TracingOTLPEndpointFlag specifies the OTLP endpoint of a collector, to which the traces are exported. Setting this
+	// to empty disables the export of traces.
+	TracingOTLPEndpointFlag = "tracing-otlp-endpoint"
+
 	DefaultConfigurationServiceAddress = "localhost:50100"
 	DefaultEvaluationServiceAddress    = "localhost:50101"
 
@@ -46,6 +54,8 @@ const (
 	DefaultOAuth2DashboardClientID              = "public"
 	DefaultOAuth2DashboardRedirectURI           = "http://localhost:8080/#/loggedin"
 	DefaultOAuth2DashboardPostLogoutRedirectURI = "http://localhost:8080/#/loggedout"
+
+	DefaultMetricsPort uint16 = 9102
 )
 
 func init() {
@@ -69,12 +79,24 @@ func newGatewayCommand() *cobra.Command {
 	config.AddFlagString(cmd, OAuth2DashboardClientID, DefaultOAuth2DashboardClientID, "Specifies the OAuth 2.0 client ID used for the dashboard")
 	config.AddFlagString(cmd, OAuth2DashboardRedirectURI, DefaultOAuth2DashboardRedirectURI, "Specifies the OAuth 2.0 redirect URI used for the dashboard")
 	config.AddFlagString(cmd, OAuth2DashboardPostLogoutRedirectURI, DefaultOAuth2DashboardPostLogoutRedirectURI, "Specifies the OAuth 2.0 post logout redirect URI used for the dashboard")
+	config.AddFlagUint16(cmd, MetricsPortFlag, DefaultMetricsPort, "Specifies the port, on which the metrics are exposed in the Prometheus/OpenMetrics format. Setting this to zero disables the metrics")
+	config.AddFlagString(cmd, TracingOTLPEndpointFlag, "", "Specifies the OTLP/HTTP endpoint of a collector, e.g. http://localhost:4318, to which the traces are exported. Setting this to empty disables the export of traces")
 
 	return cmd
 }
 
 func doCmd(cmd *cobra.Command, args []string) error {
-	err := cam.RunGateway(
+	// Expose the metrics, including the ones of the gRPC connections to the services
+	metrics.Serve(uint16(viper.GetUint(MetricsPortFlag)))
+
+	// Export the traces of the calls to the services
+	shutdownTracing, err := tracing.ExportTo(viper.GetString(TracingOTLPEndpointFlag), "cam-api-gateway")
+	if err != nil {
+		return fmt.Errorf("could not export traces: %w", err)
+	}
+	defer shutdownTracing()
+
+	err = cam.RunGateway(
 		viper.GetString(ConfigurationServiceAddressFlag),
 		viper.GetString(EvaluationServiceAddressFlag),
 		cam.OAuth2Config{
@@ -0,0 +1,243 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+
+	"clouditor.io/clouditor/logging/formatter"
+	clouditor_service "clouditor.io/clouditor/service"
+
+	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/oauth2/clientcredentials"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/eclipse-xfsc/cam/api/collection"
+	"github.com/eclipse-xfsc/cam/internal/config"
+	"github.com/eclipse-xfsc/cam/internal/metrics"
+	"github.com/eclipse-xfsc/cam/internal/tracing"
+	"github.com/eclipse-xfsc/cam/service"
+	servicecollection "github.com/eclipse-xfsc/cam/service/collection"
+	"github.com/eclipse-xfsc/cam/service/collection/certification"
+)
+
+var (
+	log       *logrus.Entry
+	oAuthCred clientcredentials.Config
+)
+
+const (
+	DefaultGrpcPort = 50056
+	// DefaultMetricsPort is the default port, on which the metrics are exposed
+	DefaultMetricsPort uint16 = 9156
+	// APIJWKSURLFlag specifies the JWKS URL that is used to validate the incoming authentication tokens.
+	APIJWKSURLFlag         = "api-jwks-url"
+	OAuth2EndpointFlag     = "oauth2-token-endpoint"
+	OAuth2ClientIDFlag     = "oauth2-client-id"
+	OAuth2ClientSecretFlag = "oauth2-client-secret"
+	OAuth2ScopesFlag       = "oauth2-scopes"
+	// RegisterFlag specifies whether the collection module registers itself with the Requirements Manager
+	RegisterFlag = "register"
+	// AdvertiseAddressFlag specifies the address under which the collection module is reachable by the Requirements
+	// Manager. It defaults to the host name and the gRPC port.
+	AdvertiseAddressFlag = "advertise-address"
+	// ConfigurationServiceAddressFlag specifies the address of the configuration service (cam-req-manager)
+	ConfigurationServiceAddressFlag = "configuration-service-address"
+	// EvidenceSigningKeyFlag specifies a file with the PEM encoded private key, with which the evidences are signed
+	EvidenceSigningKeyFlag = "evidence-signing-key"
+	// EvidenceSigningKeyIDFlag specifies the ID of the signing key, which is included in the signatures
+	EvidenceSigningKeyIDFlag = "evidence-signing-key-id"
+	// MetricsPortFlag specifies the port, on which the metrics are exposed. Setting this to zero disables the metrics.
+	MetricsPortFlag = "metrics-port"
+	// TracingOTLPEndpointFlag specifies the OTLP endpoint of a collector, to which the traces are exported. Setting this
+	// to empty disables the export of traces.
+	TracingOTLPEndpointFlag = "tracing-otlp-endpoint"
+
+	// RegistryFlag specifies the default certificate registry (a local JSON file or an HTTP(S) URL), which is used if
+	// a service configuration does not specify one.
+	RegistryFlag = "registry"
+	// TrustedIssuersFlag specifies a file with the PEM-encoded certificates of the trusted issuers of signed
+	// certificate documents.
+	TrustedIssuersFlag = "trusted-issuers"
+)
+
+func init() {
+	log = logrus.WithField("component", "collection-certification")
+	log.Logger.Formatter = formatter.CapitalizeFormatter{Formatter: &logrus.TextFormatter{ForceColors: true}}
+
+	cobra.OnInitialize(config.InitConfig)
+}
+
+func newCollectionCertificationCommand() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "cam-collection-certification",
+		Short: "cam-collection-certification launches the CAM Collection Cyber Security Certification Module",
+		Long:  "The CAM Collection Cyber Security Certification Module checks the cyber security certificates (ISO 27001, C5, EUCS) declared by a provider.",
+		RunE:  doCmd,
+	}
+
+	config.AddFlagString(cmd, APIJWKSURLFlag, "", "Specifies the JWKS URL that is used to validate the incoming authentication tokens. Setting this to empty will disable authentication (not recommended for production)")
+	config.AddFlagString(cmd, OAuth2EndpointFlag, "", "Specifies the OAuth2 token URL that is used by the service to retrieve a token to authenticate with other services")
+	config.AddFlagString(cmd, OAuth2ClientIDFlag, "", "Specifies the OAuth2 client ID that is used by the service to retrieve a token to authenticate with other services")
+	config.AddFlagString(cmd, OAuth2ClientSecretFlag, "", "Specifies the OAuth2 client secret that is used by the service to retrieve a token to authenticate with other services")
+	config.AddFlagStringSlice(cmd, OAuth2ScopesFlag, []string{}, "Specifies the OAuth2 scopes that are used by the service to retrieve a token to authenticate with other services")
+	config.AddFlagBool(cmd, RegisterFlag, false, "Specifies whether the collection module registers itself with the Requirements Manager (cam-req-manager) and renews its lease periodically")
+	config.AddFlagString(cmd, AdvertiseAddressFlag, "", "Specifies the address under which the collection module is reachable by the Requirements Manager. Defaults to the host name and the gRPC port")
+	config.AddFlagString(cmd, ConfigurationServiceAddressFlag, "localhost:50100", "Specifies the address of the configuration service (cam-req-manager), with which the collection module registers itself")
+	config.AddFlagString(cmd, EvidenceSigningKeyFlag, "", "Specifies a file with the PEM encoded private key (ECDSA, Ed25519 or RSA), with which the evidences are signed. If empty, evidences are not signed")
+	config.AddFlagString(cmd, EvidenceSigningKeyIDFlag, "", "Specifies the ID of the evidence signing key, which is included in the signatures")
+	config.AddFlagUint16(cmd, MetricsPortFlag, DefaultMetricsPort, "Specifies the port, on which the metrics are exposed in the Prometheus/OpenMetrics format. Setting this to zero disables the metrics")
+	config.AddFlagString(cmd, TracingOTLPEndpointFlag, "", "Specifies the OTLP/HTTP endpoint of a collector, e.g. http://localhost:4318, to which the traces are exported. Setting this to empty disables the export of traces")
+	config.AddFlagString(cmd, RegistryFlag, "", "Specifies the default certificate registry (a local JSON file or an HTTP(S) URL)")
+	config.AddFlagString(cmd, TrustedIssuersFlag, "", "Specifies a file with the PEM-encoded certificates of the trusted issuers of signed certificate documents")
+
+	return cmd
+}
+
+func doCmd(_ *cobra.Command, _ []string) (err error) {
+	var grpcOpts []grpc.ServerOption
+
+	log.Info("Start Cyber Security Certification ...")
+
+	// create a new socket for gRPC communication
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", DefaultGrpcPort))
+	if err != nil {
+		log.Errorf("Cyber Security Certification: could not listen: %v", err)
+	}
+
+	// Get Oauth2 token URL from environment variable
+	oAuthCred.TokenURL = viper.GetString(OAuth2EndpointFlag)
+
+	// Get Oauth2 client id from environment variable
+	oAuthCred.ClientID = viper.GetString(OAuth2ClientIDFlag)
+
+	// Get Oauth2 client secret from environment variable
+	oAuthCred.ClientSecret = viper.GetString(OAuth2ClientSecretFlag)
+
+	// Get Oauth2 scopes from environment variable
+	oAuthCred.Scopes = viper.GetStringSlice(OAuth2ScopesFlag)
+
+	// Expose the metrics, including the ones of the gRPC server
+	metrics.Serve(uint16(viper.GetUint(MetricsPortFlag)))
+	grpcOpts = append(grpcOpts, metrics.ServerOptions()...)
+
+	// Export the traces, including the ones of the gRPC server
+	shutdownTracing, err := tracing.ExportTo(viper.GetString(TracingOTLPEndpointFlag), "cam-collection-certification")
+	if err != nil {
+		return fmt.Errorf("could not export traces: %w", err)
+	}
+	defer shutdownTracing()
+	grpcOpts = append(grpcOpts, tracing.ServerOptions()...)
+
+	jwks := viper.GetString(APIJWKSURLFlag)
+	if jwks != "" {
+		log.Infof("Configuring API with JWKS URL %s to validate tokens", jwks)
+		authConfig := clouditor_service.ConfigureAuth(clouditor_service.WithJWKSURL(jwks))
+		defer authConfig.Jwks.EndBackground()
+
+		grpcOpts = append(grpcOpts, grpc.ChainUnaryInterceptor(
+			grpc_auth.UnaryServerInterceptor(authConfig.AuthFunc),
+		), grpc.ChainStreamInterceptor(
+			grpc_auth.StreamServerInterceptor(authConfig.AuthFunc),
+		))
+	}
+	var opts []service.ServiceOption[certification.Server]
+	if oAuthCred.TokenURL != "" {
+		log.Infof("Configuring service with OAuth 2.0 using %s and client ID %s (scopes: %v)",
+			oAuthCred.TokenURL, oAuthCred.ClientID, oAuthCred.Scopes)
+		opts = append(opts, certification.WithOAuth2Authorizer(&oAuthCred))
+	}
+
+	if file := viper.GetString(EvidenceSigningKeyFlag); file != "" {
+		signer, err := servicecollection.LoadSigner(file, viper.GetString(EvidenceSigningKeyIDFlag))
+		if err != nil {
+			return fmt.Errorf("could not load evidence signing key: %w", err)
+		}
+
+		log.Infof("Signing evidences with the key in %s", file)
+		opts = append(opts, certification.WithSigner(signer))
+	}
+
+	if registry := viper.GetString(RegistryFlag); registry != "" {
+		log.Infof("Using default certificate registry %s", registry)
+		opts = append(opts, certification.WithRegistry(registry))
+	}
+
+	if file := viper.GetString(TrustedIssuersFlag); file != "" {
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("could not read trusted issuers: %w", err)
+		}
+
+		issuers, err := certification.ParseCertificates(b)
+		if err != nil {
+			return fmt.Errorf("could not parse trusted issuers: %w", err)
+		}
+
+		log.Infof("Trusting %d issuer(s) of signed certificate documents", len(issuers))
+		opts = append(opts, certification.WithTrustedIssuers(issuers...))
+	}
+
+	// Create gRPC Server (srv) and register cyber security certification service (svc) on it
+	srv := grpc.NewServer(grpcOpts...)
+	svc := certification.NewServer(opts...)
+	collection.RegisterCollectionServer(srv, svc)
+
+	if viper.GetBool(RegisterFlag) {
+		reg := &servicecollection.Registration{
+			ID:                  certification.ComponentID,
+			Name:                "Cyber Security Certification",
+			Address:             viper.GetString(AdvertiseAddressFlag),
+			RequirementsManager: viper.GetString(ConfigurationServiceAddressFlag),
+		}
+		if reg.Address == "" {
+			reg.Address = servicecollection.DefaultAddress(DefaultGrpcPort)
+		}
+
+		go func() {
+			if err := servicecollection.Register(context.Background(), reg, svc); err != nil {
+				log.Errorf("Could not register with the Requirements Manager: %v", err)
+			}
+		}()
+	}
+
+	// Enable reflection, primary for testing in early stages
+	reflection.Register(srv)
+
+	// Start server (blocks until process is killed or stopped)
+	log.Infof("Starting gRPC server for Cyber Security Certification CM on port: %d", DefaultGrpcPort)
+	if err = srv.Serve(lis); err != nil {
+		log.Fatalf("Cyber Security Certification CM: failed to serve: %v", err)
+	}
+
+	return nil
+}
+
+func main() {
+	var cmd = newCollectionCertificationCommand()
+
+	if err := cmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
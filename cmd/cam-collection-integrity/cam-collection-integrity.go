@@ -16,6 +16,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"os"
@@ -23,7 +24,6 @@ import (
 	"clouditor.io/clouditor/logging/formatter"
 	clouditor_service "clouditor.io/clouditor/service"
 
-	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -34,7 +34,10 @@ import (
 
 	"github.com/eclipse-xfsc/cam/api/collection"
 	"github.com/eclipse-xfsc/cam/internal/config"
+	"github.com/eclipse-xfsc/cam/internal/metrics"
+	"github.com/eclipse-xfsc/cam/internal/tracing"
 	"github.com/eclipse-xfsc/cam/service"
+	servicecollection "github.com/eclipse-xfsc/cam/service/collection"
 	"github.com/eclipse-xfsc/cam/service/collection/integrity"
 )
 
@@ -45,12 +48,42 @@ var (
 
 const (
 	DefaultGrpcPort = 50053
+	// DefaultMetricsPort is the default port, on which the metrics are exposed
+	DefaultMetricsPort uint16 = 9153
 	// APIJWKSURLFlag specifies the JWKS URL that is used to validate the incoming authentication tokens.
 	APIJWKSURLFlag         = "api-jwks-url"
 	OAuth2EndpointFlag     = "oauth2-token-endpoint"
 	OAuth2ClientIDFlag     = "oauth2-client-id"
 	OAuth2ClientSecretFlag = "oauth2-client-secret"
 	OAuth2ScopesFlag       = "oauth2-scopes"
+	// RegisterFlag specifies whether the collection module registers itself with the Requirements Manager
+	RegisterFlag = "register"
+	// AdvertiseAddressFlag specifies the address under which the collection module is reachable by the Requirements
+	// Manager. It defaults to the host name and the gRPC port.
+	AdvertiseAddressFlag = "advertise-address"
+	// MaxParallelAttestationsFlag specifies the maximum number of targets that are attested concurrently.
+	MaxParallelAttestationsFlag = "max-parallel-attestations"
+	// ConfigurationServiceAddressFlag specifies the address of the configuration service (cam-req-manager), which
+	// provides the attestation trust store.
+	ConfigurationServiceAddressFlag = "configuration-service-address"
+	// EvidenceSigningKeyFlag specifies a file with the PEM encoded private key, with which the evidences are signed
+	EvidenceSigningKeyFlag = "evidence-signing-key"
+	// EvidenceSigningKeyIDFlag specifies the ID of the signing key, which is included in the signatures
+	EvidenceSigningKeyIDFlag = "evidence-signing-key-id"
+	// MetricsPortFlag specifies the port, on which the metrics are exposed. Setting this to zero disables the metrics.
+	MetricsPortFlag = "metrics-port"
+	// TracingOTLPEndpointFlag specifies the OTLP endpoint of a collector, to which the traces are exported. Setting this
+	// to empty disables the export of traces.
+	TracingOTLPEndpointFlag = "tracing-otlp-endpoint"
+	// SecretRefAllowedPathsFlag specifies the directories, whose files can be referenced as secrets in service
+	// configurations
+	SecretRefAllowedPathsFlag = "secret-ref-allowed-paths"
+	// SecretRefAllowedEnvPrefixFlag specifies the prefix of the environment variables, which can be referenced as
+	// secrets in service configurations
+	SecretRefAllowedEnvPrefixFlag = "secret-ref-allowed-env-prefix"
+	// SecretRefAllowedNamespacesFlag specifies the Kubernetes namespaces, whose Secrets can be referenced in service
+	// configurations
+	SecretRefAllowedNamespacesFlag = "secret-ref-allowed-namespaces"
 )
 
 func init() {
@@ -73,6 +106,17 @@ func newCollectionIntegrityCommand() *cobra.Command {
 	config.AddFlagString(cmd, OAuth2ClientIDFlag, "", "Specifies the OAuth2 client ID that is used by the service to retrieve a token to authenticate with other services")
 	config.AddFlagString(cmd, OAuth2ClientSecretFlag, "", "Specifies the OAuth2 client secret that is used by the service to retrieve a token to authenticate with other services")
 	config.AddFlagStringSlice(cmd, OAuth2ScopesFlag, []string{}, "Specifies the OAuth2 scopes that are used by the service to retrieve a token to authenticate with other services")
+	config.AddFlagBool(cmd, RegisterFlag, false, "Specifies whether the collection module registers itself with the Requirements Manager (cam-req-manager) and renews its lease periodically")
+	config.AddFlagString(cmd, AdvertiseAddressFlag, "", "Specifies the address under which the collection module is reachable by the Requirements Manager. Defaults to the host name and the gRPC port")
+	config.AddFlagString(cmd, ConfigurationServiceAddressFlag, integrity.DefaultRequirementsManagerAddress, "Specifies the address of the configuration service (cam-req-manager), which provides the attestation trust store")
+	config.AddFlagString(cmd, EvidenceSigningKeyFlag, "", "Specifies a file with the PEM encoded private key (ECDSA, Ed25519 or RSA), with which the evidences are signed. If empty, evidences are not signed")
+	config.AddFlagString(cmd, EvidenceSigningKeyIDFlag, "", "Specifies the ID of the evidence signing key, which is included in the signatures")
+	config.AddFlagUint16(cmd, MetricsPortFlag, DefaultMetricsPort, "Specifies the port, on which the metrics are exposed in the Prometheus/OpenMetrics format. Setting this to zero disables the metrics")
+	config.AddFlagString(cmd, TracingOTLPEndpointFlag, "", "Specifies the OTLP/HTTP endpoint of a collector, e.g. http://localhost:4318, to which the traces are exported. Setting this to empty disables the export of traces")
+	config.AddFlagStringSlice(cmd, SecretRefAllowedPathsFlag, []string{}, "Specifies the directories, e.g. /run/secrets, whose files can be referenced as secrets in service configurations. If empty, no files can be referenced")
+	config.AddFlagString(cmd, SecretRefAllowedEnvPrefixFlag, "", "Specifies the prefix of the environment variables, e.g. CAM_SECRET_, which can be referenced as secrets in service configurations. If empty, no environment variables can be referenced")
+	config.AddFlagStringSlice(cmd, SecretRefAllowedNamespacesFlag, []string{}, "Specifies the Kubernetes namespaces, whose Secrets can be referenced in service configurations. If empty, no Kubernetes Secrets can be referenced")
+	config.AddFlagUint16(cmd, MaxParallelAttestationsFlag, integrity.DefaultMaxParallelAttestations, "Specifies the maximum number of targets of a service that are attested concurrently")
 
 	return cmd
 }
@@ -100,31 +144,79 @@ func doCmd(_ *cobra.Command, _ []string) (err error) {
 	// Get Oauth2 scopes from environment variable
 	oAuthCred.Scopes = viper.GetStringSlice(OAuth2ScopesFlag)
 
+	// Expose the metrics, including the ones of the gRPC server
+	metrics.Serve(uint16(viper.GetUint(MetricsPortFlag)))
+	grpcOpts = append(grpcOpts, metrics.ServerOptions()...)
+
+	// Export the traces, including the ones of the gRPC server
+	shutdownTracing, err := tracing.ExportTo(viper.GetString(TracingOTLPEndpointFlag), "cam-collection-integrity")
+	if err != nil {
+		return fmt.Errorf("could not export traces: %w", err)
+	}
+	defer shutdownTracing()
+	grpcOpts = append(grpcOpts, tracing.ServerOptions()...)
+
 	jwks := viper.GetString(APIJWKSURLFlag)
 	if jwks != "" {
 		log.Infof("Configuring API with JWKS URL %s to validate tokens", jwks)
 		authConfig := clouditor_service.ConfigureAuth(clouditor_service.WithJWKSURL(jwks))
 		defer authConfig.Jwks.EndBackground()
 
-		grpcOpts = append(grpcOpts, grpc_middleware.WithUnaryServerChain(
+		grpcOpts = append(grpcOpts, grpc.ChainUnaryInterceptor(
 			grpc_auth.UnaryServerInterceptor(authConfig.AuthFunc),
-		), grpc_middleware.WithStreamServerChain(
+		), grpc.ChainStreamInterceptor(
 			grpc_auth.StreamServerInterceptor(authConfig.AuthFunc),
 		))
 	}
 
-	var opts []service.ServiceOption[integrity.Server]
+	var opts = []service.ServiceOption[integrity.Server]{
+		integrity.WithMaxParallelAttestations(viper.GetInt(MaxParallelAttestationsFlag)),
+		integrity.WithRequirementsManagerAddress(viper.GetString(ConfigurationServiceAddressFlag)),
+	}
 	if oAuthCred.TokenURL != "" {
 		log.Infof("Configuring service with OAuth 2.0 using %s and client ID %s (scopes: %v)",
 			oAuthCred.TokenURL, oAuthCred.ClientID, oAuthCred.Scopes)
 		opts = append(opts, integrity.WithOAuth2Authorizer(&oAuthCred))
 	}
 
+	// Only the secrets allowed by the operator can be referenced in service configurations
+	servicecollection.DefaultSecretResolver.AllowedFilePrefixes = viper.GetStringSlice(SecretRefAllowedPathsFlag)
+	servicecollection.DefaultSecretResolver.AllowedEnvPrefix = viper.GetString(SecretRefAllowedEnvPrefixFlag)
+	servicecollection.DefaultSecretResolver.AllowedNamespaces = viper.GetStringSlice(SecretRefAllowedNamespacesFlag)
+
+	if file := viper.GetString(EvidenceSigningKeyFlag); file != "" {
+		signer, err := servicecollection.LoadSigner(file, viper.GetString(EvidenceSigningKeyIDFlag))
+		if err != nil {
+			return fmt.Errorf("could not load evidence signing key: %w", err)
+		}
+
+		log.Infof("Signing evidences with the key in %s", file)
+		opts = append(opts, integrity.WithSigner(signer))
+	}
+
 	// Create gRPC Server (srv) and register remote integrity service (svc) on it
 	srv := grpc.NewServer(grpcOpts...)
 	svc := integrity.NewServer(opts...)
 	collection.RegisterCollectionServer(srv, svc)
 
+	if viper.GetBool(RegisterFlag) {
+		reg := &servicecollection.Registration{
+			ID:                  integrity.ComponentID,
+			Name:                "Remote Integrity",
+			Address:             viper.GetString(AdvertiseAddressFlag),
+			RequirementsManager: viper.GetString(ConfigurationServiceAddressFlag),
+		}
+		if reg.Address == "" {
+			reg.Address = servicecollection.DefaultAddress(DefaultGrpcPort)
+		}
+
+		go func() {
+			if err := servicecollection.Register(context.Background(), reg, svc); err != nil {
+				log.Errorf("Could not register with the Requirements Manager: %v", err)
+			}
+		}()
+	}
+
 	// Enable reflection, primary for testing in early stages
 	reflection.Register(srv)
 
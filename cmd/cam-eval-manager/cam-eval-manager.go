@@ -16,15 +16,23 @@
 package main
 
 import (
+	"crypto"
 	"fmt"
 	"net"
 	"os"
+	"strings"
+	"time"
 
 	"golang.org/x/oauth2/clientcredentials"
 
 	"github.com/eclipse-xfsc/cam/api/common"
 	"github.com/eclipse-xfsc/cam/api/evaluation"
+	"github.com/eclipse-xfsc/cam/internal/authz"
 	"github.com/eclipse-xfsc/cam/internal/config"
+	"github.com/eclipse-xfsc/cam/internal/metrics"
+	"github.com/eclipse-xfsc/cam/internal/notification"
+	"github.com/eclipse-xfsc/cam/internal/signing"
+	"github.com/eclipse-xfsc/cam/internal/tracing"
 	"github.com/eclipse-xfsc/cam/service"
 	serviceEvaluation "github.com/eclipse-xfsc/cam/service/evaluation"
 
@@ -33,7 +41,6 @@ import (
 	"clouditor.io/clouditor/persistence/gorm"
 	clouditor_service "clouditor.io/clouditor/service"
 
-	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 
 	"github.com/sirupsen/logrus"
@@ -50,6 +57,7 @@ var (
 		evaluation.EvaluationResult{},
 		evaluation.Compliance{},
 		common.Evidence{},
+		notification.DeadLetter{},
 	}
 	oAuthCred clientcredentials.Config
 )
@@ -72,8 +80,54 @@ const (
 	// APIJWKSURLFlag specifies the JWKS URL that is used to validate the incoming authentication tokens.
 	APIJWKSURLFlag = "api-jwks-url"
 
+	// EvidenceVerificationKeysFlag specifies the public keys of the collection modules as a list of
+	// <tool ID>=<PEM file>, with which the signatures of their evidences are verified.
+	EvidenceVerificationKeysFlag = "evidence-verification-keys"
+	// EvidenceSignaturesRequiredFlag specifies whether evidences without a verifiable signature are rejected
+	EvidenceSignaturesRequiredFlag = "evidence-signatures-required"
+
+	// CredentialIssuerFlag specifies the issuer of compliance credentials, e.g., the DID of the operator of CAM
+	CredentialIssuerFlag = "credential-issuer"
+	// CredentialIssuerKeyFlag specifies a file with the PEM encoded private key, with which compliance credentials
+	// are signed
+	CredentialIssuerKeyFlag = "credential-issuer-key"
+	// CredentialIssuerKeyIDFlag specifies the ID of the issuer key, which is included in the credentials
+	CredentialIssuerKeyIDFlag = "credential-issuer-key-id"
+	// CredentialTrustedKeysFlag specifies further public keys as a list of <key ID>=<PEM file>, with which compliance
+	// credentials are verified, e.g., previous issuer keys
+	CredentialTrustedKeysFlag = "credential-trusted-keys"
+	// CredentialValidityDaysFlag specifies the number of days, for which compliance credentials are valid
+	CredentialValidityDaysFlag = "credential-validity-days"
+
+	// NotificationWebhooksFlag specifies the URLs of webhooks, which are notified about changes of the compliance status
+	NotificationWebhooksFlag = "notification-webhooks"
+	// NotificationWebhookSecretFlag specifies the secret, with which the requests to the webhooks are signed
+	NotificationWebhookSecretFlag = "notification-webhook-secret"
+	// NotificationSMTPAddressFlag specifies the address (host:port) of the SMTP server, via which notifications are
+	// sent as emails
+	NotificationSMTPAddressFlag = "notification-smtp-address"
+	// NotificationSMTPFromFlag specifies the sender of notification emails
+	NotificationSMTPFromFlag = "notification-smtp-from"
+	// NotificationSMTPToFlag specifies the recipients of notification emails
+	NotificationSMTPToFlag = "notification-smtp-to"
+	// NotificationSMTPUsernameFlag specifies the username for the SMTP server
+	NotificationSMTPUsernameFlag = "notification-smtp-username"
+	// NotificationSMTPPasswordFlag specifies the password for the SMTP server
+	NotificationSMTPPasswordFlag = "notification-smtp-password"
+	// NotificationMaxAttemptsFlag specifies how often the delivery of a notification is attempted
+	NotificationMaxAttemptsFlag = "notification-max-attempts"
+	// NotificationRetryBackoffFlag specifies the delay in seconds before the first retry, which is doubled for each
+	// further retry
+	NotificationRetryBackoffFlag = "notification-retry-backoff"
+	// MetricsPortFlag specifies the port, on which the metrics are exposed. Setting this to zero disables the metrics.
+	MetricsPortFlag = "metrics-port"
+	// TracingOTLPEndpointFlag specifies the OTLP endpoint of a collector, to which the traces are exported. Setting this
+	// to empty disables the export of traces.
+	TracingOTLPEndpointFlag = "tracing-otlp-endpoint"
+
 	DefaultConfigurationServiceAddress        = "localhost:50100"
 	DefaultAPIgRPCPort                 uint16 = 50101
+	DefaultMetricsPort                 uint16 = 9101
 
 	DefaultDBUser            = "postgres"
 	DefaultDBPassword        = "postgres"
@@ -115,6 +169,24 @@ func newEvalManagerCommand() *cobra.Command {
 	config.AddFlagString(cmd, OAuth2ClientIDFlag, "", "Specifies the OAuth2 client ID that is used by the service to retrieve a token to authenticate with other services")
 	config.AddFlagString(cmd, OAuth2ClientSecretFlag, "", "Specifies the OAuth2 client secret that is used by the service to retrieve a token to authenticate with other services")
 	config.AddFlagStringSlice(cmd, OAuth2ScopesFlag, []string{}, "Specifies the OAuth2 scopes that are used by the service to retrieve a token to authenticate with other services")
+	config.AddFlagStringSlice(cmd, EvidenceVerificationKeysFlag, []string{}, "Specifies the public keys (PEM files) of the collection modules as <tool ID>=<file>, with which the signatures of their evidences are verified")
+	config.AddFlagBool(cmd, EvidenceSignaturesRequiredFlag, false, "Specifies whether evidences without a verifiable signature are rejected")
+	config.AddFlagString(cmd, CredentialIssuerFlag, "", "Specifies the issuer of compliance credentials, e.g., the DID of the operator of CAM")
+	config.AddFlagString(cmd, CredentialIssuerKeyFlag, "", "Specifies a file with the PEM encoded private key (ECDSA, Ed25519 or RSA), with which compliance credentials are signed. If empty, no compliance credentials are issued")
+	config.AddFlagString(cmd, CredentialIssuerKeyIDFlag, "", "Specifies the ID of the issuer key, which is included in the compliance credentials")
+	config.AddFlagStringSlice(cmd, CredentialTrustedKeysFlag, []string{}, "Specifies further public keys (PEM files) as <key ID>=<file>, with which compliance credentials are verified, e.g., previous issuer keys")
+	config.AddFlagUint16(cmd, CredentialValidityDaysFlag, 30, "Specifies the number of days, for which compliance credentials are valid")
+	config.AddFlagStringSlice(cmd, NotificationWebhooksFlag, []string{}, "Specifies the URLs of webhooks, which are notified about changes of the compliance status of controls")
+	config.AddFlagString(cmd, NotificationWebhookSecretFlag, "", "Specifies the secret, with which the requests to the webhooks are signed (HMAC-SHA256)")
+	config.AddFlagString(cmd, NotificationSMTPAddressFlag, "", "Specifies the address (host:port) of the SMTP server, via which notifications about changes of the compliance status are sent. If empty, no emails are sent")
+	config.AddFlagString(cmd, NotificationSMTPFromFlag, "", "Specifies the sender of notification emails")
+	config.AddFlagStringSlice(cmd, NotificationSMTPToFlag, []string{}, "Specifies the recipients of notification emails")
+	config.AddFlagString(cmd, NotificationSMTPUsernameFlag, "", "Specifies the username for the SMTP server. If empty, no authentication is used")
+	config.AddFlagString(cmd, NotificationSMTPPasswordFlag, "", "Specifies the password for the SMTP server")
+	config.AddFlagUint16(cmd, NotificationMaxAttemptsFlag, notification.DefaultMaxAttempts, "Specifies how often the delivery of a notification is attempted before it is stored as dead letter")
+	config.AddFlagUint16(cmd, MetricsPortFlag, DefaultMetricsPort, "Specifies the port, on which the metrics are exposed in the Prometheus/OpenMetrics format. Setting this to zero disables the metrics")
+	config.AddFlagString(cmd, TracingOTLPEndpointFlag, "", "Specifies the OTLP/HTTP endpoint of a collector, e.g. http://localhost:4318, to which the traces are exported. Setting this to empty disables the export of traces")
+	config.AddFlagUint16(cmd, NotificationRetryBackoffFlag, uint16(notification.DefaultBackoff/time.Second), "Specifies the delay in seconds before the first retry of a notification, which is doubled for each further retry")
 
 	return cmd
 }
@@ -175,16 +247,32 @@ func doCmd(_ *cobra.Command, _ []string) (err error) {
 	// Get Oauth2 scopes from environment variable
 	oAuthCred.Scopes = viper.GetStringSlice(OAuth2ScopesFlag)
 
+	// Expose the metrics, including the ones of the gRPC server
+	metrics.Serve(uint16(viper.GetUint(MetricsPortFlag)))
+	grpcOpts = append(grpcOpts, metrics.ServerOptions()...)
+
+	// Export the traces, including the ones of the gRPC server
+	shutdownTracing, err := tracing.ExportTo(viper.GetString(TracingOTLPEndpointFlag), "cam-eval-manager")
+	if err != nil {
+		return fmt.Errorf("could not export traces: %w", err)
+	}
+	defer shutdownTracing()
+	grpcOpts = append(grpcOpts, tracing.ServerOptions()...)
+
 	jwks := viper.GetString(APIJWKSURLFlag)
 	if jwks != "" {
-		log.Infof("Configuring API with JWKS URL %s to validate tokens", jwks)
+		log.Infof("Configuring API with JWKS URL %s to validate tokens and role-based authorization", jwks)
 		authConfig := clouditor_service.ConfigureAuth(clouditor_service.WithJWKSURL(jwks))
 		defer authConfig.Jwks.EndBackground()
 
-		grpcOpts = append(grpcOpts, grpc_middleware.WithUnaryServerChain(
+		grpcOpts = append(grpcOpts, grpc.ChainUnaryInterceptor(
 			grpc_auth.UnaryServerInterceptor(authConfig.AuthFunc),
-		), grpc_middleware.WithStreamServerChain(
+			service.ClaimsUnaryInterceptor,
+			authz.DefaultPolicy.UnaryServerInterceptor,
+		), grpc.ChainStreamInterceptor(
 			grpc_auth.StreamServerInterceptor(authConfig.AuthFunc),
+			service.ClaimsStreamInterceptor,
+			authz.DefaultPolicy.StreamServerInterceptor,
 		))
 	}
 
@@ -199,7 +287,91 @@ func doCmd(_ *cobra.Command, _ []string) (err error) {
 		opts = append(opts, serviceEvaluation.WithOAuth2Authorizer(&oAuthCred))
 	}
 
+	if keys := viper.GetStringSlice(EvidenceVerificationKeysFlag); len(keys) > 0 {
+		evidenceKeys := make(map[string]crypto.PublicKey)
+		for _, k := range keys {
+			toolID, file, ok := strings.Cut(k, "=")
+			if !ok {
+				return fmt.Errorf("invalid evidence verification key %s: expected <tool ID>=<file>", k)
+			}
+
+			evidenceKeys[toolID], err = signing.LoadPublicKey(file)
+			if err != nil {
+				return fmt.Errorf("could not load evidence verification key of %s: %w", toolID, err)
+			}
+		}
+
+		log.Infof("Verifying the evidences of %d collection module(s)", len(evidenceKeys))
+		opts = append(opts, serviceEvaluation.WithEvidenceVerificationKeys(evidenceKeys))
+	}
+
+	if viper.GetBool(EvidenceSignaturesRequiredFlag) {
+		log.Info("Rejecting evidences without a verifiable signature")
+		opts = append(opts, serviceEvaluation.WithRequiredEvidenceSignatures())
+	}
+
+	if file := viper.GetString(CredentialIssuerKeyFlag); file != "" {
+		key, err := signing.LoadPrivateKey(file)
+		if err != nil {
+			return fmt.Errorf("could not load credential issuer key: %w", err)
+		}
+
+		if err = serviceEvaluation.CheckCredentialIssuerKey(key); err != nil {
+			return fmt.Errorf("could not use credential issuer key: %w", err)
+		}
+
+		log.Infof("Issuing compliance credentials as %s", viper.GetString(CredentialIssuerFlag))
+		opts = append(opts,
+			serviceEvaluation.WithCredentialIssuer(viper.GetString(CredentialIssuerFlag), key,
+				viper.GetString(CredentialIssuerKeyIDFlag)),
+			serviceEvaluation.WithCredentialValidity(
+				time.Duration(viper.GetUint(CredentialValidityDaysFlag))*24*time.Hour))
+	}
+
+	if keys := viper.GetStringSlice(CredentialTrustedKeysFlag); len(keys) > 0 {
+		trusted := make(map[string]crypto.PublicKey)
+		for _, k := range keys {
+			kid, file, ok := strings.Cut(k, "=")
+			if !ok {
+				return fmt.Errorf("invalid trusted credential key %s: expected <key ID>=<file>", k)
+			}
+
+			trusted[kid], err = signing.LoadPublicKey(file)
+			if err != nil {
+				return fmt.Errorf("could not load trusted credential key %s: %w", kid, err)
+			}
+		}
+
+		opts = append(opts, serviceEvaluation.WithTrustedCredentialKeys(trusted))
+	}
+
+	var channels []notification.Channel
+	for _, url := range viper.GetStringSlice(NotificationWebhooksFlag) {
+		channels = append(channels, notification.NewWebhook(url, []byte(viper.GetString(NotificationWebhookSecretFlag))))
+	}
+	if address := viper.GetString(NotificationSMTPAddressFlag); address != "" {
+		channels = append(channels, notification.NewSMTP(address,
+			viper.GetString(NotificationSMTPFromFlag),
+			viper.GetStringSlice(NotificationSMTPToFlag),
+			viper.GetString(NotificationSMTPUsernameFlag),
+			viper.GetString(NotificationSMTPPasswordFlag)))
+	}
+	if len(channels) > 0 {
+		if len(viper.GetStringSlice(NotificationWebhooksFlag)) > 0 && viper.GetString(NotificationWebhookSecretFlag) == "" {
+			log.Warn("No webhook secret configured: Receivers cannot verify that notifications originate from CAM")
+		}
+
+		log.Infof("Notifying %d channel(s) about changes of the compliance status", len(channels))
+		notifier := notification.NewDispatcher(db, int(viper.GetUint(NotificationMaxAttemptsFlag)),
+			time.Duration(viper.GetUint(NotificationRetryBackoffFlag))*time.Second, channels...)
+		defer notifier.Close()
+
+		opts = append(opts, serviceEvaluation.WithNotifier(notifier))
+	}
+
+	// The server is stopped before the notifier is closed, so that no RPC notifies about changes afterwards
 	srv := grpc.NewServer(grpcOpts...)
+	defer srv.Stop()
 	svc := serviceEvaluation.NewServer(opts...)
 	evaluation.RegisterEvaluationServer(srv, svc)
 
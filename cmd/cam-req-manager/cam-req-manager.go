@@ -20,11 +20,16 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"time"
 
 	"github.com/eclipse-xfsc/cam/api/collection"
 	"github.com/eclipse-xfsc/cam/api/configuration"
+	"github.com/eclipse-xfsc/cam/internal/authz"
 	"github.com/eclipse-xfsc/cam/internal/config"
+	"github.com/eclipse-xfsc/cam/internal/metrics"
 	"github.com/eclipse-xfsc/cam/internal/protobuf"
+	"github.com/eclipse-xfsc/cam/internal/secrets"
+	"github.com/eclipse-xfsc/cam/internal/tracing"
 	"github.com/eclipse-xfsc/cam/service"
 	service_configuration "github.com/eclipse-xfsc/cam/service/configuration"
 
@@ -34,8 +39,8 @@ import (
 	"clouditor.io/clouditor/persistence"
 	"clouditor.io/clouditor/persistence/gorm"
 	clouditor_service "clouditor.io/clouditor/service"
-	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -45,9 +50,18 @@ import (
 )
 
 var (
-	log       *logrus.Entry
-	db        persistence.Storage
-	types     = []any{collection.CollectionModule{}, collection.ServiceConfiguration{}}
+	log   *logrus.Entry
+	db    persistence.Storage
+	types = []any{
+		collection.CollectionModule{},
+		collection.ServiceConfiguration{},
+		configuration.AttestationTrustAnchor{},
+		configuration.AttestationReferenceManifest{},
+		configuration.RevokedCertificate{},
+		configuration.AttestationTrustStoreVersion{},
+		configuration.CloudServiceTenant{},
+		configuration.AuditEvent{},
+	}
 	oAuthCred clientcredentials.Config
 )
 
@@ -69,6 +83,39 @@ const (
 	// APIJWKSURLFlag specifies the JWKS URL that is used to validate the incoming authentication tokens.
 	APIJWKSURLFlag = "api-jwks-url"
 
+	// ConfigKeyFileFlag specifies the key file, which contains the keys to encrypt the secrets of service
+	// configurations at rest. It is created, if it does not exist. Setting this to empty stores the secrets in
+	// plaintext.
+	ConfigKeyFileFlag = "config-key-file"
+	// ConfigKeyRotateFlag specifies whether a new key should be created at start-up. All secrets are re-encrypted with
+	// the new key.
+	ConfigKeyRotateFlag = "config-key-rotate"
+
+	// HealthCheckIntervalFlag specifies the interval in seconds in which the health of all collection modules is
+	// checked. Setting this to zero disables the periodic health checks.
+	HealthCheckIntervalFlag = "health-check-interval"
+
+	// MetricsPortFlag specifies the port, on which the metrics are exposed. Setting this to zero disables the metrics.
+	MetricsPortFlag = "metrics-port"
+	// TracingOTLPEndpointFlag specifies the OTLP endpoint of a collector, to which the traces are exported. Setting this
+	// to empty disables the export of traces.
+	TracingOTLPEndpointFlag = "tracing-otlp-endpoint"
+
+	// CollectionMaxJitterFlag specifies the maximum random delay in seconds of each collection run, which spreads the
+	// load on the collection modules. Setting this to zero disables the delay.
+	CollectionMaxJitterFlag = "collection-max-jitter"
+
+	// ComplianceDebounceWindowFlag specifies the default time in milliseconds, for which incoming assessment results of
+	// a metric are coalesced, before the compliance calculation is triggered.
+	ComplianceDebounceWindowFlag = "compliance-debounce-window"
+	// ComplianceDebounceThresholdFlag specifies the default number of assessment results of a metric, which trigger
+	// the compliance calculation before the debounce window has passed.
+	ComplianceDebounceThresholdFlag = "compliance-debounce-threshold"
+	// ComplianceRecalculationIntervalFlag specifies the interval in seconds, in which the compliance of monitored
+	// controls is recalculated, if it has not been calculated in the meantime. Setting this to zero disables the
+	// recalculation.
+	ComplianceRecalculationIntervalFlag = "compliance-recalculation-interval"
+
 	// CollectionModuleAutoCreateFlag specifies whether collection modules should be auto-created at start-up. This is
 	// useful, if deployed with Helm.
 	CollectionModuleAutoCreateFlag = "collection-autocreate"
@@ -97,16 +144,37 @@ const (
 	// CollectionWorkloadServicePort, if CollectionModuleAutoCreate is specified, defines the port for a default
 	// collection integrity module. In a Kubernetes cluster deployment with Helm, this will be auto-configured.
 	CollectionWorkloadServicePortFlag = "collection-workload-service-port"
-
-	DefaultCollectionModuleAutoCreate            = false
-	DefaultCollectionCommSecServiceHost          = "localhost"
-	DefaultCollectionCommSecServicePort   uint16 = 50051
-	DefaultCollectionAuthSecServiceHost          = "localhost"
-	DefaultCollectionAuthSecServicePort   uint16 = 50052
-	DefaultCollectionIntegrityServiceHost        = "localhost"
-	DefaultCollectionIntegrityServicePort uint16 = 50053
-	DefaultCollectionWorkloadServiceHost         = "localhost"
-	DefaultCollectionWorkloadServicePort  uint16 = 50054
+	// CollectionCertificationServiceHost, if CollectionModuleAutoCreate is specified, defines the host for a default
+	// collection certification module. In a Kubernetes cluster deployment with Helm, this will be auto-configured.
+	CollectionCertificationServiceHostFlag = "collection-certification-service-host"
+	// CollectionCertificationServicePort, if CollectionModuleAutoCreate is specified, defines the port for a default
+	// collection certification module. In a Kubernetes cluster deployment with Helm, this will be auto-configured.
+	CollectionCertificationServicePortFlag = "collection-certification-service-port"
+	// CollectionGaiaXServiceHost, if CollectionModuleAutoCreate is specified, defines the host for a default
+	// collection Gaia-X module. In a Kubernetes cluster deployment with Helm, this will be auto-configured.
+	CollectionGaiaXServiceHostFlag = "collection-gaiax-service-host"
+	// CollectionGaiaXServicePort, if CollectionModuleAutoCreate is specified, defines the port for a default
+	// collection Gaia-X module. In a Kubernetes cluster deployment with Helm, this will be auto-configured.
+	CollectionGaiaXServicePortFlag = "collection-gaiax-service-port"
+
+	DefaultHealthCheckInterval                uint16 = 60
+	DefaultCollectionMaxJitter                uint16 = 10
+	DefaultComplianceDebounceWindow           uint16 = 2000
+	DefaultComplianceDebounceThreshold        uint16 = 100
+	DefaultComplianceRecalculationInterval    uint16 = 3600
+	DefaultCollectionModuleAutoCreate                = false
+	DefaultCollectionCommSecServiceHost              = "localhost"
+	DefaultCollectionCommSecServicePort       uint16 = 50051
+	DefaultCollectionAuthSecServiceHost              = "localhost"
+	DefaultCollectionAuthSecServicePort       uint16 = 50052
+	DefaultCollectionIntegrityServiceHost            = "localhost"
+	DefaultCollectionIntegrityServicePort     uint16 = 50053
+	DefaultCollectionWorkloadServiceHost             = "localhost"
+	DefaultCollectionWorkloadServicePort      uint16 = 50054
+	DefaultCollectionCertificationServiceHost        = "localhost"
+	DefaultCollectionCertificationServicePort uint16 = 50056
+	DefaultCollectionGaiaXServiceHost                = "localhost"
+	DefaultCollectionGaiaXServicePort         uint16 = 50057
 
 	// DefaultEvaluationServiceAddress sets the default target address (evaluation) for the collection modules
 	DefaultEvaluationServiceAddress = "localhost:50101"
@@ -121,6 +189,8 @@ const (
 
 	// DefaultAPIgRPCPort sets the default port for the requirements manager
 	DefaultAPIgRPCPort uint16 = 50100
+	// DefaultMetricsPort sets the default port, on which the metrics of the requirements manager are exposed
+	DefaultMetricsPort uint16 = 9100
 )
 
 func init() {
@@ -150,10 +220,19 @@ func newReqManagerCommand() *cobra.Command {
 	config.AddFlagBool(cmd, DBInMemoryFlag, DefaultInMemory, "Specifies whether to use an in-memory database")
 	config.AddFlagUint16(cmd, APIgRPCPortFlag, DefaultAPIgRPCPort, "Specifies the port used by the gRPC API")
 	config.AddFlagString(cmd, APIJWKSURLFlag, "", "Specifies the JWKS URL that is used to validate the incoming authentication tokens. Setting this to empty will disable authentication (not recommended for production)")
+	config.AddFlagString(cmd, ConfigKeyFileFlag, "", "Specifies the key file used to encrypt the secrets of service configurations at rest. It is created, if it does not exist. Setting this to empty will store the secrets in plaintext (not recommended for production)")
+	config.AddFlagBool(cmd, ConfigKeyRotateFlag, false, "Specifies whether a new key should be created at start-up to re-encrypt the secrets of service configurations")
 	config.AddFlagString(cmd, OAuth2EndpointFlag, "", "Specifies the OAuth2 token URL that is used by the service to retrieve a token to authenticate with other services")
 	config.AddFlagString(cmd, OAuth2ClientIDFlag, "", "Specifies the OAuth2 client ID that is used by the service to retrieve a token to authenticate with other services")
 	config.AddFlagString(cmd, OAuth2ClientSecretFlag, "", "Specifies the OAuth2 client secret that is used by the service to retrieve a token to authenticate with other services")
 	config.AddFlagStringSlice(cmd, OAuth2ScopesFlag, []string{}, "Specifies the OAuth2 scopes that are used by the service to retrieve a token to authenticate with other services")
+	config.AddFlagUint16(cmd, CollectionMaxJitterFlag, DefaultCollectionMaxJitter, "Specifies the maximum random delay in seconds of each collection run, which spreads the load on the collection modules. Setting this to zero disables the delay")
+	config.AddFlagUint16(cmd, ComplianceDebounceWindowFlag, DefaultComplianceDebounceWindow, "Specifies the default time in milliseconds, for which incoming assessment results of a metric are coalesced, before the compliance calculation is triggered")
+	config.AddFlagUint16(cmd, ComplianceDebounceThresholdFlag, DefaultComplianceDebounceThreshold, "Specifies the default number of assessment results of a metric, which trigger the compliance calculation before the debounce window has passed")
+	config.AddFlagUint16(cmd, ComplianceRecalculationIntervalFlag, DefaultComplianceRecalculationInterval, "Specifies the interval in seconds in which the compliance of monitored controls is recalculated, if it has not been calculated in the meantime. Setting this to zero disables the recalculation")
+	config.AddFlagUint16(cmd, MetricsPortFlag, DefaultMetricsPort, "Specifies the port, on which the metrics are exposed in the Prometheus/OpenMetrics format. Setting this to zero disables the metrics")
+	config.AddFlagString(cmd, TracingOTLPEndpointFlag, "", "Specifies the OTLP/HTTP endpoint of a collector, e.g. http://localhost:4318, to which the traces are exported. Setting this to empty disables the export of traces")
+	config.AddFlagUint16(cmd, HealthCheckIntervalFlag, DefaultHealthCheckInterval, "Specifies the interval in seconds in which the health of all collection modules is checked. Setting this to zero disables the periodic health checks")
 
 	config.AddFlagBool(cmd, CollectionModuleAutoCreateFlag, DefaultCollectionModuleAutoCreate, "Specifies whether collection modules should be auto-created")
 	config.AddFlagString(cmd, CollectionCommSecServiceHostFlag, DefaultCollectionCommSecServiceHost, "Specifies the host for a default collection commsec module")
@@ -164,6 +243,10 @@ func newReqManagerCommand() *cobra.Command {
 	config.AddFlagUint16(cmd, CollectionIntegrityServicePortFlag, DefaultCollectionIntegrityServicePort, "Specifies the port for a default collection integrity module")
 	config.AddFlagString(cmd, CollectionWorkloadServiceHostFlag, DefaultCollectionWorkloadServiceHost, "Specifies the host for a default collection workload module")
 	config.AddFlagUint16(cmd, CollectionWorkloadServicePortFlag, DefaultCollectionWorkloadServicePort, "Specifies the port for a default collection workload module")
+	config.AddFlagString(cmd, CollectionCertificationServiceHostFlag, DefaultCollectionCertificationServiceHost, "Specifies the host for a default collection certification module")
+	config.AddFlagUint16(cmd, CollectionCertificationServicePortFlag, DefaultCollectionCertificationServicePort, "Specifies the port for a default collection certification module")
+	config.AddFlagString(cmd, CollectionGaiaXServiceHostFlag, DefaultCollectionGaiaXServiceHost, "Specifies the host for a default collection Gaia-X module")
+	config.AddFlagUint16(cmd, CollectionGaiaXServicePortFlag, DefaultCollectionGaiaXServicePort, "Specifies the port for a default collection Gaia-X module")
 
 	return cmd
 }
@@ -224,17 +307,37 @@ func doCmd(_ *cobra.Command, _ []string) (err error) {
 	// Get Oauth2 scopes from environment variable
 	oAuthCred.Scopes = viper.GetStringSlice(OAuth2ScopesFlag)
 
+	var (
+		unary  []grpc.UnaryServerInterceptor
+		stream []grpc.StreamServerInterceptor
+	)
+
+	// Expose the metrics, including the ones of the gRPC server
+	metrics.Serve(uint16(viper.GetUint(MetricsPortFlag)))
+	grpcOpts = append(grpcOpts, metrics.ServerOptions()...)
+
+	// Export the traces, including the ones of the gRPC server
+	shutdownTracing, err := tracing.ExportTo(viper.GetString(TracingOTLPEndpointFlag), "cam-req-manager")
+	if err != nil {
+		return fmt.Errorf("could not export traces: %w", err)
+	}
+	defer shutdownTracing()
+	grpcOpts = append(grpcOpts, tracing.ServerOptions()...)
+
 	jwks := viper.GetString(APIJWKSURLFlag)
 	if jwks != "" {
-		log.Infof("Configuring API with JWKS URL %s to validate tokens", jwks)
+		log.Infof("Configuring API with JWKS URL %s to validate tokens and role-based authorization", jwks)
 		authConfig := clouditor_service.ConfigureAuth(clouditor_service.WithJWKSURL(jwks))
 		defer authConfig.Jwks.EndBackground()
 
-		grpcOpts = append(grpcOpts, grpc_middleware.WithUnaryServerChain(
+		unary = append(unary,
 			grpc_auth.UnaryServerInterceptor(authConfig.AuthFunc),
-		), grpc_middleware.WithStreamServerChain(
+			service.ClaimsUnaryInterceptor,
+		)
+		stream = append(stream,
 			grpc_auth.StreamServerInterceptor(authConfig.AuthFunc),
-		))
+			service.ClaimsStreamInterceptor,
+		)
 	}
 
 	// Create a gRPC Server (srv) and register configuration service (svc) on it. Additionally register a Clouditor
@@ -242,6 +345,16 @@ func doCmd(_ *cobra.Command, _ []string) (err error) {
 	var opts = []service.ServiceOption[service_configuration.Server]{
 		service_configuration.WithStorage(db),
 		service_configuration.WithEvalManagerAddress(viper.GetString(EvaluationServiceAddressFlag)),
+		service_configuration.WithMaxJitter(time.Duration(viper.GetUint(CollectionMaxJitterFlag)) * time.Second),
+		service_configuration.WithHealthCheckInterval(time.Duration(viper.GetUint(HealthCheckIntervalFlag)) * time.Second),
+		service_configuration.WithDebouncer(service_configuration.NewWindowDebouncer(
+			time.Duration(viper.GetUint(ComplianceDebounceWindowFlag))*time.Millisecond,
+			int64(viper.GetUint(ComplianceDebounceThresholdFlag)))),
+		service_configuration.WithRecalculationInterval(
+			time.Duration(viper.GetUint(ComplianceRecalculationIntervalFlag)) * time.Second),
+		// Propagate the traces of collection runs and compliance calculations to the called services
+		service_configuration.WithAdditionalGRPCOpts(tracing.DialOptions()...),
+		service_configuration.WithMetricsRegisterer(prometheus.DefaultRegisterer),
 	}
 	if oAuthCred.TokenURL != "" {
 		log.Infof("Configuring service with OAuth 2.0 using %s and client ID %s (scopes: %v)",
@@ -249,8 +362,38 @@ func doCmd(_ *cobra.Command, _ []string) (err error) {
 		opts = append(opts, service_configuration.WithOAuth2Authorizer(&oAuthCred))
 	}
 
-	srv := grpc.NewServer(grpcOpts...)
+	if keyFile := viper.GetString(ConfigKeyFileFlag); keyFile != "" {
+		kp, err := secrets.NewLocalKeyProvider(keyFile)
+		if err != nil {
+			return fmt.Errorf("could not load key file: %w", err)
+		}
+
+		if viper.GetBool(ConfigKeyRotateFlag) {
+			if err = kp.Rotate(); err != nil {
+				return fmt.Errorf("could not rotate key: %w", err)
+			}
+		}
+
+		log.Infof("Encrypting secrets of service configurations with key %s of %s", kp.CurrentKeyID(), keyFile)
+		opts = append(opts, service_configuration.WithKeyProvider(kp))
+	} else {
+		log.Warn("No key file configured: Secrets of service configurations are stored in plaintext")
+	}
+
 	svc := service_configuration.NewServer(opts...)
+	defer svc.Close()
+
+	// Record the modifying calls in the audit log. This happens before the authorization, so that denied calls are
+	// recorded as well.
+	unary = append(unary, svc.AuditUnaryInterceptor)
+	if jwks != "" {
+		unary = append(unary, authz.DefaultPolicy.UnaryServerInterceptor)
+		stream = append(stream, authz.DefaultPolicy.StreamServerInterceptor)
+	}
+	grpcOpts = append(grpcOpts, grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...))
+
+	srv := grpc.NewServer(grpcOpts...)
 	configuration.RegisterConfigurationServer(srv, svc)
 	orchestrator.RegisterOrchestratorServer(srv, svc)
 
@@ -353,6 +496,43 @@ func autoCreateCollectionModules(svc configuration.ConfigurationServer) {
 	} else {
 		log.Infof("Added communication security collection module (address: %s)", mod.Address)
 	}
+
+	// Add Cyber Security Certification Collection Module
+	mod = &collection.CollectionModule{
+		Id:      config.DefaultCollectionCertificationID,
+		Name:    "Cyber Security Certification",
+		Metrics: []*assessment.Metric{{Id: "CyberSecurityCertification"}},
+		Address: fmt.Sprintf("%s:%d", viper.GetString(CollectionCertificationServiceHostFlag),
+			viper.GetUint(CollectionCertificationServicePortFlag)),
+		ConfigMessageTypeUrl: protobuf.TypeURL(&collection.CyberSecurityCertificationConfig{}),
+	}
+	_, err = svc.AddCollectionModule(context.TODO(), &configuration.AddCollectionModuleRequest{Module: mod})
+	if err != nil {
+		log.Errorf("Could not add cyber security certification collection module: %v", err)
+	} else {
+		log.Infof("Added cyber security certification collection module (address: %s)", mod.Address)
+	}
+
+	// Add Gaia-X Self-Description Collection Module
+	mod = &collection.CollectionModule{
+		Id:   config.DefaultCollectionGaiaXID,
+		Name: "Gaia-X Self-Description",
+		Metrics: []*assessment.Metric{
+			{Id: "GaiaXCredentialValidity"},
+			{Id: "GaiaXCredentialExpiry"},
+			{Id: "GaiaXServiceLocation"},
+			{Id: "GaiaXDataProtection"},
+		},
+		Address: fmt.Sprintf("%s:%d", viper.GetString(CollectionGaiaXServiceHostFlag),
+			viper.GetUint(CollectionGaiaXServicePortFlag)),
+		ConfigMessageTypeUrl: protobuf.TypeURL(&collection.GaiaXSelfDescriptionConfig{}),
+	}
+	_, err = svc.AddCollectionModule(context.TODO(), &configuration.AddCollectionModuleRequest{Module: mod})
+	if err != nil {
+		log.Errorf("Could not add Gaia-X self-description collection module: %v", err)
+	} else {
+		log.Infof("Added Gaia-X self-description collection module (address: %s)", mod.Address)
+	}
 }
 
 func main() {
@@ -49,7 +49,9 @@ export interface BaseConfig {
   "@type": "type.googleapis.com/cam.CommunicationSecurityConfig" |
   "type.googleapis.com/cam.WorkloadSecurityConfig" |
   "type.googleapis.com/cam.RemoteIntegrityConfig" |
-  "type.googleapis.com/cam.AuthenticationSecurityConfig"
+  "type.googleapis.com/cam.AuthenticationSecurityConfig" |
+  "type.googleapis.com/cam.CyberSecurityCertificationConfig" |
+  "type.googleapis.com/cam.GaiaXSelfDescriptionConfig"
 }
 
 
@@ -67,6 +69,27 @@ export interface RemoteIntegrityConfig extends BaseConfig {
   "@type": "type.googleapis.com/cam.RemoteIntegrityConfig"
   certificate: string | ArrayBuffer | null | undefined;
   target: string
+  targets?: string[]
+  discovery?: TargetDiscovery
+}
+
+export interface CyberSecurityCertificationConfig extends BaseConfig {
+  "@type": "type.googleapis.com/cam.CyberSecurityCertificationConfig"
+  provider: string
+  registry?: string
+  certificateDocuments?: string[]
+  scope?: string[]
+  schemes?: string[]
+}
+
+export interface GaiaXSelfDescriptionConfig extends BaseConfig {
+  "@type": "type.googleapis.com/cam.GaiaXSelfDescriptionConfig"
+  selfDescription: string
+  trustedIssuers?: string[]
+}
+
+export interface TargetDiscovery {
+  port?: number
 }
 
 export interface CommunicationSecurityConfig extends BaseConfig {
@@ -100,7 +123,9 @@ export interface ServiceConfiguration {
   rawConfiguration: CommunicationSecurityConfig |
   AuthenticationSecurityConfig |
   RemoteIntegrityConfig |
-  WorkloadSecurityConfig
+  WorkloadSecurityConfig |
+  CyberSecurityCertificationConfig |
+  GaiaXSelfDescriptionConfig
 }
 
 export interface GetMonitoringStatusResponse {
@@ -0,0 +1,130 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+// Package authz contains the role-based authorization of the APIs of CAM. The roles of a caller are taken from the
+// claims of its (already validated) token. A Policy determines which roles may call an RPC and whether the roles only
+// apply to the cloud services contained in the claims of the caller.
+package authz
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/eclipse-xfsc/cam/service"
+)
+
+// Role is a role of a caller of the APIs
+type Role string
+
+const (
+	// RoleAdmin may call all RPCs for all cloud services. Tokens of the CAM components themselves, e.g., the one the
+	// requirements manager uses to call the evaluation manager, need this role.
+	RoleAdmin Role = "admin"
+	// RoleServiceOwner may configure and monitor the cloud services contained in its claims
+	RoleServiceOwner Role = "service-owner"
+	// RoleAuditor may read the configuration, evidences and results of the cloud services contained in its claims
+	RoleAuditor Role = "auditor"
+	// RoleCollector may only register collection modules, retrieve the attestation trust store as well as read and send
+	// evidences. Like the other roles, it may only read the evidences of the cloud services contained in its claims.
+	RoleCollector Role = "collector"
+)
+
+// AllServices can be contained in the services claim to apply the roles of the caller to all cloud services
+const AllServices = "*"
+
+// Rule specifies who may call an RPC. Admins may call every RPC and do not need a rule.
+type Rule struct {
+	// Roles contains the roles, which may call the RPC
+	Roles []Role
+
+	// ServiceScoped specifies that the roles only apply to the cloud service given by the service ID of the request
+	ServiceScoped bool
+}
+
+// Policy maps full gRPC method names, e.g., /cam.Evaluation/SendEvidences, to their rules. RPCs without rule may
+// only be called by admins.
+type Policy map[string]Rule
+
+// serviceRequest is implemented by all requests, which refer to a single cloud service
+type serviceRequest interface {
+	GetServiceId() string
+}
+
+// Authorize checks whether the caller with the claims may call the method with the request. The request may be nil,
+// if it is not (yet) known, e.g., when a stream is opened. In this case, only the roles are checked and Authorize needs
+// to be called again for each received message of service-scoped RPCs.
+func (p Policy) Authorize(claims *service.Claims, method string, req interface{}) error {
+	if claims == nil {
+		return status.Error(codes.Unauthenticated, "missing claims")
+	}
+
+	if claims.HasRole(string(RoleAdmin)) {
+		return nil
+	}
+
+	rule, ok := p[method]
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "%s is restricted to admins", method)
+	}
+
+	for _, role := range rule.Roles {
+		if !claims.HasRole(string(role)) {
+			continue
+		}
+
+		if !rule.ServiceScoped || req == nil {
+			return nil
+		}
+
+		if r, ok := req.(serviceRequest); ok && AppliesTo(claims, r.GetServiceId()) {
+			return nil
+		}
+	}
+
+	if rule.ServiceScoped && req != nil {
+		return status.Errorf(codes.PermissionDenied, "not permitted to call %s for this service", method)
+	}
+
+	return status.Errorf(codes.PermissionDenied, "not permitted to call %s", method)
+}
+
+// AppliesTo returns whether the roles of the caller apply to the cloud service
+func AppliesTo(claims *service.Claims, serviceID string) bool {
+	if claims.HasRole(string(RoleAdmin)) {
+		return true
+	}
+
+	for _, id := range claims.Services {
+		if id == AllServices || (id == serviceID && serviceID != "") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ServiceAllowed returns whether the caller of an RPC may access the cloud service. It is used by the handlers of RPCs,
+// which do not refer to a single service by their requests, e.g., to filter lists. If there are no claims in the
+// context, i.e., the API is not secured, all services are allowed.
+func ServiceAllowed(ctx context.Context, serviceID string) bool {
+	claims := service.ClaimsFromContext(ctx)
+	if claims == nil {
+		return true
+	}
+
+	return AppliesTo(claims, serviceID)
+}
@@ -0,0 +1,391 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package authz
+
+import (
+	"context"
+	"testing"
+
+	"clouditor.io/clouditor/api/orchestrator"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/eclipse-xfsc/cam/api/collection"
+	"github.com/eclipse-xfsc/cam/api/common"
+	"github.com/eclipse-xfsc/cam/api/configuration"
+	"github.com/eclipse-xfsc/cam/api/evaluation"
+	"github.com/eclipse-xfsc/cam/service"
+)
+
+// adminOnly contains the RPCs of the Configuration and Evaluation APIs, which are deliberately not part of the
+// DefaultPolicy
+var adminOnly = []string{
+	"/cam.Configuration/ReportEvidences",
+	"/cam.Configuration/RegisterCloudService",
+	"/cam.Configuration/RemoveCloudService",
+	"/cam.Configuration/AddCollectionModule",
+	"/cam.Configuration/RemoveCollectionModule",
+	"/cam.Configuration/StoreAttestationTrustAnchor",
+	"/cam.Configuration/RemoveAttestationTrustAnchor",
+	"/cam.Configuration/StoreAttestationReferenceManifest",
+	"/cam.Configuration/RemoveAttestationReferenceManifest",
+	"/cam.Configuration/RevokeAttestationCertificate",
+}
+
+func claims(role Role, services ...string) *service.Claims {
+	return &service.Claims{Roles: []string{string(role)}, Services: services}
+}
+
+func TestDefaultPolicy_Complete(t *testing.T) {
+	for _, desc := range []grpc.ServiceDesc{configuration.Configuration_ServiceDesc, evaluation.Evaluation_ServiceDesc} {
+		var methods []string
+		for _, m := range desc.Methods {
+			methods = append(methods, m.MethodName)
+		}
+		for _, s := range desc.Streams {
+			methods = append(methods, s.StreamName)
+		}
+
+		for _, m := range methods {
+			method := "/" + desc.ServiceName + "/" + m
+			_, ok := DefaultPolicy[method]
+			assert.True(t, ok || contains(adminOnly, method), "%s is not classified", method)
+			assert.False(t, ok && contains(adminOnly, method), "%s is part of the policy", method)
+		}
+	}
+}
+
+func TestPolicy_Authorize(t *testing.T) {
+	tests := []struct {
+		name     string
+		claims   *service.Claims
+		method   string
+		req      interface{}
+		wantCode codes.Code
+	}{
+		{
+			name:     "No claims",
+			method:   "/cam.Configuration/ListMetrics",
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "No role",
+			claims:   &service.Claims{Services: []string{AllServices}},
+			method:   "/cam.Configuration/ListMetrics",
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:   "Admin registers service",
+			claims: claims(RoleAdmin),
+			method: "/cam.Configuration/RegisterCloudService",
+			req:    &orchestrator.RegisterCloudServiceRequest{},
+		},
+		{
+			name:   "Admin reads evidences of any service",
+			claims: claims(RoleAdmin),
+			method: "/cam.Evaluation/ListEvidences",
+			req:    &evaluation.ListEvidencesRequest{ServiceId: "other"},
+		},
+		{
+			name:     "Service owner registers service",
+			claims:   claims(RoleServiceOwner, AllServices),
+			method:   "/cam.Configuration/RegisterCloudService",
+			req:      &orchestrator.RegisterCloudServiceRequest{},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "Service owner removes own service",
+			claims:   claims(RoleServiceOwner, "service"),
+			method:   "/cam.Configuration/RemoveCloudService",
+			req:      &orchestrator.RemoveCloudServiceRequest{ServiceId: "service"},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:   "Service owner configures own service",
+			claims: claims(RoleServiceOwner, "service"),
+			method: "/cam.Configuration/ConfigureCloudService",
+			req:    &configuration.ConfigureCloudServiceRequest{ServiceId: "service"},
+		},
+		{
+			name:     "Service owner configures other service",
+			claims:   claims(RoleServiceOwner, "service"),
+			method:   "/cam.Configuration/ConfigureCloudService",
+			req:      &configuration.ConfigureCloudServiceRequest{ServiceId: "other"},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:   "Service owner tests collection of all services",
+			claims: claims(RoleServiceOwner, AllServices),
+			method: "/cam.Configuration/TestCollection",
+			req:    &collection.TestCollectionRequest{ServiceId: "other"},
+		},
+		{
+			name:     "Service owner without services",
+			claims:   claims(RoleServiceOwner),
+			method:   "/cam.Configuration/StartMonitoring",
+			req:      &configuration.StartMonitoringRequest{},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:   "Service owner lists metrics",
+			claims: claims(RoleServiceOwner, "service"),
+			method: "/cam.Configuration/ListMetrics",
+			req:    &orchestrator.ListMetricsRequest{},
+		},
+		{
+			name:     "Service owner sends evidences",
+			claims:   claims(RoleServiceOwner, "service"),
+			method:   "/cam.Evaluation/SendEvidences",
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:   "Auditor reads compliance of own service",
+			claims: claims(RoleAuditor, "service"),
+			method: "/cam.Evaluation/ListCompliance",
+			req:    &evaluation.ListComplianceRequest{ServiceId: "service"},
+		},
+		{
+			name:     "Auditor reads compliance of other service",
+			claims:   claims(RoleAuditor, "service"),
+			method:   "/cam.Evaluation/GetCompliance",
+			req:      &evaluation.GetComplianceRequest{ServiceId: "other"},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:   "Auditor lists configurations",
+			claims: claims(RoleAuditor, "service"),
+			method: "/cam.Configuration/ListCloudServiceConfigurations",
+			req:    &configuration.ListCloudServiceConfigurationsRequest{ServiceId: "service"},
+		},
+		{
+			name:     "Auditor starts monitoring",
+			claims:   claims(RoleAuditor, "service"),
+			method:   "/cam.Configuration/StartMonitoring",
+			req:      &configuration.StartMonitoringRequest{ServiceId: "service"},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "Auditor triggers compliance calculation",
+			claims:   claims(RoleAuditor, "service"),
+			method:   "/cam.Evaluation/CalculateCompliance",
+			req:      &evaluation.CalculateComplianceRequest{ServiceId: "service"},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:   "Collector sends evidences",
+			claims: claims(RoleCollector),
+			method: "/cam.Evaluation/SendEvidences",
+		},
+		{
+			name:   "Collector sends evidence",
+			claims: claims(RoleCollector),
+			method: "/cam.Evaluation/SendEvidences",
+			req:    &common.Evidence{TargetService: "service"},
+		},
+		{
+			name:   "Collector reads evidences",
+			claims: claims(RoleCollector, "service"),
+			method: "/cam.Evaluation/ListEvidences",
+			req:    &evaluation.ListEvidencesRequest{ServiceId: "service"},
+		},
+		{
+			name:     "Collector reads evidences of other service",
+			claims:   claims(RoleCollector, "service"),
+			method:   "/cam.Evaluation/ListEvidences",
+			req:      &evaluation.ListEvidencesRequest{ServiceId: "other"},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "Collector without services reads evidences",
+			claims:   claims(RoleCollector),
+			method:   "/cam.Evaluation/ListEvidences",
+			req:      &evaluation.ListEvidencesRequest{ServiceId: "service"},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:   "Collector registers its module",
+			claims: claims(RoleCollector),
+			method: "/cam.Configuration/RegisterCollectionModule",
+			req:    &configuration.RegisterCollectionModuleRequest{},
+		},
+		{
+			name:   "Collector renews its lease",
+			claims: claims(RoleCollector),
+			method: "/cam.Configuration/RenewCollectionModuleLease",
+			req:    &configuration.RenewCollectionModuleLeaseRequest{},
+		},
+		{
+			name:   "Collector retrieves attestation trust store",
+			claims: claims(RoleCollector),
+			method: "/cam.Configuration/GetAttestationTrustStore",
+			req:    &configuration.GetAttestationTrustStoreRequest{},
+		},
+		{
+			name:     "Collector reads evidence",
+			claims:   claims(RoleCollector, AllServices),
+			method:   "/cam.Evaluation/GetEvidence",
+			req:      &evaluation.GetEvidenceRequest{},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "Collector adds collection module",
+			claims:   claims(RoleCollector),
+			method:   "/cam.Configuration/AddCollectionModule",
+			req:      &configuration.AddCollectionModuleRequest{},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "Collector lists configurations",
+			claims:   claims(RoleCollector, AllServices),
+			method:   "/cam.Configuration/ListCloudServiceConfigurations",
+			req:      &configuration.ListCloudServiceConfigurationsRequest{ServiceId: "service"},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "Service owner renews lease",
+			claims:   claims(RoleServiceOwner, AllServices),
+			method:   "/cam.Configuration/RenewCollectionModuleLease",
+			req:      &configuration.RenewCollectionModuleLeaseRequest{},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "Unknown method",
+			claims:   claims(RoleServiceOwner, AllServices),
+			method:   "/clouditor.orchestrator.v1.Orchestrator/RegisterCloudService",
+			wantCode: codes.PermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := DefaultPolicy.Authorize(tt.claims, tt.method, tt.req)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestServiceAllowed(t *testing.T) {
+	assert.True(t, ServiceAllowed(context.Background(), "service"))
+	assert.True(t, ServiceAllowed(service.ContextWithClaims(context.Background(), claims(RoleAdmin)), "service"))
+	assert.True(t, ServiceAllowed(service.ContextWithClaims(context.Background(), claims(RoleAuditor, "service")),
+		"service"))
+	assert.False(t, ServiceAllowed(service.ContextWithClaims(context.Background(), claims(RoleAuditor, "service")),
+		"other"))
+	assert.False(t, ServiceAllowed(service.ContextWithClaims(context.Background(), claims(RoleAuditor)), ""))
+}
+
+// mockStream is a grpc.ServerStream, which receives the messages of a StreamEvaluations call
+type mockStream struct {
+	grpc.ServerStream
+
+	ctx      context.Context
+	received []*evaluation.StreamEvaluationsRequest
+}
+
+func (s *mockStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockStream) RecvMsg(m interface{}) error {
+	*m.(*evaluation.StreamEvaluationsRequest) = evaluation.StreamEvaluationsRequest{ServiceId: "other"}
+	return nil
+}
+
+func TestPolicy_StreamServerInterceptor(t *testing.T) {
+	info := &grpc.StreamServerInfo{FullMethod: "/cam.Evaluation/StreamEvaluations"}
+	ss := &mockStream{ctx: service.ContextWithClaims(context.Background(), claims(RoleAuditor, "service"))}
+
+	// The stream is opened, but the request for another service is denied
+	err := DefaultPolicy.StreamServerInterceptor(nil, ss, info, func(_ interface{}, stream grpc.ServerStream) error {
+		return stream.RecvMsg(new(evaluation.StreamEvaluationsRequest))
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Collectors cannot even open the stream
+	ss.ctx = service.ContextWithClaims(context.Background(), claims(RoleCollector))
+	err = DefaultPolicy.StreamServerInterceptor(nil, ss, info, func(_ interface{}, _ grpc.ServerStream) error {
+		assert.Fail(t, "handler must not be called")
+		return nil
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestPolicy_UnaryServerInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/cam.Configuration/StopMonitoring"}
+	handler := func(_ context.Context, _ interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	ctx := service.ContextWithClaims(context.Background(), claims(RoleServiceOwner, "service"))
+
+	res, err := DefaultPolicy.UnaryServerInterceptor(ctx,
+		&configuration.StopMonitoringRequest{ServiceId: "service"}, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", res)
+
+	res, err = DefaultPolicy.UnaryServerInterceptor(ctx,
+		&configuration.StopMonitoringRequest{ServiceId: "other"}, info, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Nil(t, res)
+}
+
+func TestClaims_Roles(t *testing.T) {
+	tests := []struct {
+		name         string
+		claims       jwt.MapClaims
+		wantRoles    []string
+		wantServices []string
+		wantTenant   string
+	}{
+		{
+			name: "Roles claim",
+			claims: jwt.MapClaims{"roles": []string{"auditor"}, "services": []string{"service"},
+				"tenant": "tenant"},
+			wantRoles:    []string{"auditor"},
+			wantServices: []string{"service"},
+			wantTenant:   "tenant",
+		},
+		{
+			name:      "Keycloak realm roles",
+			claims:    jwt.MapClaims{"realm_access": map[string]interface{}{"roles": []string{"admin"}}},
+			wantRoles: []string{"admin"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, tt.claims).SignedString([]byte("key"))
+			assert.NoError(t, err)
+
+			c := new(service.Claims)
+			_, _, err = jwt.NewParser().ParseUnverified(token, c)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantRoles, c.Roles)
+			assert.Equal(t, tt.wantServices, c.Services)
+			assert.Equal(t, tt.wantTenant, c.Tenant)
+		})
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,85 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package authz
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+
+	"github.com/eclipse-xfsc/cam/service"
+)
+
+var log = logrus.WithField("component", "authz")
+
+// UnaryServerInterceptor enforces the policy for unary RPCs. It needs to be installed after the
+// service.ClaimsUnaryInterceptor.
+func (p Policy) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	claims := service.ClaimsFromContext(ctx)
+	if err := p.Authorize(claims, info.FullMethod, req); err != nil {
+		logDenied(claims, info.FullMethod, err)
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// StreamServerInterceptor enforces the policy for streams. The roles are checked when the stream is opened. For
+// service-scoped RPCs, each received message is checked as well.
+func (p Policy) StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	claims := service.ClaimsFromContext(ss.Context())
+	if err := p.Authorize(claims, info.FullMethod, nil); err != nil {
+		logDenied(claims, info.FullMethod, err)
+		return err
+	}
+
+	return handler(srv, &authorizedStream{ServerStream: ss, policy: p, claims: claims, method: info.FullMethod})
+}
+
+// authorizedStream authorizes each message received from the stream
+type authorizedStream struct {
+	grpc.ServerStream
+
+	policy Policy
+	claims *service.Claims
+	method string
+}
+
+// RecvMsg implements grpc.ServerStream
+func (s *authorizedStream) RecvMsg(m interface{}) (err error) {
+	if err = s.ServerStream.RecvMsg(m); err != nil {
+		return
+	}
+
+	if err = s.policy.Authorize(s.claims, s.method, m); err != nil {
+		logDenied(s.claims, s.method, err)
+	}
+
+	return
+}
+
+// logDenied logs that a call was denied
+func logDenied(claims *service.Claims, method string, err error) {
+	var subject string
+	if claims != nil {
+		subject = claims.Subject
+	}
+
+	log.WithFields(logrus.Fields{"subject": subject, "method": method}).Warnf("Denied call: %v", err)
+}
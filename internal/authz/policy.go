@@ -0,0 +1,73 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package authz
+
+var (
+	// owner may change a service, auditors may not
+	owner = Rule{Roles: []Role{RoleServiceOwner}, ServiceScoped: true}
+	// reader may read a service
+	reader = Rule{Roles: []Role{RoleServiceOwner, RoleAuditor}, ServiceScoped: true}
+	// catalog may read data, which does not belong to a service, e.g., metrics. Lists of services are filtered by the
+	// handlers.
+	catalog = Rule{Roles: []Role{RoleServiceOwner, RoleAuditor}}
+	// collector may be called by collection modules, which are not bound to services
+	collector = Rule{Roles: []Role{RoleCollector}}
+)
+
+// DefaultPolicy is the policy of the Configuration and Evaluation APIs. Registering and removing cloud services as
+// well as managing collection modules and attestation trust anchors is restricted to admins. So is reporting evidence
+// counts, which is done by the evaluation manager. Collection modules may register themselves, retrieve the attestation
+// trust store, read evidences to discover their targets and send evidences.
+var DefaultPolicy = Policy{
+	// Configuration
+	"/cam.Configuration/StartMonitoring":                    owner,
+	"/cam.Configuration/UpdateMonitoring":                   owner,
+	"/cam.Configuration/StopMonitoring":                     owner,
+	"/cam.Configuration/GetMonitoringStatus":                reader,
+	"/cam.Configuration/ListMetrics":                        catalog,
+	"/cam.Configuration/GetMetric":                          catalog,
+	"/cam.Configuration/GetMetricConfiguration":             reader,
+	"/cam.Configuration/UpdateMetricConfiguration":          owner,
+	"/cam.Configuration/UpdateCloudService":                 owner,
+	"/cam.Configuration/ConfigureCloudService":              owner,
+	"/cam.Configuration/TestCollection":                     owner,
+	"/cam.Configuration/ListCloudServiceConfigurations":     reader,
+	"/cam.Configuration/GetCloudService":                    reader,
+	"/cam.Configuration/ListCloudServices":                  catalog,
+	"/cam.Configuration/ListControls":                       catalog,
+	"/cam.Configuration/ListCollectionModules":              catalog,
+	"/cam.Configuration/RegisterCollectionModule":           collector,
+	"/cam.Configuration/RenewCollectionModuleLease":         collector,
+	"/cam.Configuration/ListAttestationTrustAnchors":        catalog,
+	"/cam.Configuration/ListAttestationReferenceManifests":  catalog,
+	"/cam.Configuration/ListRevokedAttestationCertificates": catalog,
+	"/cam.Configuration/GetAttestationTrustStore":           {Roles: []Role{RoleServiceOwner, RoleAuditor, RoleCollector}},
+	"/cam.Configuration/ListAuditEvents":                    reader,
+
+	// Evaluation. The service of an evidence requested by GetEvidence is checked by the handler.
+	"/cam.Evaluation/SendEvidences":              collector,
+	"/cam.Evaluation/GetEvidence":                catalog,
+	"/cam.Evaluation/ListEvidences":              {Roles: []Role{RoleServiceOwner, RoleAuditor, RoleCollector}, ServiceScoped: true},
+	"/cam.Evaluation/VerifyEvidenceChain":        reader,
+	"/cam.Evaluation/GetEvaluation":              reader,
+	"/cam.Evaluation/StreamEvaluations":          reader,
+	"/cam.Evaluation/CalculateCompliance":        owner,
+	"/cam.Evaluation/GetCompliance":              reader,
+	"/cam.Evaluation/ListCompliance":             reader,
+	"/cam.Evaluation/IssueComplianceCredential":  owner,
+	"/cam.Evaluation/VerifyComplianceCredential": catalog,
+	"/cam.Evaluation/ListCredentialIssuerKeys":   catalog,
+}
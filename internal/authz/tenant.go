@@ -0,0 +1,74 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package authz
+
+import (
+	"context"
+
+	"github.com/eclipse-xfsc/cam/service"
+)
+
+// TenantFromContext returns the tenant of the caller of an RPC. If restricted is false, the caller may access the data
+// of all tenants. This is the case, if the API is not secured or the caller is an admin without tenant, e.g., another
+// CAM component. It is also the case for collection modules without tenant, which collect the evidences of the services
+// of all tenants and are restricted to the services in their claims instead. Otherwise, the caller may only access the
+// data of its tenant. Callers without tenant may only access data without tenant.
+func TenantFromContext(ctx context.Context) (tenant string, restricted bool) {
+	claims := service.ClaimsFromContext(ctx)
+	if claims == nil {
+		return "", false
+	}
+
+	if claims.Tenant == "" && (claims.HasRole(string(RoleAdmin)) || isCollectionModule(claims)) {
+		return "", false
+	}
+
+	return claims.Tenant, true
+}
+
+// isCollectionModule returns whether the caller only has the collector role of the CAM roles. The collector role only
+// permits the RPCs of collection modules, whereas callers, which are service owners or auditors as well, must not read
+// the data of other tenants.
+func isCollectionModule(claims *service.Claims) bool {
+	return claims.HasRole(string(RoleCollector)) && !claims.HasRole(string(RoleServiceOwner)) &&
+		!claims.HasRole(string(RoleAuditor))
+}
+
+// TenantAllowed returns whether the caller of an RPC may access data of the tenant
+func TenantAllowed(ctx context.Context, tenant string) bool {
+	own, restricted := TenantFromContext(ctx)
+
+	return !restricted || own == tenant
+}
+
+// TenantConds appends the condition on the tenant of the caller to the storage conditions conds, which consist of a
+// query and its arguments. If the caller is not restricted to a tenant, conds are returned as they are.
+func TenantConds(ctx context.Context, conds ...interface{}) []interface{} {
+	tenant, restricted := TenantFromContext(ctx)
+	if !restricted {
+		return conds
+	}
+
+	if len(conds) == 0 {
+		return []interface{}{"tenant_id = ?", tenant}
+	}
+
+	args := make([]interface{}, 0, len(conds)+1)
+	args = append(args, "("+conds[0].(string)+") AND tenant_id = ?")
+	args = append(args, conds[1:]...)
+
+	return append(args, tenant)
+}
@@ -0,0 +1,100 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package authz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eclipse-xfsc/cam/service"
+)
+
+func TestTenantConds(t *testing.T) {
+	tests := []struct {
+		name           string
+		claims         *service.Claims
+		conds          []interface{}
+		want           []interface{}
+		wantRestricted bool
+	}{
+		{
+			name:  "No claims",
+			conds: []interface{}{"service_id = ?", "service"},
+			want:  []interface{}{"service_id = ?", "service"},
+		},
+		{
+			name:   "Admin without tenant",
+			claims: &service.Claims{Roles: []string{string(RoleAdmin)}},
+			conds:  []interface{}{"service_id = ?", "service"},
+			want:   []interface{}{"service_id = ?", "service"},
+		},
+		{
+			name:           "Admin of tenant",
+			claims:         &service.Claims{Roles: []string{string(RoleAdmin)}, Tenant: "tenant"},
+			conds:          []interface{}{"service_id = ? OR service_id = ?", "a", "b"},
+			want:           []interface{}{"(service_id = ? OR service_id = ?) AND tenant_id = ?", "a", "b", "tenant"},
+			wantRestricted: true,
+		},
+		{
+			name:   "Collector without tenant",
+			claims: &service.Claims{Roles: []string{string(RoleCollector)}},
+			conds:  []interface{}{"target_service = ?", "service"},
+			want:   []interface{}{"target_service = ?", "service"},
+		},
+		{
+			name:           "Collector of tenant",
+			claims:         &service.Claims{Roles: []string{string(RoleCollector)}, Tenant: "tenant"},
+			conds:          []interface{}{"target_service = ?", "service"},
+			want:           []interface{}{"(target_service = ?) AND tenant_id = ?", "service", "tenant"},
+			wantRestricted: true,
+		},
+		{
+			name:           "Collector and auditor without tenant",
+			claims:         &service.Claims{Roles: []string{string(RoleCollector), string(RoleAuditor)}},
+			want:           []interface{}{"tenant_id = ?", ""},
+			wantRestricted: true,
+		},
+		{
+			name:           "Auditor without tenant",
+			claims:         &service.Claims{Roles: []string{string(RoleAuditor)}},
+			want:           []interface{}{"tenant_id = ?", ""},
+			wantRestricted: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.claims != nil {
+				ctx = service.ContextWithClaims(ctx, tt.claims)
+			}
+
+			_, restricted := TenantFromContext(ctx)
+			assert.Equal(t, tt.wantRestricted, restricted)
+			assert.Equal(t, tt.want, TenantConds(ctx, tt.conds...))
+		})
+	}
+}
+
+func TestTenantAllowed(t *testing.T) {
+	ctx := service.ContextWithClaims(context.Background(), &service.Claims{Tenant: "tenant"})
+
+	assert.True(t, TenantAllowed(ctx, "tenant"))
+	assert.False(t, TenantAllowed(ctx, "other"))
+	assert.False(t, TenantAllowed(ctx, ""))
+	assert.True(t, TenantAllowed(context.Background(), "other"))
+}
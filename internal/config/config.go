@@ -40,6 +40,18 @@ const DefaultCollectionIntegrityID = "6e58e7d6-774f-4b19-a8e0-a42432023f5c"
 // auto-create feature.
 const DefaultCollectionAuthSecID = "56dd78b5-33de-462e-9b26-8f6e801079e7"
 
+// DefaultCollectionCertificationID contains the default UUID used for the cyber security certification collection
+// module when using the auto-create feature.
+const DefaultCollectionCertificationID = "b9f3c1a2-5d7e-4c08-9e61-3f2a8d4b7c15"
+
+// DefaultCollectionGaiaXID contains the default UUID used for the Gaia-X self-description collection module when using
+// the auto-create feature.
+const DefaultCollectionGaiaXID = "0c4d6a8e-2f1b-4e73-8a95-d6b2e1f7c340"
+
+// Version contains the version of the CAM components, which is reported by the collection modules. It can be set at
+// build time using -ldflags "-X github.com/eclipse-xfsc/cam/internal/config.Version=<version>".
+var Version = "dev"
+
 // InitConfig initializes the viper config with sensible defaults for all of the
 // CAM modules. It enables loading configuration settings from a config file
 // named cam.yaml as well as the environment variables prefixed with EnvPrefix.
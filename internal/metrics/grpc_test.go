@@ -0,0 +1,111 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package metrics
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// value returns the value of the counter or the sample count of the histogram with the name and labels in the default
+// registry
+func value(t *testing.T, name string, labels map[string]string) float64 {
+	families, err := prometheus.DefaultGatherer.Gather()
+	assert.NoError(t, err)
+
+	for _, f := range families {
+		if f.GetName() != name {
+			continue
+		}
+
+	metrics:
+		for _, m := range f.Metric {
+			for _, l := range m.Label {
+				if v, ok := labels[l.GetName()]; ok && v != l.GetValue() {
+					continue metrics
+				}
+			}
+
+			if m.Histogram != nil {
+				return float64(m.Histogram.GetSampleCount())
+			}
+
+			return m.Counter.GetValue()
+		}
+	}
+
+	return 0
+}
+
+func TestServerOptions(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+
+	// Calls of the service "rejected" are not authenticated
+	opts := append(ServerOptions(), grpc.ChainUnaryInterceptor(
+		func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
+			interface{}, error) {
+			if req.(*grpc_health_v1.HealthCheckRequest).Service == "rejected" {
+				return nil, status.Error(codes.Unauthenticated, "missing token")
+			}
+
+			return handler(ctx, req)
+		}))
+
+	srv := grpc.NewServer(opts...)
+	grpc_health_v1.RegisterHealthServer(srv, health.NewServer())
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	defer srv.Stop()
+
+	conn, err := grpc.Dial("bufnet", append(DialOptions(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))...)
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	client := grpc_health_v1.NewHealthClient(conn)
+
+	_, err = client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+	assert.NoError(t, err)
+	_, err = client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: "rejected"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	check := func(code codes.Code) map[string]string {
+		return map[string]string{"grpc_service": "grpc.health.v1.Health", "grpc_method": "Check", "grpc_code": code.String()}
+	}
+
+	assert.Equal(t, float64(1), value(t, "grpc_server_handled_total", check(codes.OK)))
+	assert.Equal(t, float64(1), value(t, "grpc_server_handled_total", check(codes.NotFound)))
+	assert.Equal(t, float64(1), value(t, "grpc_server_handled_total", check(codes.Unauthenticated)))
+	assert.Equal(t, float64(1), value(t, "grpc_client_handled_total", check(codes.OK)))
+	assert.Equal(t, float64(1), value(t, "grpc_client_handled_total", check(codes.NotFound)))
+	assert.Equal(t, float64(3), value(t, "grpc_server_handling_seconds",
+		map[string]string{"grpc_service": "grpc.health.v1.Health", "grpc_method": "Check"}))
+}
@@ -0,0 +1,68 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+// Package metrics exposes the Prometheus metrics of the services. The services define their metrics with the
+// Prometheus client library and register them in its default registry, which also contains the metrics of the Go
+// runtime and of the RPCs of the gRPC servers and clients.
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sirupsen/logrus"
+)
+
+// Path is the path, at which the metrics are exposed
+const Path = "/metrics"
+
+var (
+	// DefaultBuckets are the upper bounds (in seconds) of the buckets of histograms of short operations, e.g., RPCs
+	DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+	// SlowBuckets are the upper bounds (in seconds) of the buckets of histograms of long-running operations, e.g., the
+	// discovery of a collection module
+	SlowBuckets = []float64{.1, .5, 1, 5, 10, 30, 60, 120, 300, 600}
+)
+
+var log = logrus.WithField("component", "metrics")
+
+// Serve exposes the metrics of the default registry at Path on the port in the background. A port of zero disables
+// the endpoint and nil is returned.
+func Serve(port uint16) *http.Server {
+	if port == 0 {
+		return nil
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(Path, promhttp.Handler())
+
+	srv := &http.Server{
+		Addr:              fmt.Sprintf(":%d", port),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	log.Infof("Exposing metrics at %s on port %d", Path, port)
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Errorf("Could not serve metrics: %v", err)
+		}
+	}()
+
+	return srv
+}
@@ -0,0 +1,317 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+// Package notification delivers notifications about changes of the compliance of cloud services to external channels,
+// e.g., webhooks or email. Deliveries are retried and, if they finally fail, stored as dead letters.
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"clouditor.io/clouditor/persistence"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sirupsen/logrus"
+)
+
+// EventComplianceChanged is the type of events, which notify about a changed compliance status of a control
+const EventComplianceChanged = "compliance.changed"
+
+const (
+	// DefaultMaxAttempts is the default number of attempts to deliver a notification to a channel
+	DefaultMaxAttempts = 5
+
+	// DefaultBackoff is the default delay before the first retry. It is doubled for each further retry.
+	DefaultBackoff = time.Second
+
+	// queueSize is the number of notifications, which are queued per channel
+	queueSize = 100
+)
+
+var (
+	log = logrus.WithField("component", "notification")
+
+	notificationsDelivered = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cam_notifications_delivered_total",
+		Help: "Number of notifications delivered",
+	}, []string{"channel"})
+	notificationsFailed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cam_notifications_failed_total",
+		Help: "Number of notifications, which could not be delivered and were stored as dead letters",
+	}, []string{"channel"})
+)
+
+// Event notifies about the change of the compliance status of a control of a cloud service
+type Event struct {
+	// ID identifies the event, so that receivers can detect duplicate deliveries
+	ID   string    `json:"id"`
+	Type string    `json:"type"`
+	Time time.Time `json:"time"`
+
+	ServiceID string `json:"serviceId"`
+	ControlID string `json:"controlId"`
+	TenantID  string `json:"tenantId,omitempty"`
+
+	// Compliant is the new compliance status, PreviouslyCompliant the status before
+	Compliant           bool `json:"compliant"`
+	PreviouslyCompliant bool `json:"previouslyCompliant"`
+
+	// ComplianceID and PreviousComplianceID refer to the compliance results of the Evaluation Manager
+	ComplianceID         string `json:"complianceId"`
+	PreviousComplianceID string `json:"previousComplianceId"`
+}
+
+// NewComplianceChangedEvent returns the event for a control, whose compliance status changed
+func NewComplianceChangedEvent(serviceID, controlID, tenantID string, compliant bool, complianceID string,
+	previousComplianceID string) *Event {
+	return &Event{
+		ID:                   uuid.NewString(),
+		Type:                 EventComplianceChanged,
+		Time:                 time.Now().UTC(),
+		ServiceID:            serviceID,
+		ControlID:            controlID,
+		TenantID:             tenantID,
+		Compliant:            compliant,
+		PreviouslyCompliant:  !compliant,
+		ComplianceID:         complianceID,
+		PreviousComplianceID: previousComplianceID,
+	}
+}
+
+// Subject returns a short human-readable description of the event
+func (e *Event) Subject() string {
+	status := "compliant"
+	if !e.Compliant {
+		status = "not compliant"
+	}
+
+	return fmt.Sprintf("Control %s of service %s is %s", e.ControlID, e.ServiceID, status)
+}
+
+// Channel delivers notifications to their receivers
+type Channel interface {
+	// Name identifies the channel in logs and dead letters
+	Name() string
+
+	// Deliver delivers the event. Failed deliveries are retried, unless the error is permanent (see Permanent).
+	Deliver(ctx context.Context, e *Event) error
+}
+
+// permanentError is an error of a delivery, which fails again, if it is retried
+type permanentError struct {
+	error
+}
+
+func (err permanentError) Unwrap() error {
+	return err.error
+}
+
+// Permanent marks the error of a delivery as permanent, so that it is not retried
+func Permanent(err error) error {
+	return permanentError{err}
+}
+
+// DeadLetter is a notification, which could not be delivered to a channel
+type DeadLetter struct {
+	ID       string `gorm:"primaryKey"`
+	Channel  string `gorm:"index"`
+	EventID  string `gorm:"index"`
+	Payload  string
+	Error    string
+	Attempts int
+	FailedAt time.Time
+}
+
+// Dispatcher delivers events to its channels. Each channel has its own queue, so that a slow or unavailable channel
+// does not delay the others, and events are delivered to a channel in the order, in which they occurred.
+type Dispatcher struct {
+	channels []Channel
+	queues   []chan *Event
+
+	// storage stores the dead letters
+	storage persistence.Storage
+
+	maxAttempts int
+	backoff     time.Duration
+
+	// mutex protects closed, so that events are not queued once the queues are closed
+	mutex  sync.RWMutex
+	closed bool
+
+	// stopped is cancelled on Close to stop waiting for retries
+	stopped context.Context
+	stop    context.CancelFunc
+
+	wg sync.WaitGroup
+}
+
+// NewDispatcher creates a dispatcher, which delivers events to the channels and starts delivering. A delivery is
+// attempted up to maxAttempts times with exponential backoff, starting with backoff. Events, which could not be
+// delivered, are stored as DeadLetter in the storage.
+func NewDispatcher(storage persistence.Storage, maxAttempts int, backoff time.Duration,
+	channels ...Channel) (d *Dispatcher) {
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	d = &Dispatcher{
+		channels:    channels,
+		storage:     storage,
+		maxAttempts: maxAttempts,
+		backoff:     backoff,
+	}
+	d.stopped, d.stop = context.WithCancel(context.Background())
+
+	for _, c := range channels {
+		q := make(chan *Event, queueSize)
+		d.queues = append(d.queues, q)
+
+		d.wg.Add(1)
+		go d.run(c, q)
+	}
+
+	return
+}
+
+// Notify queues the event for delivery to all channels. It does not block. If the queue of a channel is full or the
+// dispatcher is closed, the event is stored as dead letter of the channel.
+func (d *Dispatcher) Notify(e *Event) {
+	if d == nil {
+		return
+	}
+
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	for i, q := range d.queues {
+		if d.closed {
+			d.deadLetter(d.channels[i], e, 0, errors.New("dispatcher is closed"))
+			continue
+		}
+
+		select {
+		case q <- e:
+		default:
+			d.deadLetter(d.channels[i], e, 0, errors.New("queue is full"))
+		}
+	}
+}
+
+// Close stops the dispatcher after the queued events have been delivered. Failed deliveries are not retried anymore,
+// but stored as dead letters, so that Close does not wait for the backoff of the retries.
+func (d *Dispatcher) Close() {
+	if d == nil {
+		return
+	}
+
+	d.mutex.Lock()
+	if !d.closed {
+		d.closed = true
+		d.stop()
+		for _, q := range d.queues {
+			close(q)
+		}
+	}
+	d.mutex.Unlock()
+
+	d.wg.Wait()
+}
+
+// run delivers the events of the queue to the channel
+func (d *Dispatcher) run(c Channel, queue chan *Event) {
+	defer d.wg.Done()
+
+	for e := range queue {
+		d.deliver(c, e)
+	}
+}
+
+// deliver delivers the event to the channel, retrying failed deliveries
+func (d *Dispatcher) deliver(c Channel, e *Event) {
+	var (
+		err     error
+		backoff = d.backoff
+	)
+
+	for attempt := 1; attempt <= d.maxAttempts; attempt++ {
+		if err = c.Deliver(context.Background(), e); err == nil {
+			log.Debugf("Delivered notification %s to %s", e.ID, c.Name())
+			notificationsDelivered.WithLabelValues(c.Name()).Inc()
+			return
+		}
+
+		var permanent permanentError
+		if errors.As(err, &permanent) {
+			d.deadLetter(c, e, attempt, err)
+			return
+		}
+
+		if attempt < d.maxAttempts {
+			log.Debugf("Could not deliver notification %s to %s (attempt %d): %v. Retrying in %v", e.ID, c.Name(),
+				attempt, err, backoff)
+			if !d.wait(backoff) {
+				d.deadLetter(c, e, attempt, err)
+				return
+			}
+			backoff *= 2
+		}
+	}
+
+	d.deadLetter(c, e, d.maxAttempts, err)
+}
+
+// wait waits for the backoff before a retry. It returns false, if the dispatcher has been closed in the meantime.
+func (d *Dispatcher) wait(backoff time.Duration) bool {
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-d.stopped.Done():
+		return false
+	}
+}
+
+// deadLetter stores the event, which could not be delivered to the channel
+func (d *Dispatcher) deadLetter(c Channel, e *Event, attempts int, err error) {
+	log.Errorf("Could not deliver notification %s to %s after %d attempt(s): %v", e.ID, c.Name(), attempts, err)
+	notificationsFailed.WithLabelValues(c.Name()).Inc()
+
+	if d.storage == nil {
+		return
+	}
+
+	payload, _ := json.Marshal(e)
+
+	err = d.storage.Create(&DeadLetter{
+		ID:       uuid.NewString(),
+		Channel:  c.Name(),
+		EventID:  e.ID,
+		Payload:  string(payload),
+		Error:    err.Error(),
+		Attempts: attempts,
+		FailedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		log.Errorf("Could not store dead letter of notification %s: %v", e.ID, err)
+	}
+}
@@ -0,0 +1,184 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/persistence"
+	"clouditor.io/clouditor/persistence/gorm"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeChannel fails the first failures deliveries with err and records the delivered events
+type fakeChannel struct {
+	failures int
+	err      error
+
+	mutex     sync.Mutex
+	attempts  int
+	delivered []*Event
+}
+
+func (c *fakeChannel) Name() string {
+	return "fake"
+}
+
+func (c *fakeChannel) Deliver(_ context.Context, e *Event) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.attempts++
+	if c.attempts <= c.failures {
+		return c.err
+	}
+
+	c.delivered = append(c.delivered, e)
+
+	return nil
+}
+
+// Attempts returns the number of delivery attempts
+func (c *fakeChannel) Attempts() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.attempts
+}
+
+func newStorage(t *testing.T) persistence.Storage {
+	s, err := gorm.NewStorage(gorm.WithInMemory(), gorm.WithAdditionalAutoMigration(DeadLetter{}))
+	assert.NoError(t, err)
+
+	return s
+}
+
+func TestDispatcher(t *testing.T) {
+	var (
+		unavailable = errors.New("unavailable")
+		e           = NewComplianceChangedEvent("service", "Control1", "alice", false, "2", "1")
+	)
+
+	tests := []struct {
+		name          string
+		channel       *fakeChannel
+		wantAttempts  int
+		wantDelivered bool
+	}{
+		{
+			name:          "delivered",
+			channel:       &fakeChannel{},
+			wantAttempts:  1,
+			wantDelivered: true,
+		},
+		{
+			name:          "delivered after retries",
+			channel:       &fakeChannel{failures: 2, err: unavailable},
+			wantAttempts:  3,
+			wantDelivered: true,
+		},
+		{
+			name:         "retries exhausted",
+			channel:      &fakeChannel{failures: 10, err: unavailable},
+			wantAttempts: 3,
+		},
+		{
+			name:         "permanent error",
+			channel:      &fakeChannel{failures: 10, err: Permanent(unavailable)},
+			wantAttempts: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newStorage(t)
+
+			d := NewDispatcher(s, 3, time.Millisecond, tt.channel)
+			d.Notify(e)
+
+			// Close does not wait for retries
+			assert.Eventually(t, func() bool { return tt.channel.Attempts() == tt.wantAttempts }, time.Second,
+				time.Millisecond)
+			d.Close()
+
+			assert.Equal(t, tt.wantAttempts, tt.channel.attempts)
+
+			var letters []*DeadLetter
+			assert.NoError(t, s.List(&letters, "", true, 0, -1))
+
+			if tt.wantDelivered {
+				assert.Equal(t, []*Event{e}, tt.channel.delivered)
+				assert.Empty(t, letters)
+				return
+			}
+
+			assert.Len(t, letters, 1)
+			assert.Equal(t, "fake", letters[0].Channel)
+			assert.Equal(t, e.ID, letters[0].EventID)
+			assert.Equal(t, tt.wantAttempts, letters[0].Attempts)
+			assert.Contains(t, letters[0].Error, "unavailable")
+
+			// The dead letter contains the event, so that it can be delivered later on
+			var stored Event
+			assert.NoError(t, json.Unmarshal([]byte(letters[0].Payload), &stored))
+			assert.Equal(t, e.ID, stored.ID)
+			assert.Equal(t, "Control1", stored.ControlID)
+		})
+	}
+
+	// A dispatcher without channels, e.g., if notifications are not configured, does nothing
+	var d *Dispatcher
+	d.Notify(e)
+	d.Close()
+}
+
+func TestDispatcher_Close(t *testing.T) {
+	var (
+		s       = newStorage(t)
+		channel = &fakeChannel{failures: 10, err: errors.New("unavailable")}
+		e       = NewComplianceChangedEvent("service", "Control1", "alice", false, "2", "1")
+	)
+
+	// Closing the dispatcher cancels the backoff of the retries
+	d := NewDispatcher(s, 3, time.Hour, channel)
+	d.Notify(e)
+	assert.Eventually(t, func() bool { return channel.Attempts() == 1 }, time.Second, time.Millisecond)
+
+	start := time.Now()
+	d.Close()
+	assert.Less(t, time.Since(start), time.Minute)
+	d.Close()
+
+	// Events notified after Close, e.g., by a pending RPC, are stored as dead letters
+	d.Notify(e)
+	assert.Equal(t, 1, channel.Attempts())
+
+	var letters []*DeadLetter
+	assert.NoError(t, s.List(&letters, "", true, 0, -1))
+	assert.Len(t, letters, 2)
+	assert.ElementsMatch(t, []int{1, 0}, []int{letters[0].Attempts, letters[1].Attempts})
+}
+
+func TestEvent_Subject(t *testing.T) {
+	assert.Equal(t, "Control Control1 of service s1 is not compliant",
+		NewComplianceChangedEvent("s1", "Control1", "", false, "2", "1").Subject())
+	assert.Equal(t, "Control Control1 of service s1 is compliant",
+		NewComplianceChangedEvent("s1", "Control1", "", true, "2", "1").Subject())
+}
@@ -0,0 +1,102 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package notification
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// SMTP delivers events as plain text emails via an SMTP server. STARTTLS is used, if the server supports it.
+type SMTP struct {
+	address string
+	from    string
+	to      []string
+	auth    smtp.Auth
+}
+
+// NewSMTP creates an SMTP channel, which sends emails from the sender to the recipients via the SMTP server at the
+// address (host:port). If a username is given, PLAIN authentication is used, which Go only allows via TLS or to
+// localhost.
+func NewSMTP(address string, from string, to []string, username string, password string) *SMTP {
+	s := &SMTP{
+		address: address,
+		from:    from,
+		to:      to,
+	}
+
+	if username != "" {
+		host, _, _ := net.SplitHostPort(address)
+		s.auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return s
+}
+
+// Name returns the name of the channel
+func (s *SMTP) Name() string {
+	return "smtp " + s.address
+}
+
+// Deliver sends the event to the recipients. Errors of the SMTP server are retried.
+func (s *SMTP) Deliver(_ context.Context, e *Event) error {
+	if len(s.to) == 0 {
+		return Permanent(fmt.Errorf("no recipients"))
+	}
+
+	return smtp.SendMail(s.address, s.auth, s.from, s.to, s.message(e))
+}
+
+// message returns the email of the event including its headers
+func (s *SMTP) message(e *Event) []byte {
+	var (
+		buf    bytes.Buffer
+		status = "compliant"
+		before = "compliant"
+	)
+
+	if !e.Compliant {
+		status = "not compliant"
+	}
+	if !e.PreviouslyCompliant {
+		before = "not compliant"
+	}
+
+	fmt.Fprintf(&buf, "From: %s\r\n", s.from)
+	fmt.Fprintf(&buf, "To: %s\r\n", strings.Join(s.to, ", "))
+	fmt.Fprintf(&buf, "Subject: [CAM] %s\r\n", e.Subject())
+	fmt.Fprintf(&buf, "Date: %s\r\n", e.Time.Format(time.RFC1123Z))
+	fmt.Fprintf(&buf, "Message-ID: <%s@cam>\r\n", e.ID)
+	fmt.Fprintf(&buf, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&buf, "Content-Type: text/plain; charset=utf-8\r\n")
+	fmt.Fprintf(&buf, "\r\n")
+	fmt.Fprintf(&buf, "The compliance status of control %s of cloud service %s changed from %s to %s.\r\n",
+		e.ControlID, e.ServiceID, before, status)
+	fmt.Fprintf(&buf, "\r\n")
+	fmt.Fprintf(&buf, "Time: %s\r\n", e.Time.Format(time.RFC3339))
+	if e.TenantID != "" {
+		fmt.Fprintf(&buf, "Tenant: %s\r\n", e.TenantID)
+	}
+	fmt.Fprintf(&buf, "Compliance result: %s\r\n", e.ComplianceID)
+	fmt.Fprintf(&buf, "Previous compliance result: %s\r\n", e.PreviousComplianceID)
+
+	return buf.Bytes()
+}
@@ -0,0 +1,194 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package notification
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// mail is an email received by the smtpStandIn
+type mail struct {
+	auth string
+	from string
+	to   []string
+	data string
+}
+
+// smtpStandIn is a minimal SMTP server, which rejects the first failures mails temporarily and records the others
+type smtpStandIn struct {
+	listener net.Listener
+	failures int
+
+	mutex sync.Mutex
+	mails []*mail
+}
+
+func newSMTPStandIn(t *testing.T, failures int) *smtpStandIn {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+
+	s := &smtpStandIn{listener: l, failures: failures}
+	t.Cleanup(func() { _ = l.Close() })
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+
+			go s.serve(conn)
+		}
+	}()
+
+	return s
+}
+
+func (s *smtpStandIn) serve(conn net.Conn) {
+	defer conn.Close()
+
+	var (
+		r = bufio.NewReader(conn)
+		m = new(mail)
+	)
+
+	reply := func(format string, args ...interface{}) {
+		_, _ = fmt.Fprintf(conn, format+"\r\n", args...)
+	}
+
+	reply("220 localhost ESMTP stand-in")
+
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+
+		switch cmd {
+		case "EHLO":
+			reply("250-localhost")
+			reply("250 AUTH PLAIN")
+		case "HELO", "NOOP", "RSET":
+			reply("250 OK")
+		case "AUTH":
+			b, _ := base64.StdEncoding.DecodeString(strings.TrimPrefix(line, "AUTH PLAIN "))
+			m.auth = strings.ReplaceAll(string(b), "\x00", " ")
+			reply("235 Authenticated")
+		case "MAIL":
+			s.mutex.Lock()
+			fail := s.failures > 0
+			if fail {
+				s.failures--
+			}
+			s.mutex.Unlock()
+
+			if fail {
+				reply("451 Try again later")
+				continue
+			}
+
+			m.from = strings.Trim(strings.TrimPrefix(line, "MAIL FROM:"), "<>")
+			reply("250 OK")
+		case "RCPT":
+			m.to = append(m.to, strings.Trim(strings.TrimPrefix(line, "RCPT TO:"), "<>"))
+			reply("250 OK")
+		case "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+
+			var data strings.Builder
+			for {
+				line, err = r.ReadString('\n')
+				if err != nil || line == ".\r\n" {
+					break
+				}
+				data.WriteString(line)
+			}
+			m.data = data.String()
+
+			s.mutex.Lock()
+			s.mails = append(s.mails, m)
+			s.mutex.Unlock()
+
+			reply("250 OK")
+		case "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("502 Not implemented")
+		}
+	}
+}
+
+func (s *smtpStandIn) received() []*mail {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return append([]*mail(nil), s.mails...)
+}
+
+func TestSMTP_Deliver(t *testing.T) {
+	s := newSMTPStandIn(t, 0)
+	e := NewComplianceChangedEvent("service", "Control1", "alice", false, "2", "1")
+
+	c := NewSMTP(s.listener.Addr().String(), "cam@example.com",
+		[]string{"ops@example.com", "security@example.com"}, "cam", "password")
+	assert.NoError(t, c.Deliver(context.Background(), e))
+
+	mails := s.received()
+	assert.Len(t, mails, 1)
+	assert.Equal(t, " cam password", mails[0].auth)
+	assert.Equal(t, "cam@example.com", mails[0].from)
+	assert.Equal(t, []string{"ops@example.com", "security@example.com"}, mails[0].to)
+	assert.Contains(t, mails[0].data, "Subject: [CAM] Control Control1 of service service is not compliant\r\n")
+	assert.Contains(t, mails[0].data, "changed from compliant to not compliant")
+	assert.Contains(t, mails[0].data, "Tenant: alice")
+
+	// Without recipients, nothing can be delivered
+	err := NewSMTP(s.listener.Addr().String(), "cam@example.com", nil, "", "").Deliver(context.Background(), e)
+	assert.ErrorAs(t, err, new(permanentError))
+}
+
+func TestSMTP_Retries(t *testing.T) {
+	s := newSMTPStandIn(t, 2)
+	store := newStorage(t)
+
+	d := NewDispatcher(store, 3, time.Millisecond,
+		NewSMTP(s.listener.Addr().String(), "cam@example.com", []string{"ops@example.com"}, "", ""))
+	d.Notify(NewComplianceChangedEvent("service", "Control1", "", true, "2", "1"))
+	assert.Eventually(t, func() bool { return len(s.received()) == 1 }, time.Second, time.Millisecond)
+	d.Close()
+
+	mails := s.received()
+	assert.Len(t, mails, 1)
+	assert.Empty(t, mails[0].auth)
+	assert.Contains(t, mails[0].data, "changed from not compliant to compliant")
+
+	count, err := store.Count(&DeadLetter{})
+	assert.NoError(t, err)
+	assert.Zero(t, count)
+}
@@ -0,0 +1,130 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// HeaderEvent contains the type of the event
+	HeaderEvent = "X-CAM-Event"
+	// HeaderDelivery contains the ID of the event, which is the same for all attempts
+	HeaderDelivery = "X-CAM-Delivery"
+	// HeaderTimestamp contains the time of the attempt in seconds since the epoch
+	HeaderTimestamp = "X-CAM-Timestamp"
+	// HeaderSignature contains the signature of the request as sha256=<hex encoded HMAC>
+	HeaderSignature = "X-CAM-Signature"
+
+	// webhookTimeout is the timeout of a single attempt
+	webhookTimeout = 10 * time.Second
+)
+
+// Webhook delivers events as JSON via HTTP POST. Each request is signed with an HMAC-SHA256 of the timestamp and the
+// body, so that receivers can verify that it originates from CAM and is recent (see VerifySignature).
+type Webhook struct {
+	url    string
+	secret []byte
+	client *http.Client
+}
+
+// NewWebhook creates a webhook, which delivers to the URL and signs with the secret
+func NewWebhook(url string, secret []byte) *Webhook {
+	return &Webhook{
+		url:    url,
+		secret: secret,
+		client: &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+// Name returns the name of the webhook, which is its URL without query and user info
+func (w *Webhook) Name() string {
+	u, err := url.Parse(w.url)
+	if err != nil {
+		return "webhook"
+	}
+
+	return "webhook " + u.Scheme + "://" + u.Host + u.Path
+}
+
+// Deliver posts the event to the URL of the webhook. Responses with status 4xx, apart from 408 and 429, are permanent
+// errors.
+func (w *Webhook) Deliver(ctx context.Context, e *Event) (err error) {
+	body, err := json.Marshal(e)
+	if err != nil {
+		return Permanent(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return Permanent(err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, e.Type)
+	req.Header.Set(HeaderDelivery, e.ID)
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, "sha256="+hex.EncodeToString(sign(w.secret, timestamp, body)))
+
+	res, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	switch {
+	case res.StatusCode >= 200 && res.StatusCode < 300:
+		return nil
+	case res.StatusCode >= 400 && res.StatusCode < 500 && res.StatusCode != http.StatusRequestTimeout &&
+		res.StatusCode != http.StatusTooManyRequests:
+		return Permanent(fmt.Errorf("webhook responded with %s", res.Status))
+	default:
+		return fmt.Errorf("webhook responded with %s", res.Status)
+	}
+}
+
+// VerifySignature verifies the signature header of a webhook request with the timestamp header and the body. Receivers
+// should additionally reject old timestamps to prevent replays.
+func VerifySignature(secret []byte, timestamp string, body []byte, signature string) bool {
+	mac, err := hex.DecodeString(strings.TrimPrefix(signature, "sha256="))
+	if err != nil {
+		return false
+	}
+
+	return hmac.Equal(mac, sign(secret, timestamp, body))
+}
+
+// sign returns the HMAC-SHA256 of the timestamp and the body
+func sign(secret []byte, timestamp string, body []byte) []byte {
+	h := hmac.New(sha256.New, secret)
+	h.Write([]byte(timestamp + "."))
+	h.Write(body)
+
+	return h.Sum(nil)
+}
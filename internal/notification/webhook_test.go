@@ -0,0 +1,148 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package notification
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhook_Deliver(t *testing.T) {
+	var (
+		secret   = []byte("my-secret")
+		mutex    sync.Mutex
+		statuses []int
+		received []*Event
+	)
+
+	// The stand-in verifies the signature and responds with the next status
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		body, _ := io.ReadAll(r.Body)
+		if !VerifySignature(secret, r.Header.Get(HeaderTimestamp), body, r.Header.Get(HeaderSignature)) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		status := http.StatusNoContent
+		if len(statuses) > 0 {
+			status, statuses = statuses[0], statuses[1:]
+		}
+		if status < 300 {
+			var e Event
+			_ = json.Unmarshal(body, &e)
+			received = append(received, &e)
+			assert.Equal(t, e.ID, r.Header.Get(HeaderDelivery))
+			assert.Equal(t, EventComplianceChanged, r.Header.Get(HeaderEvent))
+		}
+
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	e := NewComplianceChangedEvent("service", "Control1", "", false, "2", "1")
+
+	tests := []struct {
+		name          string
+		secret        []byte
+		statuses      []int
+		wantErr       bool
+		wantPermanent bool
+	}{
+		{
+			name:   "delivered",
+			secret: secret,
+		},
+		{
+			name:          "wrong secret",
+			secret:        []byte("other secret"),
+			wantErr:       true,
+			wantPermanent: true,
+		},
+		{
+			name:     "unavailable",
+			secret:   secret,
+			statuses: []int{http.StatusServiceUnavailable},
+			wantErr:  true,
+		},
+		{
+			name:     "rate limited",
+			secret:   secret,
+			statuses: []int{http.StatusTooManyRequests},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mutex.Lock()
+			statuses = tt.statuses
+			mutex.Unlock()
+
+			err := NewWebhook(srv.URL+"/hook?token=abc", tt.secret).Deliver(context.Background(), e)
+			assert.Equal(t, tt.wantErr, err != nil, err)
+
+			var permanent permanentError
+			assert.Equal(t, tt.wantPermanent, errors.As(err, &permanent))
+		})
+	}
+
+	assert.Len(t, received, 1)
+	assert.Equal(t, e.ID, received[0].ID)
+	assert.False(t, received[0].Compliant)
+	assert.True(t, received[0].PreviouslyCompliant)
+
+	// Retries of the dispatcher are delivered to the stand-in
+	statuses = []int{http.StatusBadGateway, http.StatusServiceUnavailable}
+	d := NewDispatcher(nil, 3, time.Millisecond, NewWebhook(srv.URL, secret))
+	d.Notify(e)
+	assert.Eventually(t, func() bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		return len(received) == 2
+	}, time.Second, time.Millisecond)
+	d.Close()
+
+	assert.Len(t, received, 2)
+	assert.Empty(t, statuses)
+
+	// The name does not disclose tokens in the query
+	assert.Equal(t, "webhook "+srv.URL+"/hook", NewWebhook(srv.URL+"/hook?token=abc", secret).Name())
+}
+
+func TestVerifySignature(t *testing.T) {
+	secret := []byte("my-secret")
+	body := []byte(`{"id":"1"}`)
+
+	assert.False(t, VerifySignature(secret, "1", body, "sha256=c0ffee"))
+	assert.False(t, VerifySignature(secret, "1", body, "sha256=not hex"))
+
+	mac := sign(secret, "1", body)
+	assert.True(t, VerifySignature(secret, "1", body, "sha256="+hex.EncodeToString(mac)))
+	assert.False(t, VerifySignature(secret, "2", body, "sha256="+hex.EncodeToString(mac)))
+}
@@ -0,0 +1,153 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package secrets
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// LocalKeyProvider is a KeyProvider, which keeps its key encryption keys in a local key file. The file contains all
+// keys, so that secrets encrypted with previous keys can still be decrypted after a rotation.
+type LocalKeyProvider struct {
+	path string
+
+	mutex sync.RWMutex
+	keys  localKeyFile
+}
+
+// localKeyFile is the content of the key file
+type localKeyFile struct {
+	// Current is the ID of the key, which is used for new secrets
+	Current string `json:"current"`
+
+	// Keys contains the AES-256 keys (value) per ID (key)
+	Keys map[string][]byte `json:"keys"`
+}
+
+// NewLocalKeyProvider loads the key file at path. If the file does not exist, it is created with a new key.
+func NewLocalKeyProvider(path string) (kp *LocalKeyProvider, err error) {
+	kp = &LocalKeyProvider{path: path}
+
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		if err = kp.Rotate(); err != nil {
+			return nil, err
+		}
+
+		return kp, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("could not read key file: %w", err)
+	}
+
+	if err = json.Unmarshal(b, &kp.keys); err != nil {
+		return nil, fmt.Errorf("could not parse key file: %w", err)
+	}
+	if _, ok := kp.keys.Keys[kp.keys.Current]; !ok {
+		return nil, fmt.Errorf("%w: current key %s is missing in key file", ErrUnknownKey, kp.keys.Current)
+	}
+
+	return kp, nil
+}
+
+// Rotate creates a new key encryption key, which is used for new secrets from now on, and stores it in the key file.
+// The previous keys are kept to decrypt existing secrets.
+func (kp *LocalKeyProvider) Rotate() (err error) {
+	var (
+		key = make([]byte, 32)
+		id  = make([]byte, 8)
+	)
+
+	if _, err = io.ReadFull(rand.Reader, key); err != nil {
+		return fmt.Errorf("could not create key: %w", err)
+	}
+	if _, err = io.ReadFull(rand.Reader, id); err != nil {
+		return fmt.Errorf("could not create key ID: %w", err)
+	}
+
+	kp.mutex.Lock()
+	defer kp.mutex.Unlock()
+
+	keys := localKeyFile{Current: hex.EncodeToString(id), Keys: map[string][]byte{hex.EncodeToString(id): key}}
+	for id, key := range kp.keys.Keys {
+		keys.Keys[id] = key
+	}
+
+	b, err := json.MarshalIndent(keys, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not marshal key file: %w", err)
+	}
+
+	// Write the new key file next to the old one first, so that no keys are lost, if writing fails
+	tmp := kp.path + ".tmp"
+	if err = os.WriteFile(tmp, b, 0600); err != nil {
+		return fmt.Errorf("could not write key file: %w", err)
+	}
+	if err = os.Rename(tmp, kp.path); err != nil {
+		return fmt.Errorf("could not write key file: %w", err)
+	}
+
+	kp.keys = keys
+
+	return nil
+}
+
+// CurrentKeyID implements KeyProvider
+func (kp *LocalKeyProvider) CurrentKeyID() string {
+	kp.mutex.RLock()
+	defer kp.mutex.RUnlock()
+
+	return kp.keys.Current
+}
+
+// WrapKey implements KeyProvider
+func (kp *LocalKeyProvider) WrapKey(_ context.Context, keyID string, dataKey []byte) ([]byte, error) {
+	key, err := kp.key(keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return seal(key, dataKey)
+}
+
+// UnwrapKey implements KeyProvider
+func (kp *LocalKeyProvider) UnwrapKey(_ context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	key, err := kp.key(keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return open(key, wrapped)
+}
+
+func (kp *LocalKeyProvider) key(keyID string) ([]byte, error) {
+	kp.mutex.RLock()
+	defer kp.mutex.RUnlock()
+
+	key, ok := kp.keys.Keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKey, keyID)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,168 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+// Package secrets contains the envelope encryption of secrets at rest. Each secret is encrypted with its own random
+// data key, which is in turn encrypted (wrapped) with a key encryption key of a KeyProvider. Rotating the key
+// encryption key therefore only requires re-wrapping the data keys.
+package secrets
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// prefix marks an encrypted secret. The complete format is prefix<key ID>:<wrapped data key>:<ciphertext>, where the
+// wrapped data key and the ciphertext are base64 (URL) encoded.
+const prefix = "cam:enc:v1:"
+
+var (
+	// ErrUnknownKey indicates that the key encryption key of an encrypted secret is not known to the key provider
+	ErrUnknownKey = errors.New("unknown key encryption key")
+	// ErrMalformedSecret indicates that a value looks like an encrypted secret, but cannot be parsed
+	ErrMalformedSecret = errors.New("malformed encrypted secret")
+)
+
+// KeyProvider manages the key encryption keys. It resembles the interface of a key management service (KMS), so that
+// the keys never need to leave it.
+type KeyProvider interface {
+	// CurrentKeyID returns the ID of the key encryption key, which is used for new secrets
+	CurrentKeyID() string
+
+	// WrapKey encrypts the data key with the key encryption key keyID
+	WrapKey(ctx context.Context, keyID string, dataKey []byte) (wrapped []byte, err error)
+
+	// UnwrapKey decrypts the data key, which has been wrapped with the key encryption key keyID
+	UnwrapKey(ctx context.Context, keyID string, wrapped []byte) (dataKey []byte, err error)
+}
+
+// IsEncrypted returns whether value is an encrypted secret
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, prefix)
+}
+
+// KeyID returns the ID of the key encryption key of an encrypted secret or an empty string, if value is not encrypted
+func KeyID(value string) string {
+	if !IsEncrypted(value) {
+		return ""
+	}
+
+	id, _, _ := strings.Cut(strings.TrimPrefix(value, prefix), ":")
+	return id
+}
+
+// Encrypt encrypts the plaintext with a new data key, which is wrapped with the current key encryption key of kp
+func Encrypt(ctx context.Context, kp KeyProvider, plaintext string) (value string, err error) {
+	dataKey := make([]byte, 32)
+	if _, err = io.ReadFull(rand.Reader, dataKey); err != nil {
+		return "", fmt.Errorf("could not create data key: %w", err)
+	}
+
+	ciphertext, err := seal(dataKey, []byte(plaintext))
+	if err != nil {
+		return "", err
+	}
+
+	keyID := kp.CurrentKeyID()
+	wrapped, err := kp.WrapKey(ctx, keyID, dataKey)
+	if err != nil {
+		return "", fmt.Errorf("could not wrap data key: %w", err)
+	}
+
+	return prefix + keyID + ":" + base64.RawURLEncoding.EncodeToString(wrapped) + ":" +
+		base64.RawURLEncoding.EncodeToString(ciphertext), nil
+}
+
+// Decrypt decrypts an encrypted secret. Values, which are not encrypted, are returned as they are.
+func Decrypt(ctx context.Context, kp KeyProvider, value string) (plaintext string, err error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+
+	parts := strings.Split(strings.TrimPrefix(value, prefix), ":")
+	if len(parts) != 3 {
+		return "", ErrMalformedSecret
+	}
+
+	wrapped, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrMalformedSecret, err)
+	}
+	ciphertext, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrMalformedSecret, err)
+	}
+
+	dataKey, err := kp.UnwrapKey(ctx, parts[0], wrapped)
+	if err != nil {
+		return "", fmt.Errorf("could not unwrap data key: %w", err)
+	}
+
+	b, err := open(dataKey, ciphertext)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// seal encrypts the plaintext with AES-GCM. The random nonce is prepended to the ciphertext.
+func seal(key []byte, plaintext []byte) (ciphertext []byte, err error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("could not create nonce: %w", err)
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// open decrypts a ciphertext created by seal
+func open(key []byte, ciphertext []byte) (plaintext []byte, err error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, ErrMalformedSecret
+	}
+
+	plaintext, err = aead.Open(nil, ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():], nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt: %w", err)
+	}
+
+	return
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key: %w", err)
+	}
+
+	return cipher.NewGCM(block)
+}
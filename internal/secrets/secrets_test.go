@@ -0,0 +1,96 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package secrets
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncrypt(t *testing.T) {
+	ctx := context.Background()
+
+	kp, err := NewLocalKeyProvider(filepath.Join(t.TempDir(), "keys.json"))
+	assert.NoError(t, err)
+
+	value, err := Encrypt(ctx, kp, "secret")
+	assert.NoError(t, err)
+	assert.True(t, IsEncrypted(value))
+	assert.NotContains(t, value, "secret")
+	assert.Equal(t, kp.CurrentKeyID(), KeyID(value))
+
+	plaintext, err := Decrypt(ctx, kp, value)
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", plaintext)
+
+	// Values, which are not encrypted, are returned as they are
+	plaintext, err = Decrypt(ctx, kp, "not encrypted")
+	assert.NoError(t, err)
+	assert.Equal(t, "not encrypted", plaintext)
+	assert.Empty(t, KeyID("not encrypted"))
+
+	// Tampered secrets cannot be decrypted
+	_, err = Decrypt(ctx, kp, value[:len(value)-2]+"AA")
+	assert.Error(t, err)
+	_, err = Decrypt(ctx, kp, prefix+"id:abc")
+	assert.ErrorIs(t, err, ErrMalformedSecret)
+}
+
+func TestLocalKeyProvider_Rotate(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		path = filepath.Join(t.TempDir(), "keys.json")
+	)
+
+	kp, err := NewLocalKeyProvider(path)
+	assert.NoError(t, err)
+
+	info, err := os.Stat(path)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	old, err := Encrypt(ctx, kp, "secret")
+	assert.NoError(t, err)
+
+	assert.NoError(t, kp.Rotate())
+	assert.NotEqual(t, KeyID(old), kp.CurrentKeyID())
+
+	// The rotated key file is loaded again, including the previous key
+	kp, err = NewLocalKeyProvider(path)
+	assert.NoError(t, err)
+
+	plaintext, err := Decrypt(ctx, kp, old)
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", plaintext)
+
+	value, err := Encrypt(ctx, kp, "secret")
+	assert.NoError(t, err)
+	assert.Equal(t, kp.CurrentKeyID(), KeyID(value))
+
+	// Secrets of unknown keys cannot be decrypted
+	_, err = Decrypt(ctx, kp, strings.Replace(old, KeyID(old), "unknown", 1))
+	assert.ErrorIs(t, err, ErrUnknownKey)
+
+	// A key file without its current key is rejected
+	assert.NoError(t, os.WriteFile(path, []byte(`{"current":"k1","keys":{}}`), 0600))
+	_, err = NewLocalKeyProvider(path)
+	assert.ErrorIs(t, err, ErrUnknownKey)
+}
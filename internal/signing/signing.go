@@ -0,0 +1,121 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+// Package signing contains the keys, with which CAM signs evidences and compliance credentials. The signatures
+// themselves are created by go-jose (evidences) and golang-jwt (credentials). The algorithm is derived from the type
+// of the key: ES256, ES384 and ES512 for ECDSA keys, EdDSA for Ed25519 keys and RS256 for RSA keys.
+package signing
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+
+	"gopkg.in/square/go-jose.v2"
+)
+
+// ErrUnsupportedKey indicates that there is no signature algorithm for the type of the key
+var ErrUnsupportedKey = errors.New("unsupported key type")
+
+// Algorithm returns the signature algorithm for the private or public key
+func Algorithm(key interface{}) (alg jose.SignatureAlgorithm, err error) {
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		return Algorithm(&k.PublicKey)
+	case *ecdsa.PublicKey:
+		switch k.Curve {
+		case elliptic.P256():
+			return jose.ES256, nil
+		case elliptic.P384():
+			return jose.ES384, nil
+		case elliptic.P521():
+			return jose.ES512, nil
+		}
+	case ed25519.PrivateKey, ed25519.PublicKey:
+		return jose.EdDSA, nil
+	case *rsa.PrivateKey, *rsa.PublicKey:
+		return jose.RS256, nil
+	}
+
+	return "", fmt.Errorf("%w: %T", ErrUnsupportedKey, key)
+}
+
+// PublicKey returns the public key of the private key
+func PublicKey(key crypto.PrivateKey) (pub crypto.PublicKey, err error) {
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedKey, key)
+	}
+
+	return signer.Public(), nil
+}
+
+// LoadPrivateKey loads a PEM encoded private key (PKCS #8, SEC 1 or PKCS #1) from a file
+func LoadPrivateKey(path string) (key crypto.PrivateKey, err error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		return x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+}
+
+// LoadPublicKey loads a PEM encoded public key (PKIX) or the public key of a PEM encoded certificate from a file
+func LoadPublicKey(path string) (key crypto.PublicKey, err error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if block.Type == "CERTIFICATE" {
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+
+		return cert.PublicKey, nil
+	}
+
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
+
+// readPEM reads the first PEM block of a file
+func readPEM(path string) (block *pem.Block, err error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read key: %w", err)
+	}
+
+	block, _ = pem.Decode(b)
+	if block == nil {
+		return nil, fmt.Errorf("could not read key: no PEM data in %s", path)
+	}
+
+	return block, nil
+}
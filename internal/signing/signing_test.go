@@ -0,0 +1,109 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package signing
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/square/go-jose.v2"
+)
+
+func TestAlgorithm(t *testing.T) {
+	p256, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	p384, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	assert.NoError(t, err)
+	pub, ed, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	r, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		key     interface{}
+		want    jose.SignatureAlgorithm
+		wantErr error
+	}{
+		{name: "ECDSA P-256", key: p256, want: jose.ES256},
+		{name: "ECDSA P-384 public key", key: &p384.PublicKey, want: jose.ES384},
+		{name: "Ed25519", key: ed, want: jose.EdDSA},
+		{name: "Ed25519 public key", key: pub, want: jose.EdDSA},
+		{name: "RSA", key: r, want: jose.RS256},
+		{name: "No key", key: "no key", wantErr: ErrUnsupportedKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Algorithm(tt.key)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPublicKey(t *testing.T) {
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	got, err := PublicKey(key)
+	assert.NoError(t, err)
+	assert.Equal(t, pub, got)
+
+	_, err = PublicKey("no key")
+	assert.ErrorIs(t, err, ErrUnsupportedKey)
+}
+
+func TestLoadKeys(t *testing.T) {
+	var (
+		dir  = t.TempDir()
+		priv = filepath.Join(dir, "key.pem")
+		pub  = filepath.Join(dir, "pub.pem")
+	)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	b, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(priv, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: b}), 0600))
+
+	b, err = x509.MarshalPKIXPublicKey(&key.PublicKey)
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(pub, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: b}), 0600))
+
+	loaded, err := LoadPrivateKey(priv)
+	assert.NoError(t, err)
+	assert.True(t, key.Equal(loaded))
+
+	loadedPub, err := LoadPublicKey(pub)
+	assert.NoError(t, err)
+	assert.True(t, key.PublicKey.Equal(loadedPub))
+
+	_, err = LoadPrivateKey(filepath.Join(dir, "missing.pem"))
+	assert.Error(t, err)
+
+	_, err = LoadPublicKey(priv)
+	assert.Error(t, err)
+}
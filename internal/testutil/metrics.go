@@ -0,0 +1,39 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package testutil
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+// Observations returns the number and the sum of the observations of the histogram h, e.g., the one of a label value
+// combination returned by HistogramVec.WithLabelValues
+func Observations(t *testing.T, h prometheus.Observer) (count uint64, sum float64) {
+	var m dto.Metric
+
+	metric, ok := h.(prometheus.Metric)
+	if !assert.True(t, ok, "%T is not a metric", h) {
+		return 0, 0
+	}
+
+	assert.NoError(t, metric.Write(&m))
+
+	return m.GetHistogram().GetSampleCount(), m.GetHistogram().GetSampleSum()
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/eclipse-xfsc/cam/api/collection"
 	"github.com/eclipse-xfsc/cam/api/common"
+	"github.com/eclipse-xfsc/cam/api/configuration"
 	"github.com/eclipse-xfsc/cam/api/evaluation"
 )
 
@@ -42,7 +43,13 @@ func NewInMemoryStorage(t *testing.T, funcs ...func(s persistence.Storage)) (s p
 			collection.CollectionModule{},
 			common.Evidence{},
 			common.Error{},
-			collection.ServiceConfiguration{}),
+			collection.ServiceConfiguration{},
+			configuration.AttestationTrustAnchor{},
+			configuration.AttestationReferenceManifest{},
+			configuration.RevokedCertificate{},
+			configuration.AttestationTrustStoreVersion{},
+			configuration.CloudServiceTenant{},
+			configuration.AuditEvent{}),
 		// For storage debugging, set to `logger.Info`
 		gorm.WithLogger(logger.Default.LogMode(logger.Silent)))
 	if err != nil {
@@ -96,7 +103,9 @@ func NewInMemoryStorageWithListError(t *testing.T, listErrorMsg string, funcs ..
 			evaluation.Compliance{},
 			collection.CollectionModule{},
 			common.Evidence{},
-			common.Error{}),
+			common.Error{},
+			configuration.CloudServiceTenant{},
+			configuration.AuditEvent{}),
 		// For storage debugging, set to `logger.Info`
 		gorm.WithLogger(logger.Default.LogMode(logger.Silent)))
 	if err != nil {
@@ -137,7 +146,9 @@ func NewInMemoryStorageWithSaveError(t *testing.T, saveErrorMsg string, funcs ..
 			evaluation.Compliance{},
 			collection.CollectionModule{},
 			common.Evidence{},
-			common.Error{}),
+			common.Error{},
+			configuration.CloudServiceTenant{},
+			configuration.AuditEvent{}),
 		// For storage debugging, set to `logger.Info`
 		gorm.WithLogger(logger.Default.LogMode(logger.Silent)))
 	if err != nil {
@@ -23,6 +23,7 @@ import (
 	"clouditor.io/clouditor/api/orchestrator"
 	"clouditor.io/clouditor/persistence/gorm"
 
+	"github.com/eclipse-xfsc/cam/api/collection"
 	"github.com/eclipse-xfsc/cam/api/configuration"
 	api_evaluation "github.com/eclipse-xfsc/cam/api/evaluation"
 	service_configuration "github.com/eclipse-xfsc/cam/service/configuration"
@@ -60,7 +61,19 @@ func StartBufConnServerToEvaluation() (*grpc.Server, api_evaluation.EvaluationSe
 		service_evaluation.WithRequirementsManagerAddress("bufnet", grpc.WithContextDialer(BufConnDialer)))
 	api_evaluation.RegisterEvaluationServer(server, evaluationServer)
 
-	configServer := service_configuration.NewServer()
+	configDB, err := gorm.NewStorage(gorm.WithInMemory(), gorm.WithAdditionalAutoMigration(
+		collection.CollectionModule{},
+		collection.ServiceConfiguration{},
+		configuration.AttestationTrustAnchor{},
+		configuration.AttestationReferenceManifest{},
+		configuration.RevokedCertificate{},
+		configuration.AttestationTrustStoreVersion{},
+		configuration.CloudServiceTenant{},
+		configuration.AuditEvent{}))
+	if err != nil {
+		log.Fatalf("Couldn't create storage for bufconn listener: %v", err)
+	}
+	configServer := service_configuration.NewServer(service_configuration.WithStorage(configDB))
 	configuration.RegisterConfigurationServer(server, configServer)
 	orchestrator.RegisterOrchestratorServer(server, configServer)
 
@@ -0,0 +1,70 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package testutil
+
+import (
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RecordSpans records the spans of the global tracer provider until the end of the test
+func RecordSpans(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previous)
+	})
+
+	return recorder
+}
+
+// FindSpans returns the ended spans of the recorder with the name
+func FindSpans(recorder *tracetest.SpanRecorder, name string) (spans []sdktrace.ReadOnlySpan) {
+	for _, s := range recorder.Ended() {
+		if s.Name() == name {
+			spans = append(spans, s)
+		}
+	}
+
+	return
+}
+
+// SpanAttribute returns the value of the attribute of the span. It is empty, if the span has no such attribute.
+func SpanAttribute(s sdktrace.ReadOnlySpan, key string) attribute.Value {
+	for _, kv := range s.Attributes() {
+		if string(kv.Key) == key {
+			return kv.Value
+		}
+	}
+
+	return attribute.Value{}
+}
+
+// SpanLinks returns the span contexts, to which the span links
+func SpanLinks(s sdktrace.ReadOnlySpan) (links []trace.SpanContext) {
+	for _, l := range s.Links() {
+		links = append(links, l.SpanContext)
+	}
+
+	return
+}
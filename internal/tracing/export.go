@@ -0,0 +1,87 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+)
+
+// shutdownTimeout is the maximum duration of exporting the remaining spans on shutdown
+const shutdownTimeout = 10 * time.Second
+
+var log = logrus.WithField("component", "tracing")
+
+// ExportTo sets the global tracer provider, which exports the spans of the service in batches to the OTLP/HTTP
+// endpoint, e.g., http://localhost:4318. If the endpoint has no path, the spans are sent to the path /v1/traces. If
+// the endpoint is empty, spans are still created and propagated, but not exported. The returned function shuts the
+// tracer provider down, so that the remaining spans are exported, before the service exits.
+func ExportTo(endpoint string, serviceName string) (shutdown func(), err error) {
+	var opts = []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL,
+			semconv.ServiceNameKey.String(serviceName))),
+	}
+
+	if endpoint != "" {
+		exporter, err := newOTLPExporter(endpoint)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Infof("Exporting traces to %s", endpoint)
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	}
+
+	provider := sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(provider)
+
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		if err := provider.Shutdown(ctx); err != nil {
+			log.Errorf("Could not export remaining spans: %v", err)
+		}
+	}, nil
+}
+
+// newOTLPExporter creates an exporter, which sends spans to the OTLP/HTTP endpoint
+func newOTLPExporter(endpoint string) (*otlptrace.Exporter, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid OTLP endpoint %q", endpoint)
+	}
+
+	var opts = []otlptracehttp.Option{otlptracehttp.WithEndpoint(u.Host)}
+	if u.Path != "" && u.Path != "/" {
+		opts = append(opts, otlptracehttp.WithURLPath(u.Path))
+	}
+	if u.Scheme == "http" {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+
+	return otlptracehttp.New(context.Background(), opts...)
+}
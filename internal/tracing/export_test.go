@@ -0,0 +1,119 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestExportTo(t *testing.T) {
+	var (
+		mutex    sync.Mutex
+		requests []*coltracepb.ExportTraceServiceRequest
+		paths    []string
+	)
+
+	defer otel.SetTracerProvider(otel.GetTracerProvider())
+
+	// The collector decodes the requests with the protobuf messages of OTLP
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req coltracepb.ExportTraceServiceRequest
+
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+		assert.NoError(t, proto.Unmarshal(body, &req))
+
+		mutex.Lock()
+		requests = append(requests, &req)
+		paths = append(paths, r.URL.Path)
+		mutex.Unlock()
+
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer collector.Close()
+
+	shutdown, err := ExportTo(collector.URL, "cam-test")
+	assert.NoError(t, err)
+
+	remote := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	ctx, parent := Start(ContextWithTraceParent(context.Background(), remote), "evaluation.ReceiveEvidence")
+	_, child := Start(ctx, "assessment.AssessEvidence")
+	child.End()
+	parent.End()
+
+	// Shutting down exports the remaining spans
+	shutdown()
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	assert.Equal(t, []string{"/v1/traces"}, paths)
+	if !assert.Len(t, requests, 1) || !assert.Len(t, requests[0].ResourceSpans, 1) {
+		return
+	}
+
+	resource := requests[0].ResourceSpans[0]
+	var serviceName string
+	for _, kv := range resource.Resource.Attributes {
+		if kv.Key == "service.name" {
+			serviceName = kv.Value.GetStringValue()
+		}
+	}
+	assert.Equal(t, "cam-test", serviceName)
+	if !assert.Len(t, resource.ScopeSpans, 1) {
+		return
+	}
+	assert.Equal(t, scopeName, resource.ScopeSpans[0].Scope.Name)
+
+	spans := map[string]*tracepb.Span{}
+	for _, s := range resource.ScopeSpans[0].Spans {
+		spans[s.Name] = s
+	}
+	assert.Len(t, spans, 2)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", hex.EncodeToString(spans["evaluation.ReceiveEvidence"].TraceId))
+	assert.Equal(t, "00f067aa0ba902b7", hex.EncodeToString(spans["evaluation.ReceiveEvidence"].ParentSpanId))
+	assert.Equal(t, spans["evaluation.ReceiveEvidence"].SpanId, spans["assessment.AssessEvidence"].ParentSpanId)
+}
+
+func TestExportTo_endpoint(t *testing.T) {
+	defer otel.SetTracerProvider(otel.GetTracerProvider())
+
+	// Without an endpoint, spans are not exported, but the trace context is still propagated
+	shutdown, err := ExportTo("", "cam-test")
+	assert.NoError(t, err)
+	ctx, s := Start(context.Background(), "span")
+	assert.NotEmpty(t, TraceParent(ctx))
+	s.End()
+	shutdown()
+
+	for _, endpoint := range []string{"localhost:4318", "grpc://localhost:4317", "http://"} {
+		_, err = ExportTo(endpoint, "cam-test")
+		assert.ErrorContains(t, err, "invalid OTLP endpoint", endpoint)
+	}
+}
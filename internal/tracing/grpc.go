@@ -0,0 +1,40 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package tracing
+
+import (
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+)
+
+// ServerOptions returns the server options, which create a span for each RPC of a gRPC server. The span is a child of
+// the span propagated by the client, if any. Like the ones of the metrics, the server options need to precede the ones
+// of other interceptors, e.g., to also trace the RPCs rejected due to missing authentication.
+func ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(otelgrpc.UnaryServerInterceptor(otelgrpc.WithPropagators(propagator))),
+		grpc.ChainStreamInterceptor(otelgrpc.StreamServerInterceptor(otelgrpc.WithPropagators(propagator))),
+	}
+}
+
+// DialOptions returns the dial options, which create a span for each RPC of a gRPC client and propagate it to the
+// server
+func DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(otelgrpc.UnaryClientInterceptor(otelgrpc.WithPropagators(propagator))),
+		grpc.WithChainStreamInterceptor(otelgrpc.StreamClientInterceptor(otelgrpc.WithPropagators(propagator))),
+	}
+}
@@ -0,0 +1,90 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package tracing
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/eclipse-xfsc/cam/internal/testutil"
+)
+
+func TestServerOptions(t *testing.T) {
+	const method = "grpc.health.v1.Health/Check"
+
+	recorder := testutil.RecordSpans(t)
+
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer(ServerOptions()...)
+	grpc_health_v1.RegisterHealthServer(srv, health.NewServer())
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	defer srv.Stop()
+
+	conn, err := grpc.Dial("bufnet", append(DialOptions(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))...)
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	healthClient := grpc_health_v1.NewHealthClient(conn)
+
+	ctx, parent := Start(context.Background(), "configuration.RunCollection")
+	_, err = healthClient.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	parent.End()
+
+	// The server span is a child of the client span, which is a child of the span of the caller
+	spans := testutil.FindSpans(recorder, method)
+	assert.Len(t, spans, 2)
+
+	var server, client sdktrace.ReadOnlySpan
+	for _, s := range spans {
+		if s.SpanKind() == trace.SpanKindServer {
+			server = s
+		} else {
+			client = s
+		}
+	}
+	if !assert.NotNil(t, server) || !assert.NotNil(t, client) {
+		return
+	}
+	assert.Equal(t, trace.SpanKindClient, client.SpanKind())
+
+	assert.Equal(t, parent.SpanContext().TraceID(), client.SpanContext().TraceID())
+	assert.Equal(t, parent.SpanContext().SpanID(), client.Parent().SpanID())
+	assert.Equal(t, client.SpanContext().TraceID(), server.SpanContext().TraceID())
+	assert.Equal(t, client.SpanContext().SpanID(), server.Parent().SpanID())
+	assert.True(t, server.Parent().IsRemote())
+
+	assert.Equal(t, "grpc", testutil.SpanAttribute(server, "rpc.system").AsString())
+	assert.Equal(t, int64(codes.NotFound), testutil.SpanAttribute(server, "rpc.grpc.status_code").AsInt64())
+	assert.Equal(t, otelcodes.Error, server.Status().Code)
+}
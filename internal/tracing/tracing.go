@@ -0,0 +1,79 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+// Package tracing traces the lifecycle of evidences across the services with OpenTelemetry. The trace context is
+// propagated in the W3C Trace Context format (traceparent) through gRPC and evidences, and spans are exported to an
+// OTLP endpoint.
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TraceParentHeader is the gRPC metadata key (and HTTP header), which contains the trace context
+const TraceParentHeader = "traceparent"
+
+// scopeName is the name of the instrumentation scope of all spans
+const scopeName = "github.com/eclipse-xfsc/cam"
+
+// propagator propagates the trace context in the W3C Trace Context format
+var propagator = propagation.TraceContext{}
+
+// Start starts a span with the global tracer provider. If the context contains a span, e.g., a remote one added by
+// ContextWithTraceParent, the new span is its child. Otherwise, it starts a new trace.
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(scopeName).Start(ctx, name, opts...)
+}
+
+// End ends the span. If err is not nil, it is recorded in the span and the span is marked with an error status.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// TraceParent returns the traceparent of the span in the context. It is empty, if the context has no valid span.
+func TraceParent(ctx context.Context) string {
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+
+	return carrier.Get(TraceParentHeader)
+}
+
+// ContextWithTraceParent returns a copy of the context, in which the remote span of the traceparent is the parent of
+// new spans. If the traceparent is not valid, the context is returned as it is.
+func ContextWithTraceParent(ctx context.Context, traceParent string) context.Context {
+	return propagator.Extract(ctx, propagation.MapCarrier{TraceParentHeader: traceParent})
+}
+
+// SpanContextFromTraceParent returns the span context of the traceparent, e.g., to link to it. It is not valid, if
+// the traceparent is not valid.
+func SpanContextFromTraceParent(traceParent string) trace.SpanContext {
+	return trace.SpanContextFromContext(ContextWithTraceParent(context.Background(), traceParent))
+}
+
+// Detach returns a background context, which contains the current span of ctx, but is not cancelled with ctx. It is
+// used by goroutines, which continue an operation after the RPC, which started it, has returned.
+func Detach(ctx context.Context) context.Context {
+	return trace.ContextWithSpan(context.Background(), trace.SpanFromContext(ctx))
+}
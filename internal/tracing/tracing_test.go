@@ -0,0 +1,136 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/eclipse-xfsc/cam/internal/testutil"
+)
+
+func TestContextWithTraceParent(t *testing.T) {
+	tests := []struct {
+		name        string
+		traceParent string
+		want        string
+		wantSampled bool
+	}{
+		{
+			name:        "sampled",
+			traceParent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			want:        "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			wantSampled: true,
+		},
+		{
+			name:        "not sampled",
+			traceParent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00",
+			want:        "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00",
+		},
+		{
+			name:        "future version",
+			traceParent: "01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+			want:        "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			wantSampled: true,
+		},
+		{
+			name:        "empty",
+			traceParent: "",
+		},
+		{
+			name:        "zero trace ID",
+			traceParent: "00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		},
+		{
+			name:        "upper case",
+			traceParent: "00-4BF92F3577B34DA6A3CE929D0E0E4736-00F067AA0BA902B7-01",
+		},
+		{
+			name:        "invalid version",
+			traceParent: "ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		},
+		{
+			name:        "short span ID",
+			traceParent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba9-01",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := ContextWithTraceParent(context.Background(), tt.traceParent)
+			assert.Equal(t, tt.want, TraceParent(ctx))
+
+			sc := SpanContextFromTraceParent(tt.traceParent)
+			assert.Equal(t, tt.want != "", sc.IsValid())
+			assert.Equal(t, tt.wantSampled, sc.IsSampled())
+		})
+	}
+}
+
+func TestStart(t *testing.T) {
+	recorder := testutil.RecordSpans(t)
+
+	ctx, root := Start(context.Background(), "root", trace.WithAttributes(attribute.String("service.id", "s1")))
+	assert.True(t, root.SpanContext().IsValid())
+	assert.Equal(t, "00-"+root.SpanContext().TraceID().String()+"-"+root.SpanContext().SpanID().String()+"-01",
+		TraceParent(ctx))
+
+	_, child := Start(ctx, "child", trace.WithSpanKind(trace.SpanKindClient))
+	End(child, errors.New("unavailable"))
+	End(root, nil)
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 2)
+	assert.Equal(t, "child", spans[0].Name())
+	assert.Equal(t, root.SpanContext().TraceID(), spans[0].SpanContext().TraceID())
+	assert.Equal(t, root.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	assert.Equal(t, trace.SpanKindClient, spans[0].SpanKind())
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Equal(t, "unavailable", spans[0].Status().Description)
+	assert.Equal(t, codes.Unset, spans[1].Status().Code)
+	assert.Equal(t, "s1", testutil.SpanAttribute(spans[1], "service.id").AsString())
+
+	// A remote parent, e.g., of an evidence, continues its trace
+	remote := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	_, s := Start(ContextWithTraceParent(context.Background(), remote), "remote")
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", s.SpanContext().TraceID().String())
+	s.End()
+	assert.Equal(t, "00f067aa0ba902b7", testutil.FindSpans(recorder, "remote")[0].Parent().SpanID().String())
+
+	// An invalid remote parent is ignored
+	assert.Equal(t, TraceParent(ctx), TraceParent(ContextWithTraceParent(ctx, "invalid")))
+}
+
+func TestDetach(t *testing.T) {
+	testutil.RecordSpans(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ctx, s := Start(ctx, "rpc")
+	cancel()
+
+	detached := Detach(ctx)
+	assert.NoError(t, detached.Err())
+	assert.Equal(t, s, trace.SpanFromContext(detached))
+
+	remote := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	assert.Equal(t, remote, TraceParent(Detach(ContextWithTraceParent(ctx, remote))))
+	assert.Empty(t, TraceParent(Detach(context.Background())))
+}
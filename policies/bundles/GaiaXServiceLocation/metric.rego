@@ -0,0 +1,34 @@
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#	http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+#
+# Contributors:
+#	Fraunhofer AISEC
+
+package xfsc.metrics.gaia_x_service_location
+
+default applicable = false
+
+default compliant = false
+
+locations := input.gaiaXCredential.locations
+
+allowed := {l | l := data.target_value[_]}
+
+applicable {
+	locations != null
+}
+
+# All declared locations must be allowed. A self-description without any declared location is not compliant.
+compliant {
+	count(locations) > 0
+	count({l | l := locations[_]; not allowed[l]}) == 0
+}
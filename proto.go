@@ -24,6 +24,7 @@ package cam
 // Generate evidence resource
 //go:generate protoc -I . -I ./third_party/ -I ./third_party/clouditor --go_out=paths=source_relative:. --go-grpc_out=paths=source_relative:. --grpc-gateway_out=paths=source_relative:. --grpc-gateway_opt logtostderr=true api/common/evidence.proto
 // Run protobuf tagging
+//go:generate protoc -I . -I third_party -I ./third_party/clouditor --gotag_out=paths=source_relative:. --gotag_opt=Mapi/configuration/configuration.proto=github.com/eclipse-xfsc/cam/api/configuration api/configuration/configuration.proto
 //go:generate protoc -I . -I third_party -I ./third_party/clouditor --gotag_out=paths=source_relative:. --gotag_opt=Mapi/evaluation/evaluation.proto=github.com/eclipse-xfsc/cam/api/evaluation api/evaluation/evaluation.proto
 //go:generate protoc -I . -I third_party -I ./third_party/clouditor --gotag_out=paths=source_relative:. --gotag_opt=Mapi/common/evidence.proto=github.com/eclipse-xfsc/cam/api/evidence api/common/evidence.proto
 //go:generate protoc -I . -I third_party -I ./third_party/clouditor --gotag_out=paths=source_relative:. --gotag_opt=Mapi/collection/collection.proto=github.com/eclipse-xfsc/cam/api/collection api/collection/collection.proto
@@ -26,6 +26,8 @@ import (
 
 	"github.com/eclipse-xfsc/cam/api/configuration"
 	"github.com/eclipse-xfsc/cam/api/evaluation"
+	"github.com/eclipse-xfsc/cam/internal/metrics"
+	"github.com/eclipse-xfsc/cam/internal/tracing"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/sirupsen/logrus"
@@ -66,6 +68,8 @@ func RunGateway(configurationEndpoint string,
 	apiMux = runtime.NewServeMux()
 
 	opts = []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	opts = append(opts, metrics.DialOptions()...)
+	opts = append(opts, tracing.DialOptions()...)
 
 	err = configuration.RegisterConfigurationHandlerFromEndpoint(ctx, apiMux, configurationEndpoint, opts)
 	if err != nil {
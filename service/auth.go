@@ -0,0 +1,155 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v4"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"google.golang.org/grpc"
+)
+
+// claimsContextKey is the key of the Claims of the caller in the context of an RPC
+type claimsContextKey struct{}
+
+// Claims contains the claims of the token of the caller, which are relevant for the authorization
+type Claims struct {
+	jwt.RegisteredClaims
+
+	// Scopes contains the scopes of the token. They are either contained in the space-separated "scope" claim
+	// (RFC 8693) or in the "scp" claim as an array.
+	Scopes []string `json:"-"`
+
+	// Roles contains the roles of the caller. They are either contained in the "roles" claim or, if the token is
+	// issued by Keycloak, in the "realm_access" claim.
+	Roles []string `json:"-"`
+
+	// Services contains the IDs of the cloud services, to which the roles of the caller apply
+	Services []string `json:"-"`
+
+	// Tenant is the tenant, i.e., the organisation, of the caller. It is contained in the "tenant" claim.
+	Tenant string `json:"-"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler to collect the scopes and roles of the different claims
+func (c *Claims) UnmarshalJSON(b []byte) (err error) {
+	var raw struct {
+		Scope       string   `json:"scope"`
+		Scp         []string `json:"scp"`
+		Roles       []string `json:"roles"`
+		Services    []string `json:"services"`
+		Tenant      string   `json:"tenant"`
+		RealmAccess struct {
+			Roles []string `json:"roles"`
+		} `json:"realm_access"`
+	}
+
+	if err = json.Unmarshal(b, &c.RegisteredClaims); err != nil {
+		return
+	}
+	if err = json.Unmarshal(b, &raw); err != nil {
+		return
+	}
+
+	c.Scopes = append(strings.Fields(raw.Scope), raw.Scp...)
+	c.Roles = append(raw.Roles, raw.RealmAccess.Roles...)
+	c.Services = raw.Services
+	c.Tenant = raw.Tenant
+
+	return nil
+}
+
+// HasScope returns true, if the claims contain the scope
+func (c *Claims) HasScope(scope string) bool {
+	if c == nil {
+		return false
+	}
+
+	for _, s := range c.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasRole returns true, if the claims contain the role
+func (c *Claims) HasRole(role string) bool {
+	if c == nil {
+		return false
+	}
+
+	for _, r := range c.Roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ClaimsFromContext returns the claims of the caller of an RPC or nil, if the caller is not authenticated. The claims
+// are only available, if the ClaimsUnaryInterceptor or ClaimsStreamInterceptor is installed.
+func ClaimsFromContext(ctx context.Context) *Claims {
+	if ctx == nil {
+		return nil
+	}
+
+	claims, _ := ctx.Value(claimsContextKey{}).(*Claims)
+	return claims
+}
+
+// ContextWithClaims returns a copy of the context containing the claims
+func ContextWithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsContextKey{}, claims)
+}
+
+// claimsFromMetadata parses the claims of the bearer token of the incoming request. The signature of the token is not
+// verified again, so the interceptors need to be installed after the authentication interceptor.
+func claimsFromMetadata(ctx context.Context) context.Context {
+	token, err := grpc_auth.AuthFromMD(ctx, "bearer")
+	if err != nil {
+		return ctx
+	}
+
+	claims := new(Claims)
+	if _, _, err = jwt.NewParser().ParseUnverified(token, claims); err != nil {
+		return ctx
+	}
+
+	return ContextWithClaims(ctx, claims)
+}
+
+// ClaimsUnaryInterceptor stores the claims of the (already verified) token of the caller in the context, where they
+// can be retrieved using ClaimsFromContext
+func ClaimsUnaryInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	return handler(claimsFromMetadata(ctx), req)
+}
+
+// ClaimsStreamInterceptor is the equivalent of ClaimsUnaryInterceptor for streams
+func ClaimsStreamInterceptor(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	wrapped := grpc_middleware.WrapServerStream(ss)
+	wrapped.WrappedContext = claimsFromMetadata(ss.Context())
+
+	return handler(srv, wrapped)
+}
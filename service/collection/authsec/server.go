@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
 	clapi "clouditor.io/clouditor/api"
 	"clouditor.io/clouditor/voc"
@@ -42,6 +43,7 @@ import (
 	"github.com/eclipse-xfsc/cam/internal/config"
 	"github.com/eclipse-xfsc/cam/internal/protobuf"
 	"github.com/eclipse-xfsc/cam/service"
+	servicecollection "github.com/eclipse-xfsc/cam/service/collection"
 )
 
 var (
@@ -54,6 +56,7 @@ type Server struct {
 	streams    *clapi.StreamsOf[evaluation.Evaluation_SendEvidencesClient, *common.Evidence]
 	grpcOpts   []grpc.DialOption
 	authorizer clapi.Authorizer
+	signer     *servicecollection.Signer
 }
 
 // WithAdditionalGRPCOpts is an option to configure additional gRPC options.
@@ -63,6 +66,13 @@ func WithAdditionalGRPCOpts(opts ...grpc.DialOption) service.ServiceOption[Serve
 	}
 }
 
+// WithSigner is an option to sign the evidences with the signer, so that the Evaluation Manager can verify them
+func WithSigner(signer *servicecollection.Signer) service.ServiceOption[Server] {
+	return func(s *Server) {
+		s.signer = signer
+	}
+}
+
 // WithOAuth2Authorizer is an option to use an OAuth 2.0 authorizer
 func WithOAuth2Authorizer(config *clientcredentials.Config) service.ServiceOption[Server] {
 	return func(srv *Server) {
@@ -224,50 +234,75 @@ func checkConfiguration(req *collection.StartCollectingRequest) (config *collect
 }
 
 // handleCollectionRequest runs the actual Collection Request, after the initial sanity checks.
-// If any problems arise, an error is reported to the evaluation manager
+// If any problems arise, an error is reported to the evaluation manager. All evidences are assigned to the tenant and
+// are part of the trace in ctx.
 // TODO(oxisto): EnqueueEvidences should be used instead or adapted
-func handleCollectionRequest(serviceId string,
+func handleCollectionRequest(ctx context.Context, serviceId string, tenantId string, runId string,
 	evidenceStream *clapi.StreamChannelOf[evaluation.Evaluation_SendEvidencesClient, *common.Evidence],
-	config *collection.AuthenticationSecurityConfig) {
+	signer *servicecollection.Signer, config *collection.AuthenticationSecurityConfig) {
 	var err error
 	var evidence *common.Evidence
+	var start time.Time
+
+	ctx, done := servicecollection.TrackCollection(ctx, ComponentID, runId)
+	defer done()
+
+	send := func(evidence *common.Evidence) {
+		evidence.TenantId = tenantId
+		enqueueEvidence(ctx, evidenceStream, signer, evidence)
+	}
+
+	// Secrets can be referenced instead of being contained in the configuration. If they cannot be resolved, no
+	// evidences can be collected.
+	if _, err = servicecollection.ResolveSecretRefs(ctx, serviceId, config); err != nil {
+		send(servicecollection.InvalidConfigurationEvidence(ComponentID, serviceId, config.Issuer, err))
+		return
+	}
 
 	// In any case, we are collecting evidence about the OAuth 2.0 endpoint
+	start = time.Now()
 	evidence, err = collectOAuth2Evidence(serviceId, config)
+	servicecollection.ObserveDiscoverer(ComponentID, "oauth2", start, err)
 	if err != nil {
 		err = fmt.Errorf("internal error while collecting OAuth2.0 evidence: %w", err)
 		log.Error(err)
 		return
 	}
-	enqueueEvidence(evidenceStream, evidence)
+	send(evidence)
 
 	// Optionally, we are also gathering evidence about the API endpoint and whether it is protected
 	if config.ApiEndpoint != "" {
+		start = time.Now()
 		evidence, err = collectAPIAccessEvidence(serviceId, config)
+		servicecollection.ObserveDiscoverer(ComponentID, "api", start, err)
 		if err != nil {
 			err = fmt.Errorf("internal error while collecting OAuth2.0 evidence: %w", err)
 			log.Error(err)
 			return
 		}
-		enqueueEvidence(evidenceStream, evidence)
+		send(evidence)
 	}
 }
 
 // TODO(oxisto): Migrate this to the already existing collection.EnqueueEvidence, once we migrate the evidence value to
 // the ontology.
-func enqueueEvidence(evidenceStream *clapi.StreamChannelOf[evaluation.Evaluation_SendEvidencesClient, *common.Evidence], evidence *common.Evidence) {
+func enqueueEvidence(ctx context.Context, evidenceStream *clapi.StreamChannelOf[evaluation.Evaluation_SendEvidencesClient, *common.Evidence],
+	signer *servicecollection.Signer, evidence *common.Evidence) {
 	if evidence.Error != nil {
 		log.Warnf("Reporting an error: %s", evidence.Error.Description)
 	} else {
 		log.Tracef("Sending '%v' to Evaluation Manager", evidence.Value)
 	}
 
-	evidenceStream.Send(evidence)
+	if err := servicecollection.SignAndSend(ctx, evidenceStream, signer, evidence); err != nil {
+		log.Errorf("Could not send evidence %s: %v", evidence.Id, err)
+		return
+	}
 
 	log.Infof("Sent evidence {id: %s, target_resource: %s } to evaluation manager", evidence.Id, evidence.TargetResource)
 }
 
-func (s *Server) StartCollecting(_ context.Context, req *collection.StartCollectingRequest) (*collection.StartCollectingResponse, error) {
+func (s *Server) StartCollecting(ctx context.Context, req *collection.StartCollectingRequest) (*collection.StartCollectingResponse, error) {
 	log.Infof("Received StartCollecting Request for Service ID '%v'", req.ServiceId)
 	// Parse and check configuration data
 	// If problems are detected at this stage, they are returned to the caller
@@ -291,14 +326,49 @@ func (s *Server) StartCollecting(_ context.Context, req *collection.StartCollect
 	// Handle the actual request in a separate goroutine
 	// StartCollecting will return and later collection problems are reported to the evaluation manager
 
-	go handleCollectionRequest(req.ServiceId, evidenceStream, config)
+	go handleCollectionRequest(ctx, req.ServiceId, req.TenantId, req.RunId, evidenceStream, s.signer, config)
 	return &collection.StartCollectingResponse{Id: requestId}, nil
 }
 
+// TestCollection checks the configuration, resolves its secret references and retrieves the metadata of the
+// authorization server, without collecting any evidences
+func (s *Server) TestCollection(ctx context.Context, req *collection.TestCollectionRequest) (
+	*collection.TestCollectionResponse, error) {
+	log.Infof("Received TestCollection Request for Service ID '%v'", req.ServiceId)
+
+	if err := req.Validate(); err != nil {
+		return nil, grpcstatus.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	config, err := checkConfiguration(req.StartCollectingRequest())
+	if err != nil {
+		return nil, grpcstatus.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	resolved, err := servicecollection.ResolveSecretRefs(ctx, req.ServiceId, config)
+	if err != nil {
+		return nil, grpcstatus.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	if _, errStruct := getAndValidateMetadata(config); errStruct != nil {
+		return nil, grpcstatus.Errorf(codes.FailedPrecondition, "%s", errStruct.Description)
+	}
+
+	return &collection.TestCollectionResponse{
+		Checks: append(servicecollection.ResolvedChecks(resolved),
+			fmt.Sprintf("retrieved authorization server metadata of %s", config.Issuer)),
+	}, nil
+}
+
 func (s *Server) StopCollecting(_ context.Context, _ *collection.StopCollectingRequest) (*emptypb.Empty, error) {
 	return nil, grpcstatus.Error(codes.Unimplemented, "StopCollection not implemented")
 }
 
+// Describe returns the metrics and the configuration type supported by this collection module
+func (*Server) Describe(_ context.Context, _ *collection.DescribeRequest) (*collection.DescribeResponse, error) {
+	return servicecollection.Describe(&collection.AuthenticationSecurityConfig{}, "OAuthGrantTypes", "APIOAuthProtected"), nil
+}
+
 // collectOAuth2Evidence collects evidence about an OAuth 2.0 authorization server, such as metadata, grant types, and
 // such.
 func collectOAuth2Evidence(serviceID string, config *collection.AuthenticationSecurityConfig) (evidence *common.Evidence, err error) {
@@ -0,0 +1,213 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package certification
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	SchemeISO27001 = "ISO27001"
+	SchemeC5       = "C5"
+	SchemeEUCS     = "EUCS"
+)
+
+var (
+	// ErrRegistryUnavailable is returned, if the certificate registry could not be retrieved
+	ErrRegistryUnavailable = errors.New("certificate registry is unavailable")
+	// ErrMalformedRegistry is returned, if the certificate registry could not be parsed
+	ErrMalformedRegistry = errors.New("certificate registry is malformed")
+	// ErrMalformedDocument is returned, if a certificate document is not a valid JWS
+	ErrMalformedDocument = errors.New("certificate document is malformed")
+)
+
+// Registry is a certificate registry, such as the registry of a certification body or a local stand-in for it. It
+// contains plain certificate entries, which are trusted as they are, as well as signed certificate documents.
+type Registry struct {
+	Certificates []*Certificate `json:"certificates"`
+
+	// Documents contains signed certificate documents in JWS compact serialization
+	Documents []string `json:"documents"`
+
+	// Revoked contains the IDs of revoked certificates
+	Revoked []string `json:"revoked"`
+}
+
+// Certificate is a cyber security certificate, e.g., an ISO 27001 certificate or a C5 attestation
+type Certificate struct {
+	ID         string    `json:"id"`
+	Scheme     string    `json:"scheme"`
+	Level      string    `json:"level,omitempty"`
+	Provider   string    `json:"provider"`
+	Issuer     string    `json:"issuer,omitempty"`
+	Scope      []string  `json:"scope,omitempty"`
+	ValidFrom  time.Time `json:"validFrom"`
+	ValidUntil time.Time `json:"validUntil"`
+
+	// Status is the status in the registry, e.g., "active", "suspended", "withdrawn" or "revoked"
+	Status string `json:"status,omitempty"`
+}
+
+// documentClaims are the claims of a signed certificate document
+type documentClaims struct {
+	jwt.RegisteredClaims
+	Certificate Certificate `json:"certificate"`
+}
+
+// signedCertificate is a certificate together with the result of its signature verification
+type signedCertificate struct {
+	*Certificate
+
+	// signed is true, if the certificate was taken from a signed certificate document
+	signed bool
+
+	// signer is the trusted issuer certificate that verified the signature. It is nil, if the signature could not be
+	// verified.
+	signer *x509.Certificate
+}
+
+// loadRegistry loads the certificate registry from a local file or an HTTP(S) URL.
+func loadRegistry(client *http.Client, location string) (r *Registry, err error) {
+	var b []byte
+
+	if strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://") {
+		b, err = fetch(client, location)
+	} else {
+		b, err = os.ReadFile(strings.TrimPrefix(location, "file://"))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrRegistryUnavailable, err)
+	}
+
+	r = new(Registry)
+	if err = json.Unmarshal(b, r); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedRegistry, err)
+	}
+
+	return
+}
+
+func fetch(client *http.Client, url string) (b []byte, err error) {
+	res, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+
+	return io.ReadAll(res.Body)
+}
+
+// parseDocument parses a signed certificate document and verifies its signature against the trusted issuers. A
+// document with an invalid signature is still returned, so that it can be reported as invalid. The issuer of the
+// certificate is the subject of the trusted issuer certificate, since the issuer declared in the document itself is
+// not verified.
+func parseDocument(document string, issuers []*x509.Certificate) (c *signedCertificate, err error) {
+	var claims documentClaims
+
+	_, _, err = jwt.NewParser().ParseUnverified(document, &claims)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedDocument, err)
+	}
+
+	c = &signedCertificate{Certificate: &claims.Certificate, signed: true}
+
+	// Fall back to the registered claims, if the certificate does not specify them explicitly
+	if c.ValidFrom.IsZero() && claims.NotBefore != nil {
+		c.ValidFrom = claims.NotBefore.Time
+	}
+	if c.ValidUntil.IsZero() && claims.ExpiresAt != nil {
+		c.ValidUntil = claims.ExpiresAt.Time
+	}
+
+	// The validity periods of the certificate and of the issuer certificate are checked separately, so that we can
+	// report them as such
+	c.Issuer = ""
+	parser := jwt.NewParser(jwt.WithoutClaimsValidation())
+	for _, issuer := range issuers {
+		_, err = parser.Parse(document, func(_ *jwt.Token) (interface{}, error) {
+			return issuer.PublicKey, nil
+		})
+		if err == nil {
+			c.signer = issuer
+			c.Issuer = issuer.Subject.String()
+			break
+		}
+	}
+
+	return c, nil
+}
+
+// ParseCertificates parses the PEM-encoded certificates of trusted issuers of certificate documents.
+func ParseCertificates(data []byte) (certs []*x509.Certificate, err error) {
+	var (
+		block *pem.Block
+		cert  *x509.Certificate
+	)
+
+	for {
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err = x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse certificate: %w", err)
+		}
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, errors.New("no PEM-encoded certificate found")
+	}
+
+	return
+}
+
+// normalizeScheme converts the different spellings of a certification scheme, such as "ISO/IEC 27001" or "BSI C5",
+// into one of SchemeISO27001, SchemeC5 or SchemeEUCS. Unknown schemes are returned in upper case.
+func normalizeScheme(scheme string) string {
+	s := strings.ToUpper(strings.NewReplacer(" ", "", "-", "", "/", "", "_", "").Replace(scheme))
+
+	switch {
+	case strings.Contains(s, "27001"):
+		return SchemeISO27001
+	case s == "C5" || s == "BSIC5":
+		return SchemeC5
+	case strings.HasPrefix(s, "EUCS"):
+		return SchemeEUCS
+	default:
+		return s
+	}
+}
@@ -0,0 +1,167 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package certification
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockIssuer is a certification body, which signs certificate documents
+type mockIssuer struct {
+	key  *ecdsa.PrivateKey
+	cert *x509.Certificate
+}
+
+func newMockIssuer(t *testing.T, name string) *mockIssuer {
+	return newMockIssuerValidUntil(t, name, time.Now().Add(time.Hour))
+}
+
+// newMockIssuerValidUntil creates a certification body, whose certificate expires at notAfter
+func newMockIssuerValidUntil(t *testing.T, name string, notAfter time.Time) *mockIssuer {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	tmpl := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    notAfter.Add(-2 * time.Hour),
+		NotAfter:     notAfter,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &tmpl, &tmpl, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+
+	return &mockIssuer{key: key, cert: cert}
+}
+
+func (i *mockIssuer) sign(t *testing.T, c Certificate) string {
+	doc, err := jwt.NewWithClaims(jwt.SigningMethodES256, documentClaims{
+		RegisteredClaims: jwt.RegisteredClaims{Issuer: i.cert.Subject.CommonName},
+		Certificate:      c,
+	}).SignedString(i.key)
+	assert.NoError(t, err)
+
+	return doc
+}
+
+func Test_parseDocument(t *testing.T) {
+	var (
+		issuer = newMockIssuer(t, "Certification Body")
+		other  = newMockIssuer(t, "Someone Else")
+		cert   = Certificate{ID: "cert1", Scheme: "ISO 27001", Provider: "provider1"}
+	)
+
+	type args struct {
+		document string
+		issuers  []*x509.Certificate
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantSigner *x509.Certificate
+		wantIssuer string
+		wantErr    assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "Malformed document",
+			args:    args{document: "not a jws", issuers: []*x509.Certificate{issuer.cert}},
+			wantErr: assert.Error,
+		},
+		{
+			name:       "Signed by trusted issuer",
+			args:       args{document: issuer.sign(t, cert), issuers: []*x509.Certificate{other.cert, issuer.cert}},
+			wantSigner: issuer.cert,
+			wantIssuer: "CN=Certification Body",
+			wantErr:    assert.NoError,
+		},
+		{
+			name:       "Signed by untrusted issuer",
+			args:       args{document: other.sign(t, cert), issuers: []*x509.Certificate{issuer.cert}},
+			wantSigner: nil,
+			wantIssuer: "",
+			wantErr:    assert.NoError,
+		},
+		{
+			name: "Self-declared issuer is not reported",
+			args: args{
+				document: other.sign(t, Certificate{ID: "cert1", Provider: "provider1", Issuer: "Certification Body"}),
+				issuers:  []*x509.Certificate{issuer.cert},
+			},
+			wantSigner: nil,
+			wantIssuer: "",
+			wantErr:    assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseDocument(tt.args.document, tt.args.issuers)
+			tt.wantErr(t, err)
+			if err != nil {
+				assert.ErrorIs(t, err, ErrMalformedDocument)
+				return
+			}
+
+			assert.True(t, got.signed)
+			assert.Equal(t, tt.wantSigner, got.signer)
+			assert.Equal(t, tt.wantIssuer, got.Issuer)
+			assert.Equal(t, "cert1", got.ID)
+		})
+	}
+}
+
+func TestParseCertificates(t *testing.T) {
+	issuer := newMockIssuer(t, "Certification Body")
+
+	certs, err := ParseCertificates(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: issuer.cert.Raw}))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(certs))
+
+	_, err = ParseCertificates([]byte("no certificate"))
+	assert.Error(t, err)
+}
+
+func Test_normalizeScheme(t *testing.T) {
+	tests := []struct {
+		scheme string
+		want   string
+	}{
+		{scheme: "ISO/IEC 27001", want: SchemeISO27001},
+		{scheme: "iso27001", want: SchemeISO27001},
+		{scheme: "BSI C5", want: SchemeC5},
+		{scheme: "c5", want: SchemeC5},
+		{scheme: "EUCS-high", want: SchemeEUCS},
+		{scheme: "SOC 2", want: "SOC2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.scheme, func(t *testing.T) {
+			assert.Equal(t, tt.want, normalizeScheme(tt.scheme))
+		})
+	}
+}
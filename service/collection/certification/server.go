@@ -0,0 +1,467 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+// Package certification contains service specific code for the Cyber Security Certification Collection Module
+package certification
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	clapi "clouditor.io/clouditor/api"
+	"clouditor.io/clouditor/voc"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/oauth2/clientcredentials"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eclipse-xfsc/cam/api"
+	"github.com/eclipse-xfsc/cam/api/collection"
+	"github.com/eclipse-xfsc/cam/api/common"
+	"github.com/eclipse-xfsc/cam/api/evaluation"
+	"github.com/eclipse-xfsc/cam/internal/config"
+	"github.com/eclipse-xfsc/cam/internal/protobuf"
+	"github.com/eclipse-xfsc/cam/service"
+	servicecollection "github.com/eclipse-xfsc/cam/service/collection"
+)
+
+// DefaultRegistryTimeout is the default timeout for retrieving an HTTP certificate registry
+const DefaultRegistryTimeout = 10 * time.Second
+
+var (
+	log         = logrus.WithField("service", "collection-certification")
+	ComponentID = config.DefaultCollectionCertificationID
+)
+
+type Server struct {
+	collection.UnimplementedCollectionServer
+	streams  *clapi.StreamsOf[evaluation.Evaluation_SendEvidencesClient, *common.Evidence]
+	grpcOpts []grpc.DialOption
+
+	// registry is the default certificate registry, which is used if a service configuration does not specify one
+	registry string
+
+	// issuers contains the certificates of the trusted issuers of signed certificate documents
+	issuers []*x509.Certificate
+
+	client     *http.Client
+	authorizer clapi.Authorizer
+	signer     *servicecollection.Signer
+}
+
+// WithAdditionalGRPCOpts is an option to configure additional gRPC options.
+func WithAdditionalGRPCOpts(opts ...grpc.DialOption) service.ServiceOption[Server] {
+	return func(s *Server) {
+		s.grpcOpts = opts
+	}
+}
+
+// WithRegistry is an option to configure the default certificate registry (a local file or an HTTP(S) URL).
+func WithRegistry(location string) service.ServiceOption[Server] {
+	return func(s *Server) {
+		s.registry = location
+	}
+}
+
+// WithTrustedIssuers is an option to configure the certificates of the trusted issuers of signed certificate
+// documents.
+func WithTrustedIssuers(certs ...*x509.Certificate) service.ServiceOption[Server] {
+	return func(s *Server) {
+		s.issuers = certs
+	}
+}
+
+// WithHTTPClient is an option to configure the HTTP client that is used to retrieve HTTP certificate registries.
+func WithHTTPClient(client *http.Client) service.ServiceOption[Server] {
+	return func(s *Server) {
+		s.client = client
+	}
+}
+
+// WithSigner is an option to sign the evidences with the signer, so that the Evaluation Manager can verify them
+func WithSigner(signer *servicecollection.Signer) service.ServiceOption[Server] {
+	return func(s *Server) {
+		s.signer = signer
+	}
+}
+
+// WithOAuth2Authorizer is an option to use an OAuth 2.0 authorizer
+func WithOAuth2Authorizer(config *clientcredentials.Config) service.ServiceOption[Server] {
+	return func(srv *Server) {
+		srv.SetAuthorizer(clapi.NewOAuthAuthorizerFromClientCredentials(config))
+	}
+}
+
+// SetAuthorizer implements UsesAuthorizer
+func (srv *Server) SetAuthorizer(auth clapi.Authorizer) {
+	srv.authorizer = auth
+}
+
+// Authorizer implements UsesAuthorizer
+func (srv *Server) Authorizer() clapi.Authorizer {
+	return srv.authorizer
+}
+
+func NewServer(opts ...service.ServiceOption[Server]) collection.CollectionServer {
+	s := &Server{
+		streams: clapi.NewStreamsOf(clapi.WithLogger[evaluation.Evaluation_SendEvidencesClient, *common.Evidence](log)),
+		client:  &http.Client{Timeout: DefaultRegistryTimeout},
+	}
+
+	// Apply any options
+	for _, o := range opts {
+		o(s)
+	}
+
+	return s
+}
+
+// checkConfiguration performs some basic input validation on the configuration data sent with the request. Problems
+// with the registry or the certificate documents themselves are reported to the evaluation manager instead.
+func (s *Server) checkConfiguration(req *collection.StartCollectingRequest) (
+	config *collection.CyberSecurityCertificationConfig, err error) {
+	if req.Configuration == nil {
+		err = errors.New("configuration is missing")
+		return
+	}
+	if req.Configuration.RawConfiguration == nil {
+		err = errors.New("RawConfiguration in Configuration is missing")
+		return
+	}
+
+	config = new(collection.CyberSecurityCertificationConfig)
+	if err = req.Configuration.RawConfiguration.UnmarshalTo(config); err != nil {
+		err = errors.New("RawConfiguration is not a Cyber Security Certification Config")
+		return
+	}
+
+	if config.Provider == "" {
+		err = errors.New("missing required config parameter: provider")
+		return
+	}
+
+	for _, scheme := range config.Schemes {
+		switch normalizeScheme(scheme) {
+		case SchemeISO27001, SchemeC5, SchemeEUCS:
+		default:
+			err = fmt.Errorf("unsupported certification scheme: %s", scheme)
+			return
+		}
+	}
+
+	if config.Registry == "" {
+		config.Registry = s.registry
+	}
+	if config.Registry == "" && len(config.CertificateDocuments) == 0 {
+		err = errors.New("neither a certificate registry nor certificate documents are configured")
+		return
+	}
+	if strings.HasPrefix(config.Registry, "http") {
+		if _, err = url.ParseRequestURI(config.Registry); err != nil {
+			err = fmt.Errorf("error parsing registry: %w", err)
+			return
+		}
+	}
+
+	return
+}
+
+func (s *Server) StartCollecting(ctx context.Context, req *collection.StartCollectingRequest) (
+	*collection.StartCollectingResponse, error) {
+	log.Infof("Received StartCollecting Request for Service ID '%v'", req.ServiceId)
+
+	// Parse and check configuration data. If problems are detected at this stage, they are returned to the caller
+	// instead of being forwarded to the evaluation manager
+	config, err := s.checkConfiguration(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	// Get stream for the Evaluation Manager
+	component := "Evaluation Manager"
+	stream, err := s.streams.GetStream(req.EvalManager, component, api.InitEvalStream,
+		clapi.DefaultGrpcDialOptions(req.EvalManager, s, s.grpcOpts...)...)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Could not connect to Eval Manager: %v", err)
+	}
+
+	// Check the certificates in a separate goroutine. StartCollecting will return and later collection problems are
+	// reported to the evaluation manager
+	ctx, done := servicecollection.TrackCollection(ctx, ComponentID, req.RunId)
+	go func() {
+		defer done()
+
+		evidence := s.collect(req.ServiceId, config, time.Now())
+		evidence.TenantId = req.TenantId
+		if err := servicecollection.SignAndSend(ctx, stream, s.signer, evidence); err != nil {
+			log.Errorf("Could not send evidence %s: %v", evidence.Id, err)
+			return
+		}
+		log.Infof("Sent evidence {id: %s, target_resource: %s } to evaluation manager", evidence.Id,
+			evidence.TargetResource)
+	}()
+
+	return &collection.StartCollectingResponse{Id: uuid.NewString()}, nil
+}
+
+// TestCollection checks the configuration and loads the certificates of the provider from the registry and the
+// certificate documents, without collecting any evidences
+func (s *Server) TestCollection(_ context.Context, req *collection.TestCollectionRequest) (
+	*collection.TestCollectionResponse, error) {
+	log.Infof("Received TestCollection Request for Service ID '%v'", req.ServiceId)
+
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	config, err := s.checkConfiguration(req.StartCollectingRequest())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	certs, _, err := s.certificates(config)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "could not load certificates: %v", err)
+	}
+
+	return &collection.TestCollectionResponse{
+		Checks: []string{fmt.Sprintf("loaded %d certificate(s) of provider %s", len(certs), config.Provider)},
+	}, nil
+}
+
+func (s *Server) StopCollecting(_ context.Context, _ *collection.StopCollectingRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "StopCollection not implemented")
+}
+
+// Describe returns the metrics and the configuration type supported by this collection module
+func (*Server) Describe(_ context.Context, _ *collection.DescribeRequest) (*collection.DescribeResponse, error) {
+	return servicecollection.Describe(&collection.CyberSecurityCertificationConfig{}, "CyberSecurityCertification"), nil
+}
+
+// collect checks the certificates of the configured provider and creates the corresponding evidence. If the registry
+// could not be retrieved or parsed, the evidence contains the error.
+func (s *Server) collect(serviceID string, config *collection.CyberSecurityCertificationConfig, now time.Time) (
+	evidence *common.Evidence) {
+	evidenceID := uuid.NewString()
+	evidence = &common.Evidence{
+		Id:             evidenceID,
+		Name:           evidenceID,
+		TargetService:  serviceID,
+		TargetResource: config.Provider,
+		GatheredAt:     timestamppb.Now(),
+		ToolId:         ComponentID,
+	}
+
+	start := time.Now()
+	certs, revoked, err := s.certificates(config)
+	servicecollection.ObserveDiscoverer(ComponentID, "registry", start, err)
+	if errors.Is(err, ErrRegistryUnavailable) {
+		evidence.Error = &common.Error{Code: common.Error_ERROR_CONNECTION_FAILURE, Description: err.Error()}
+		return
+	} else if err != nil {
+		evidence.Error = &common.Error{Code: common.Error_ERROR_PROTOCOL_VIOLATION, Description: err.Error()}
+		return
+	}
+
+	result := &CyberSecurityCertification{Status: StatusNotAvailable, Certificates: []CertificateResult{}}
+	for _, c := range certs {
+		if !accepted(c.Certificate, config.Schemes) {
+			continue
+		}
+
+		r := verify(c, config.Provider, config.Scope, revoked, now)
+		result.Certificates = append(result.Certificates, r)
+
+		if r.Valid {
+			result.Status = StatusValid
+		} else if result.Status != StatusValid {
+			result.Status = StatusInvalid
+		}
+	}
+
+	rawEvidence, err := json.Marshal(result)
+	if err != nil {
+		log.Errorf("Failed to marshal certification result: %v", err)
+	} else {
+		evidence.RawEvidence = string(rawEvidence)
+	}
+
+	evidence.Value, err = protobuf.ToValue(Value{
+		Resource: voc.Resource{
+			// ID and Type has to be set. Otherwise, evaluation will fail due to evidence validation
+			ID:   voc.ResourceID(config.Provider),
+			Type: []string{"CyberSecurityCertification"},
+		},
+		CyberSecurityCertification: result,
+	})
+	if err != nil {
+		log.Errorf("Could not convert evidence value: %v", err)
+		evidence.Error = &common.Error{Code: common.Error_ERROR_UNKNOWN, Description: err.Error()}
+	}
+
+	return
+}
+
+// certificates returns the certificates of the provider, taken from the registry and the certificate documents of
+// the configuration, as well as the IDs of the revoked certificates.
+func (s *Server) certificates(config *collection.CyberSecurityCertificationConfig) (
+	certs []*signedCertificate, revoked map[string]bool, err error) {
+	var (
+		registry = &Registry{}
+		c        *signedCertificate
+	)
+
+	if config.Registry != "" {
+		registry, err = loadRegistry(s.client, config.Registry)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	revoked = make(map[string]bool)
+	for _, id := range registry.Revoked {
+		revoked[id] = true
+	}
+
+	for _, c := range registry.Certificates {
+		if c.Provider == config.Provider {
+			certs = append(certs, &signedCertificate{Certificate: c})
+		}
+	}
+
+	// Documents of the registry might belong to any provider, whereas documents of the configuration are declared by
+	// the provider itself. Malformed documents of the registry are ignored, since we cannot tell whom they belong to.
+	for _, doc := range registry.Documents {
+		if c, err = parseDocument(doc, s.issuers); err != nil {
+			log.Warnf("Ignoring certificate document of registry %s: %v", config.Registry, err)
+			continue
+		}
+		if c.Provider == config.Provider {
+			certs = append(certs, c)
+		}
+	}
+
+	for _, doc := range config.CertificateDocuments {
+		if c, err = parseDocument(doc, s.issuers); err != nil {
+			return nil, nil, err
+		}
+		// Documents without a provider are attributed to the declaring provider. Documents of other providers are
+		// kept, so that verify marks them as invalid rather than silently dropping them.
+		if c.Provider == "" {
+			c.Provider = config.Provider
+		}
+		certs = append(certs, c)
+	}
+
+	return certs, revoked, nil
+}
+
+// accepted returns whether the scheme of the certificate is one of the accepted schemes. All schemes are accepted, if
+// none are given.
+func accepted(c *Certificate, schemes []string) bool {
+	if len(schemes) == 0 {
+		return true
+	}
+
+	for _, scheme := range schemes {
+		if normalizeScheme(scheme) == normalizeScheme(c.Scheme) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// verify verifies the provider, signature, validity period, scope and revocation status of a certificate. The
+// signature is only trusted within the validity period of the certificate of its issuer.
+func verify(c *signedCertificate, provider string, scope []string, revoked map[string]bool, now time.Time) (
+	r CertificateResult) {
+	r = CertificateResult{
+		ID:     c.ID,
+		Scheme: normalizeScheme(c.Scheme),
+		Level:  c.Level,
+		Issuer: c.Issuer,
+		Signed: c.signed,
+	}
+	if !c.ValidUntil.IsZero() {
+		r.ValidUntil = c.ValidUntil.Format(time.RFC3339)
+	}
+
+	if c.Provider != provider {
+		r.Details = append(r.Details, fmt.Sprintf("certificate belongs to provider '%s'", c.Provider))
+	}
+
+	switch {
+	case !c.signed:
+	case c.signer == nil:
+		r.Details = append(r.Details, "signature of certificate document could not be verified with a trusted issuer")
+	case now.After(c.signer.NotAfter):
+		r.Details = append(r.Details, fmt.Sprintf("certificate of issuer '%s' expired on %s", c.Issuer,
+			c.signer.NotAfter.Format(time.RFC3339)))
+	case now.Before(c.signer.NotBefore):
+		r.Details = append(r.Details, fmt.Sprintf("certificate of issuer '%s' is not valid before %s", c.Issuer,
+			c.signer.NotBefore.Format(time.RFC3339)))
+	}
+
+	switch {
+	case c.ValidUntil.IsZero():
+		r.Details = append(r.Details, "certificate has no expiration date")
+	case now.After(c.ValidUntil):
+		r.Details = append(r.Details, fmt.Sprintf("certificate expired on %s", r.ValidUntil))
+	case now.Before(c.ValidFrom):
+		r.Details = append(r.Details, fmt.Sprintf("certificate is not valid before %s", c.ValidFrom.Format(time.RFC3339)))
+	}
+
+	for _, s := range scope {
+		if !covers(c.Scope, s) {
+			r.Details = append(r.Details, fmt.Sprintf("scope '%s' is not covered by the certificate", s))
+		}
+	}
+
+	switch strings.ToLower(c.Status) {
+	case "revoked", "suspended", "withdrawn":
+		r.Details = append(r.Details, fmt.Sprintf("certificate is %s", strings.ToLower(c.Status)))
+	default:
+		if revoked[c.ID] {
+			r.Details = append(r.Details, "certificate is revoked")
+		}
+	}
+
+	r.Valid = len(r.Details) == 0
+
+	return
+}
+
+// covers returns whether the scope of a certificate covers the required scope. A wildcard covers any scope.
+func covers(certScope []string, required string) bool {
+	for _, s := range certScope {
+		if s == "*" || strings.EqualFold(s, required) {
+			return true
+		}
+	}
+
+	return false
+}
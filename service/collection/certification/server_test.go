@@ -0,0 +1,330 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package certification
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/eclipse-xfsc/cam/api/collection"
+	"github.com/eclipse-xfsc/cam/api/common"
+	"github.com/eclipse-xfsc/cam/internal/testutil/testevaluation"
+	"github.com/eclipse-xfsc/cam/internal/testutil/testproto"
+)
+
+func TestMain(m *testing.M) {
+	// Mock the Evaluation Manager to stream evidences to
+	evaluationManagerServer, _, _ := testevaluation.StartBufConnServerToEvaluation()
+
+	code := m.Run()
+
+	evaluationManagerServer.Stop()
+
+	os.Exit(code)
+}
+
+// newRegistryServer starts an HTTP stand-in for a certificate registry
+func newRegistryServer(t *testing.T, registry *Registry) *httptest.Server {
+	b, err := json.Marshal(registry)
+	assert.NoError(t, err)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/registry.json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = w.Write(b)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestServer_checkConfiguration(t *testing.T) {
+	type fields struct {
+		registry string
+	}
+	tests := []struct {
+		name         string
+		fields       fields
+		config       *collection.CyberSecurityCertificationConfig
+		wantRegistry string
+		wantErr      assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "Missing provider",
+			config:  &collection.CyberSecurityCertificationConfig{Registry: "registry.json"},
+			wantErr: assert.Error,
+		},
+		{
+			name: "Unsupported scheme",
+			config: &collection.CyberSecurityCertificationConfig{
+				Provider: "provider1", Registry: "registry.json", Schemes: []string{"SOC 2"},
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name:    "Neither registry nor documents",
+			config:  &collection.CyberSecurityCertificationConfig{Provider: "provider1"},
+			wantErr: assert.Error,
+		},
+		{
+			name:         "Default registry",
+			fields:       fields{registry: "http://localhost/registry.json"},
+			config:       &collection.CyberSecurityCertificationConfig{Provider: "provider1", Schemes: []string{"C5"}},
+			wantRegistry: "http://localhost/registry.json",
+			wantErr:      assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Server{registry: tt.fields.registry}
+
+			got, err := s.checkConfiguration(&collection.StartCollectingRequest{
+				Configuration: &collection.ServiceConfiguration{RawConfiguration: testproto.NewAny(t, tt.config)},
+			})
+			tt.wantErr(t, err)
+			if err == nil {
+				assert.Equal(t, tt.wantRegistry, got.Registry)
+			}
+		})
+	}
+}
+
+func TestServer_collect(t *testing.T) {
+	var (
+		now      = time.Now()
+		issuer   = newMockIssuer(t, "Certification Body")
+		other    = newMockIssuer(t, "Someone Else")
+		expired  = newMockIssuerValidUntil(t, "Former Certification Body", now.Add(-time.Hour))
+		lastYear = now.AddDate(-1, 0, 0)
+		nextYear = now.AddDate(1, 0, 0)
+	)
+
+	registry := &Registry{
+		Certificates: []*Certificate{
+			{ID: "iso", Scheme: "ISO 27001", Provider: "valid", Scope: []string{"*"}, ValidFrom: lastYear, ValidUntil: nextYear},
+			{ID: "expired", Scheme: "C5", Provider: "invalid", ValidFrom: lastYear, ValidUntil: now.AddDate(0, -1, 0)},
+			{ID: "suspended", Scheme: "EUCS", Provider: "invalid", ValidFrom: lastYear, ValidUntil: nextYear, Status: "Suspended"},
+			{ID: "revoked", Scheme: "C5", Provider: "invalid", ValidFrom: lastYear, ValidUntil: nextYear},
+			{ID: "scope", Scheme: "ISO27001", Provider: "scope", Scope: []string{"storage"}, ValidFrom: lastYear, ValidUntil: nextYear},
+		},
+		Documents: []string{
+			issuer.sign(t, Certificate{ID: "signed", Scheme: "C5", Provider: "signed", ValidFrom: lastYear, ValidUntil: nextYear}),
+			other.sign(t, Certificate{ID: "forged", Scheme: "C5", Provider: "forged", ValidFrom: lastYear, ValidUntil: nextYear}),
+			"malformed",
+		},
+		Revoked: []string{"revoked"},
+	}
+	srv := newRegistryServer(t, registry)
+
+	// A local registry file works just like the HTTP registry
+	b, err := json.Marshal(registry)
+	assert.NoError(t, err)
+	file := filepath.Join(t.TempDir(), "registry.json")
+	assert.NoError(t, os.WriteFile(file, b, 0600))
+
+	tests := []struct {
+		name        string
+		config      *collection.CyberSecurityCertificationConfig
+		wantStatus  string
+		wantCerts   int
+		wantDetails []string
+		wantErr     *common.Error
+	}{
+		{
+			name:       "Valid certificate",
+			config:     &collection.CyberSecurityCertificationConfig{Provider: "valid", Registry: srv.URL + "/registry.json", Scope: []string{"compute"}},
+			wantStatus: StatusValid,
+			wantCerts:  1,
+		},
+		{
+			name:       "Local registry",
+			config:     &collection.CyberSecurityCertificationConfig{Provider: "valid", Registry: file},
+			wantStatus: StatusValid,
+			wantCerts:  1,
+		},
+		{
+			name:       "Scheme not accepted",
+			config:     &collection.CyberSecurityCertificationConfig{Provider: "valid", Registry: file, Schemes: []string{"C5"}},
+			wantStatus: StatusNotAvailable,
+		},
+		{
+			name:       "Unknown provider",
+			config:     &collection.CyberSecurityCertificationConfig{Provider: "unknown", Registry: file},
+			wantStatus: StatusNotAvailable,
+		},
+		{
+			name:       "Expired, suspended and revoked certificates",
+			config:     &collection.CyberSecurityCertificationConfig{Provider: "invalid", Registry: file},
+			wantStatus: StatusInvalid,
+			wantCerts:  3,
+			wantDetails: []string{
+				"certificate expired on " + now.AddDate(0, -1, 0).Format(time.RFC3339),
+				"certificate is suspended",
+				"certificate is revoked",
+			},
+		},
+		{
+			name:        "Scope not covered",
+			config:      &collection.CyberSecurityCertificationConfig{Provider: "scope", Registry: file, Scope: []string{"storage", "compute"}},
+			wantStatus:  StatusInvalid,
+			wantCerts:   1,
+			wantDetails: []string{"scope 'compute' is not covered by the certificate"},
+		},
+		{
+			name:       "Signed certificate document of registry",
+			config:     &collection.CyberSecurityCertificationConfig{Provider: "signed", Registry: file},
+			wantStatus: StatusValid,
+			wantCerts:  1,
+		},
+		{
+			name:        "Forged certificate document of registry",
+			config:      &collection.CyberSecurityCertificationConfig{Provider: "forged", Registry: file},
+			wantStatus:  StatusInvalid,
+			wantCerts:   1,
+			wantDetails: []string{"signature of certificate document could not be verified with a trusted issuer"},
+		},
+		{
+			name: "Certificate document declared by provider",
+			config: &collection.CyberSecurityCertificationConfig{
+				Provider: "declared",
+				CertificateDocuments: []string{
+					issuer.sign(t, Certificate{ID: "declared", Scheme: "EUCS", ValidFrom: lastYear, ValidUntil: nextYear}),
+				},
+			},
+			wantStatus: StatusValid,
+			wantCerts:  1,
+		},
+		{
+			name: "Certificate document of another provider declared by provider",
+			config: &collection.CyberSecurityCertificationConfig{
+				Provider: "declared",
+				CertificateDocuments: []string{
+					issuer.sign(t, Certificate{ID: "foreign", Scheme: "EUCS", Provider: "foreign", ValidFrom: lastYear, ValidUntil: nextYear}),
+				},
+			},
+			wantStatus:  StatusInvalid,
+			wantCerts:   1,
+			wantDetails: []string{"certificate belongs to provider 'foreign'"},
+		},
+		{
+			name: "Certificate document signed by expired issuer certificate",
+			config: &collection.CyberSecurityCertificationConfig{
+				Provider: "declared",
+				CertificateDocuments: []string{
+					expired.sign(t, Certificate{ID: "outdated", Scheme: "EUCS", ValidFrom: lastYear, ValidUntil: nextYear}),
+				},
+			},
+			wantStatus: StatusInvalid,
+			wantCerts:  1,
+			wantDetails: []string{"certificate of issuer 'CN=Former Certification Body' expired on " +
+				expired.cert.NotAfter.Format(time.RFC3339)},
+		},
+		{
+			name:    "Malformed certificate document declared by provider",
+			config:  &collection.CyberSecurityCertificationConfig{Provider: "declared", CertificateDocuments: []string{"malformed"}},
+			wantErr: &common.Error{Code: common.Error_ERROR_PROTOCOL_VIOLATION},
+		},
+		{
+			name:    "Registry unavailable",
+			config:  &collection.CyberSecurityCertificationConfig{Provider: "valid", Registry: srv.URL + "/unknown.json"},
+			wantErr: &common.Error{Code: common.Error_ERROR_CONNECTION_FAILURE},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Server{client: http.DefaultClient, issuers: []*x509.Certificate{issuer.cert, expired.cert}}
+
+			evidence := s.collect("service1", tt.config, now)
+			assert.Equal(t, tt.config.Provider, evidence.TargetResource)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr.Code, evidence.Error.GetCode())
+				assert.Nil(t, evidence.Value)
+				return
+			}
+			assert.Nil(t, evidence.Error)
+
+			var value Value
+			b, err := evidence.Value.MarshalJSON()
+			assert.NoError(t, err)
+			assert.NoError(t, json.Unmarshal(b, &value))
+
+			assert.Equal(t, tt.wantStatus, value.CyberSecurityCertification.Status)
+			assert.Equal(t, tt.wantCerts, len(value.CyberSecurityCertification.Certificates))
+
+			var details []string
+			for _, c := range value.CyberSecurityCertification.Certificates {
+				details = append(details, c.Details...)
+			}
+			assert.Equal(t, tt.wantDetails, details)
+		})
+	}
+}
+
+func TestStartCollecting(t *testing.T) {
+	srv := newRegistryServer(t, &Registry{})
+
+	tests := []struct {
+		name     string
+		config   *collection.CyberSecurityCertificationConfig
+		wantCode codes.Code
+	}{
+		{
+			name:     "Invalid configuration",
+			config:   &collection.CyberSecurityCertificationConfig{Registry: srv.URL + "/registry.json"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Collect Success",
+			config:   &collection.CyberSecurityCertificationConfig{Provider: "provider1", Registry: srv.URL + "/registry.json"},
+			wantCode: codes.OK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewServer(WithAdditionalGRPCOpts(grpc.WithTransportCredentials(insecure.NewCredentials()),
+				grpc.WithContextDialer(testevaluation.BufConnDialer)))
+
+			res, err := s.StartCollecting(context.Background(), &collection.StartCollectingRequest{
+				ServiceId:   "service1",
+				EvalManager: "bufnet",
+				Configuration: &collection.ServiceConfiguration{
+					ServiceId:        "service1",
+					RawConfiguration: testproto.NewAny(t, tt.config),
+				},
+			})
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if err == nil {
+				assert.NotEmpty(t, res.Id)
+			}
+		})
+	}
+}
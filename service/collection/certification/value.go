@@ -0,0 +1,54 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package certification
+
+import "clouditor.io/clouditor/voc"
+
+const (
+	// StatusValid is reported, if the provider holds at least one valid certificate
+	StatusValid = "valid"
+	// StatusInvalid is reported, if the provider declares certificates, but none of them is valid
+	StatusInvalid = "invalid"
+	// StatusNotAvailable is reported, if the provider does not declare any certificate
+	StatusNotAvailable = "not available"
+)
+
+// Value represents the Value of an evidence in the case of the Cyber Security Certification CM
+type Value struct {
+	// Clouditor's Resource properties ID and Types have to be set that Evaluation will not fail
+	voc.Resource
+
+	// CyberSecurityCertification metric properties
+	*CyberSecurityCertification `json:"cyberSecurityCertification,omitempty"`
+}
+
+type CyberSecurityCertification struct {
+	// Status is one of StatusValid, StatusInvalid or StatusNotAvailable
+	Status       string              `json:"status"`
+	Certificates []CertificateResult `json:"certificates"`
+}
+
+// CertificateResult contains the result of the verification of a single certificate
+type CertificateResult struct {
+	ID         string   `json:"id"`
+	Scheme     string   `json:"scheme"`
+	Level      string   `json:"level,omitempty"`
+	Issuer     string   `json:"issuer,omitempty"`
+	ValidUntil string   `json:"validUntil,omitempty"`
+	Signed     bool     `json:"signed"`
+	Valid      bool     `json:"valid"`
+	Details    []string `json:"details,omitempty"`
+}
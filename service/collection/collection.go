@@ -16,23 +16,29 @@
 package collection
 
 import (
+	"context"
+
 	"clouditor.io/clouditor/api"
 	"clouditor.io/clouditor/voc"
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/eclipse-xfsc/cam/api/collection"
 	"github.com/eclipse-xfsc/cam/api/common"
 	"github.com/eclipse-xfsc/cam/api/evaluation"
+	"github.com/eclipse-xfsc/cam/internal/config"
+	"github.com/eclipse-xfsc/cam/internal/protobuf"
 )
 
 const (
 	TargetComponent = "Evaluation Manager"
 )
 
-// EnqueueEvidences creates evidences and sends them into the stream to the Evaluation Manager
-func EnqueueEvidences(toolId string, req *collection.StartCollectingRequest, results []voc.IsCloudResource, stream *api.StreamChannelOf[evaluation.Evaluation_SendEvidencesClient, *common.Evidence], log *logrus.Entry) error {
+// EnqueueEvidences creates evidences, signs them with the signer, if any, and sends them into the stream to the
+// Evaluation Manager. The evidences are part of the trace in ctx.
+func EnqueueEvidences(ctx context.Context, toolId string, req *collection.StartCollectingRequest, results []voc.IsCloudResource, stream *api.StreamChannelOf[evaluation.Evaluation_SendEvidencesClient, *common.Evidence], signer *Signer, log *logrus.Entry) error {
 	var (
 		evidence *common.Evidence
 	)
@@ -51,6 +57,7 @@ func EnqueueEvidences(toolId string, req *collection.StartCollectingRequest, res
 			Id:             id,
 			Name:           id,
 			TargetService:  req.ServiceId,
+			TenantId:       req.TenantId,
 			TargetResource: string(result.GetID()),
 			ToolId:         toolId,
 			GatheredAt:     timestamppb.New(*result.GetCreationTime()),
@@ -67,8 +74,21 @@ func EnqueueEvidences(toolId string, req *collection.StartCollectingRequest, res
 		}
 
 		log.Infof("Sending evidence '%s' with resource type %s to evaluation manager stream", evidence.Id, types)
-		stream.Send(evidence)
+		if err = SignAndSend(ctx, stream, signer, evidence); err != nil {
+			log.Errorf("Could not send evidence %s: %v", evidence.Id, err)
+			return err
+		}
 	}
 
 	return nil
 }
+
+// Describe creates the response to a Describe request of a collection module, which is configured with messages of
+// the same type as configMsg and gathers evidences for the given metrics.
+func Describe(configMsg proto.Message, metricIDs ...string) *collection.DescribeResponse {
+	return &collection.DescribeResponse{
+		MetricIds:            metricIDs,
+		ConfigMessageTypeUrl: protobuf.TypeURL(configMsg),
+		Version:              config.Version,
+	}
+}
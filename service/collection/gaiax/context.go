@@ -0,0 +1,120 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package gaiax
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/piprate/json-gold/ld"
+)
+
+// builtinContexts contains the JSON-LD contexts of the W3C Verifiable Credentials data model and of
+// JsonWebSignature2020, which nearly every self-description refers to
+//
+//go:embed contexts
+var builtinContexts embed.FS
+
+// builtinContextFiles maps the URLs of the built-in contexts to their files
+var builtinContextFiles = map[string]string{
+	"https://www.w3.org/2018/credentials/v1":             "contexts/credentials-v1.jsonld",
+	"https://w3id.org/security/suites/jws-2020/v1":       "contexts/jws-2020-v1.jsonld",
+	"https://w3id.org/security/jws/v1":                   "contexts/jws-2020-v1.jsonld",
+	"https://w3c-ccg.github.io/lds-jws2020/contexts/v1/": "contexts/jws-2020-v1.jsonld",
+}
+
+// DefaultAllowedContexts are the URL prefixes of the remote JSON-LD contexts, which are retrieved by default, i.e.,
+// the contexts of the Gaia-X trust framework and of the W3C
+var DefaultAllowedContexts = []string{
+	"https://registry.lab.gaia-x.eu/",
+	"https://registry.gaia-x.eu/",
+	"https://w3id.org/gaia-x/",
+	"https://www.w3.org/",
+}
+
+// maxCachedContexts is the maximum number of remote contexts, which are cached. Further contexts are retrieved each
+// time they are needed.
+const maxCachedContexts = 100
+
+// contextLoader loads the JSON-LD contexts that are needed to normalize a document. The built-in contexts are served
+// from memory, all others are retrieved with the HTTP client and cached, since the self-descriptions of a trust
+// framework usually refer to the same few contexts. Since the contexts are chosen by the authors of the
+// self-descriptions, only contexts with one of the allowed URL prefixes are retrieved.
+type contextLoader struct {
+	next    ld.DocumentLoader
+	allowed []string
+
+	mutex sync.RWMutex
+	cache map[string]*ld.RemoteDocument
+}
+
+// newContextLoader creates a context loader, which retrieves contexts that are not built-in with client, if their URL
+// starts with one of the allowed prefixes
+func newContextLoader(client *http.Client, allowed ...string) *contextLoader {
+	return &contextLoader{
+		next:    ld.NewDefaultDocumentLoader(client),
+		allowed: allowed,
+		cache:   make(map[string]*ld.RemoteDocument),
+	}
+}
+
+// LoadDocument implements ld.DocumentLoader
+func (l *contextLoader) LoadDocument(u string) (doc *ld.RemoteDocument, err error) {
+	l.mutex.RLock()
+	doc, ok := l.cache[u]
+	l.mutex.RUnlock()
+	if ok {
+		return doc, nil
+	}
+
+	if file, builtin := builtinContextFiles[u]; builtin {
+		doc = &ld.RemoteDocument{DocumentURL: u}
+		b, err := builtinContexts.ReadFile(file)
+		if err == nil {
+			err = json.Unmarshal(b, &doc.Document)
+		}
+		if err != nil {
+			return nil, ld.NewJsonLdError(ld.LoadingDocumentFailed, err)
+		}
+	} else if !l.isAllowed(u) {
+		return nil, ld.NewJsonLdError(ld.LoadingDocumentFailed, fmt.Errorf("context %s is not allowed", u))
+	} else if doc, err = l.next.LoadDocument(u); err != nil {
+		return nil, err
+	}
+
+	l.mutex.Lock()
+	if len(l.cache) < maxCachedContexts {
+		l.cache[u] = doc
+	}
+	l.mutex.Unlock()
+
+	return doc, nil
+}
+
+// isAllowed returns whether the remote context u has one of the allowed URL prefixes
+func (l *contextLoader) isAllowed(u string) bool {
+	for _, prefix := range l.allowed {
+		if strings.HasPrefix(u, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,67 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package gaiax
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_contextLoader_LoadDocument(t *testing.T) {
+	var requests int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/ld+json")
+		_, _ = w.Write([]byte(`{"@context": {"name": "https://schema.org/name"}}`))
+	}))
+	defer srv.Close()
+
+	l := newContextLoader(srv.Client(), srv.URL+"/contexts/")
+
+	// Built-in contexts are always served
+	doc, err := l.LoadDocument("https://www.w3.org/2018/credentials/v1")
+	assert.NoError(t, err)
+	assert.NotNil(t, doc.Document)
+	assert.Zero(t, atomic.LoadInt32(&requests))
+
+	// Allowed contexts are retrieved once and then cached
+	for i := 0; i < 2; i++ {
+		doc, err = l.LoadDocument(srv.URL + "/contexts/v1")
+		assert.NoError(t, err)
+		assert.NotNil(t, doc.Document)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	// Other contexts are not retrieved at all
+	_, err = l.LoadDocument(srv.URL + "/internal/v1")
+	assert.Error(t, err)
+	_, err = l.LoadDocument("http://169.254.169.254/latest/meta-data/")
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	// The cache is bounded
+	for i := 0; i < maxCachedContexts+10; i++ {
+		_, err = l.LoadDocument(fmt.Sprintf("%s/contexts/%d", srv.URL, i))
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, maxCachedContexts, len(l.cache))
+}
@@ -0,0 +1,372 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package gaiax
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/piprate/json-gold/ld"
+)
+
+// ProofTypeJsonWebSignature2020 is the only supported proof type
+const ProofTypeJsonWebSignature2020 = "JsonWebSignature2020"
+
+var (
+	// ErrNoCredentials is returned, if a self-description does not contain any verifiable credential
+	ErrNoCredentials = errors.New("self-description does not contain a verifiable credential")
+	// ErrMissingProof is returned, if a credential or presentation is not signed
+	ErrMissingProof = errors.New("proof is missing")
+	// ErrNoLinkedData is returned, if a document does not contain any linked data, e.g., because its context is missing
+	ErrNoLinkedData = errors.New("document does not contain any linked data")
+	// ErrUndefinedProperty is returned, if a document contains a property, which is not defined by its context and,
+	// thus, is not protected by its proof
+	ErrUndefinedProperty = errors.New("document contains a property, which is not defined by its context")
+)
+
+// document is a JSON-LD document in compacted form, e.g., a verifiable credential or presentation
+type document map[string]interface{}
+
+// parseSelfDescription parses a self-description, which is either a verifiable presentation or a single verifiable
+// credential. It returns the credentials and the presentation, if any.
+func parseSelfDescription(b []byte) (creds []document, vp document, err error) {
+	var doc document
+
+	if err = json.Unmarshal(b, &doc); err != nil {
+		return nil, nil, fmt.Errorf("self-description is not a JSON-LD document: %w", err)
+	}
+
+	if !contains(doc.types(), "VerifiablePresentation") {
+		return []document{doc}, nil, nil
+	}
+
+	for _, v := range list(doc["verifiableCredential"]) {
+		if c, ok := v.(map[string]interface{}); ok {
+			creds = append(creds, c)
+		}
+	}
+	if len(creds) == 0 {
+		return nil, nil, ErrNoCredentials
+	}
+
+	return creds, doc, nil
+}
+
+// id returns the id of the document
+func (d document) id() string {
+	return str(d["id"], d["@id"])
+}
+
+// types returns the types of the document
+func (d document) types() (types []string) {
+	for _, t := range list(firstOf(d["type"], d["@type"])) {
+		if s, ok := t.(string); ok {
+			types = append(types, s)
+		}
+	}
+
+	return
+}
+
+// issuer returns the issuer of a credential, which is either a string or an object with an id
+func (d document) issuer() string {
+	if m, ok := d["issuer"].(map[string]interface{}); ok {
+		return document(m).id()
+	}
+
+	return str(d["issuer"])
+}
+
+// date returns the first of the given date properties, e.g., expirationDate (VC data model 1.1) or validUntil (VC
+// data model 2.0).
+func (d document) date(names ...string) (t time.Time, ok bool, err error) {
+	for _, name := range names {
+		s, isString := d[name].(string)
+		if !isString {
+			continue
+		}
+
+		t, err = time.Parse(time.RFC3339, s)
+		if err != nil {
+			return t, false, fmt.Errorf("invalid %s: %w", name, err)
+		}
+
+		return t, true, nil
+	}
+
+	return
+}
+
+// subjects returns the credential subjects of a credential
+func (d document) subjects() (subjects []document) {
+	for _, v := range list(d["credentialSubject"]) {
+		if m, ok := v.(map[string]interface{}); ok {
+			subjects = append(subjects, m)
+		}
+	}
+
+	return
+}
+
+// verifyProof verifies the JsonWebSignature2020 proof of the document with the public key of the verification
+// method, which is resolved by resolve. The JWS is detached and unencoded (RFC 7797), the payload is the hex-encoded
+// SHA-256 hash of the document without its proof, normalized with URDNA2015 like the Gaia-X Compliance Service does.
+// The JSON-LD contexts of the document are loaded by loader. It returns the DID of the verification method.
+func (d document) verifyProof(resolve func(did string) (*DIDDocument, error), loader ld.DocumentLoader) (did string,
+	err error) {
+	var header struct {
+		Alg  string   `json:"alg"`
+		B64  *bool    `json:"b64"`
+		Crit []string `json:"crit"`
+	}
+
+	proof, ok := d["proof"].(map[string]interface{})
+	if !ok {
+		return "", ErrMissingProof
+	}
+
+	if t := str(proof["type"]); t != ProofTypeJsonWebSignature2020 {
+		return "", fmt.Errorf("unsupported proof type '%s'", t)
+	}
+
+	vm := str(proof["verificationMethod"])
+	did, _, _ = strings.Cut(vm, "#")
+	if did == "" {
+		return "", errors.New("verification method of proof is missing")
+	}
+
+	parts := strings.Split(str(proof["jws"]), ".")
+	if len(parts) != 3 || parts[1] != "" {
+		return "", errors.New("proof does not contain a detached JWS")
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err == nil {
+		err = json.Unmarshal(b, &header)
+	}
+	if err != nil {
+		return "", fmt.Errorf("invalid JWS header: %w", err)
+	}
+	if header.B64 == nil || *header.B64 || !contains(header.Crit, "b64") {
+		return "", errors.New("JWS payload must be unencoded (b64=false)")
+	}
+
+	method := jwt.GetSigningMethod(header.Alg)
+	if method == nil || header.Alg == "none" {
+		return "", fmt.Errorf("unsupported JWS algorithm '%s'", header.Alg)
+	}
+
+	didDoc, err := resolve(did)
+	if err != nil {
+		return "", err
+	}
+
+	key, err := didDoc.publicKey(vm)
+	if err != nil {
+		return "", err
+	}
+
+	payload, err := d.hash(loader)
+	if err != nil {
+		return "", err
+	}
+
+	if err = method.Verify(parts[0]+"."+payload, parts[2], key); err != nil {
+		return "", fmt.Errorf("signature of proof is invalid: %w", err)
+	}
+
+	return did, nil
+}
+
+// hash returns the hex-encoded SHA-256 hash of the normalized document without its proof
+func (d document) hash(loader ld.DocumentLoader) (string, error) {
+	unsigned := make(map[string]interface{}, len(d))
+	for k, v := range d {
+		if k != "proof" {
+			unsigned[k] = v
+		}
+	}
+
+	nquads, err := normalize(unsigned, loader)
+	if err != nil {
+		return "", fmt.Errorf("could not normalize document: %w", err)
+	}
+
+	h := sha256.Sum256([]byte(nquads))
+	return hex.EncodeToString(h[:]), nil
+}
+
+// normalize converts a JSON-LD document to RDF and returns the canonical N-Quads of the dataset, as specified by the
+// URDNA2015 algorithm. Documents without any statements are rejected, since a proof would not protect anything of
+// their content, e.g., if the context of a document is missing. Documents with properties, which are not defined by
+// their context, are rejected as well, since these properties would be dropped from the dataset and, thus, are not
+// protected by the proof, although the claims are read from the document.
+func normalize(doc map[string]interface{}, loader ld.DocumentLoader) (string, error) {
+	opts := ld.NewJsonLdOptions("")
+	opts.Algorithm = ld.AlgorithmURDNA2015
+	opts.Format = "application/n-quads"
+	opts.DocumentLoader = loader
+
+	v, err := ld.NewJsonLdProcessor().Normalize(doc, opts)
+	if err != nil {
+		return "", err
+	}
+
+	nquads, _ := v.(string)
+	if nquads == "" {
+		return "", ErrNoLinkedData
+	}
+
+	// The processor does not pass its safe mode on (json-gold v0.5.0 drops it when copying the options), so we expand
+	// the document ourselves to find the properties, which were dropped
+	opts.SafeMode = true
+	if _, err = ld.NewJsonLdApi().Expand(ld.NewContext(nil, opts), "", doc, opts, false, nil); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrUndefinedProperty, err)
+	}
+
+	return nquads, nil
+}
+
+// claims returns the sorted, distinct string values of all properties with one of the given local names, which are
+// found anywhere in the credential subjects.
+func claims(subjects []document, names ...string) (values []string) {
+	var (
+		seen = make(map[string]bool)
+		walk func(v interface{})
+	)
+
+	walk = func(v interface{}) {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			for k, child := range v {
+				if contains(names, localName(k)) {
+					for _, s := range list(child) {
+						if s := str(s); s != "" && !seen[s] {
+							seen[s] = true
+							values = append(values, s)
+						}
+					}
+				}
+				walk(child)
+			}
+		case []interface{}:
+			for _, child := range v {
+				walk(child)
+			}
+		}
+	}
+
+	for _, s := range subjects {
+		walk(map[string]interface{}(s))
+	}
+
+	sort.Strings(values)
+
+	return
+}
+
+// fetch retrieves a document via HTTP(S)
+func fetch(client *http.Client, url string) (b []byte, err error) {
+	res, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+
+	return io.ReadAll(res.Body)
+}
+
+// localName returns the local name of a (compact) IRI, e.g., legalName for gx:legalName or
+// https://w3id.org/gaia-x/core#legalName.
+func localName(iri string) string {
+	if i := strings.LastIndexAny(iri, "#/:"); i >= 0 {
+		return iri[i+1:]
+	}
+
+	return iri
+}
+
+// list returns the value as a list, since JSON-LD allows single values in place of a list with one entry. A value
+// object ({"@value": ...}) is unwrapped and a list object ({"@list": [...]}) is flattened.
+func list(v interface{}) []interface{} {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		return v
+	case map[string]interface{}:
+		if l, ok := v["@list"]; ok {
+			return list(l)
+		}
+		return []interface{}{v}
+	default:
+		return []interface{}{v}
+	}
+}
+
+// str returns the first value that is a string or a value object with a string value
+func str(values ...interface{}) string {
+	for _, v := range values {
+		switch v := v.(type) {
+		case string:
+			return v
+		case map[string]interface{}:
+			if s, ok := v["@value"].(string); ok {
+				return s
+			}
+			if s, ok := v["@id"].(string); ok {
+				return s
+			}
+		}
+	}
+
+	return ""
+}
+
+func firstOf(values ...interface{}) interface{} {
+	for _, v := range values {
+		if v != nil {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// contains returns whether the list contains the value or a compact IRI with the value as local name
+func contains(l []string, value string) bool {
+	for _, s := range l {
+		if s == value || localName(s) == value {
+			return true
+		}
+	}
+
+	return false
+}
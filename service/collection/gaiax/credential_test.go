@@ -0,0 +1,279 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package gaiax
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockIssuer is an issuer of verifiable credentials with a did:web DID
+type mockIssuer struct {
+	did string
+	key *ecdsa.PrivateKey
+}
+
+func newMockIssuer(t *testing.T, did string) *mockIssuer {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	return &mockIssuer{did: did, key: key}
+}
+
+func (i *mockIssuer) didDocument() *DIDDocument {
+	return &DIDDocument{
+		ID: i.did,
+		VerificationMethod: []VerificationMethod{{
+			ID:           "#key-0",
+			Type:         "JsonWebKey2020",
+			Controller:   i.did,
+			PublicKeyJwk: ecJWK(&i.key.PublicKey),
+		}},
+	}
+}
+
+// sign adds a JsonWebSignature2020 proof to the document
+func (i *mockIssuer) sign(t *testing.T, d document) document {
+	hash, err := d.hash(newContextLoader(http.DefaultClient))
+	assert.NoError(t, err)
+
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"ES256","b64":false,"crit":["b64"]}`))
+	sig, err := jwt.SigningMethodES256.Sign(header+"."+hash, i.key)
+	assert.NoError(t, err)
+
+	d["proof"] = map[string]interface{}{
+		"type":               ProofTypeJsonWebSignature2020,
+		"proofPurpose":       "assertionMethod",
+		"verificationMethod": i.did + "#key-0",
+		"jws":                header + ".." + sig,
+	}
+
+	return d
+}
+
+func Test_document_verifyProof(t *testing.T) {
+	var (
+		issuer = newMockIssuer(t, "did:web:issuer.example")
+		other  = newMockIssuer(t, "did:web:issuer.example")
+	)
+
+	resolve := func(did string) (*DIDDocument, error) {
+		return issuer.didDocument(), nil
+	}
+
+	newCredential := func() document {
+		return document{
+			"@context":          []interface{}{"https://www.w3.org/2018/credentials/v1"},
+			"type":              []interface{}{"VerifiableCredential"},
+			"issuer":            issuer.did,
+			"credentialSubject": map[string]interface{}{"id": "did:web:provider.example", "gx:legalName": "<Provider & Co>"},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		doc     document
+		wantDID string
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "Valid proof",
+			doc:     issuer.sign(t, newCredential()),
+			wantDID: issuer.did,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Tampered credential",
+			doc: func() document {
+				d := issuer.sign(t, newCredential())
+				d["credentialSubject"].(map[string]interface{})["gx:legalName"] = "Someone Else"
+				return d
+			}(),
+			wantErr: assert.Error,
+		},
+		{
+			name:    "Signed with another key",
+			doc:     other.sign(t, newCredential()),
+			wantErr: assert.Error,
+		},
+		{
+			name: "Missing proof",
+			doc:  newCredential(),
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrMissingProof)
+			},
+		},
+		{
+			name: "Unsigned property, which is not defined by the context",
+			doc: func() document {
+				d := issuer.sign(t, newCredential())
+				d["credentialSubject"].(map[string]interface{})["legalName"] = "Someone Else"
+				return d
+			}(),
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrUndefinedProperty)
+			},
+		},
+		{
+			name: "Tampered context",
+			doc: func() document {
+				d := issuer.sign(t, newCredential())
+				delete(d, "@context")
+				return d
+			}(),
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrNoLinkedData)
+			},
+		},
+		{
+			name: "Unsupported proof type",
+			doc: func() document {
+				d := issuer.sign(t, newCredential())
+				d["proof"].(map[string]interface{})["type"] = "Ed25519Signature2018"
+				return d
+			}(),
+			wantErr: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.doc.verifyProof(resolve, newContextLoader(http.DefaultClient))
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantDID, got)
+		})
+	}
+}
+
+func Test_document_verifyProof_fixture(t *testing.T) {
+	var (
+		cred   document
+		didDoc DIDDocument
+	)
+
+	// The fixture is a LegalParticipant credential in the format of the Gaia-X Compliance Service, which is signed with
+	// PS256 over the hash of the URDNA2015 normalization of the credential.
+	b, err := os.ReadFile("testdata/participant.json")
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(b, &cred))
+
+	b, err = os.ReadFile("testdata/did.json")
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(b, &didDoc))
+
+	resolve := func(did string) (*DIDDocument, error) {
+		return &didDoc, nil
+	}
+
+	hash, err := cred.hash(newContextLoader(http.DefaultClient))
+	assert.NoError(t, err)
+	assert.Equal(t, "23efb73505dda17c4b70ef392f089a8259634c8b68111921e420f463c55004fa", hash)
+
+	did, err := cred.verifyProof(resolve, newContextLoader(http.DefaultClient))
+	assert.NoError(t, err)
+	assert.Equal(t, "did:web:provider.example", did)
+
+	cred["credentialSubject"].(map[string]interface{})["gx:legalName"] = "Someone Else"
+	_, err = cred.verifyProof(resolve, newContextLoader(http.DefaultClient))
+	assert.Error(t, err)
+}
+
+func Test_parseSelfDescription(t *testing.T) {
+	tests := []struct {
+		name      string
+		sd        string
+		wantCreds int
+		wantVP    bool
+		wantErr   assert.ErrorAssertionFunc
+	}{
+		{
+			name:      "Single credential",
+			sd:        `{"type": "VerifiableCredential", "issuer": {"id": "did:web:issuer.example"}}`,
+			wantCreds: 1,
+			wantErr:   assert.NoError,
+		},
+		{
+			name:      "Presentation",
+			sd:        `{"type": ["VerifiablePresentation"], "verifiableCredential": [{"type": "VerifiableCredential"}, {"type": "VerifiableCredential"}]}`,
+			wantCreds: 2,
+			wantVP:    true,
+			wantErr:   assert.NoError,
+		},
+		{
+			name:    "Empty presentation",
+			sd:      `{"type": "VerifiablePresentation", "verifiableCredential": []}`,
+			wantErr: assert.Error,
+		},
+		{
+			name:    "No JSON",
+			sd:      `<html></html>`,
+			wantErr: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			creds, vp, err := parseSelfDescription([]byte(tt.sd))
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantCreds, len(creds))
+			assert.Equal(t, tt.wantVP, vp != nil)
+		})
+	}
+}
+
+func Test_claims(t *testing.T) {
+	subjects := []document{
+		{
+			"type":                    "gx:LegalParticipant",
+			"gx:headquarterAddress":   map[string]interface{}{"gx:countrySubdivisionCode": "DE-BE"},
+			"gx:legalAddress":         map[string]interface{}{"gx:countrySubdivisionCode": map[string]interface{}{"@value": "FR-75"}},
+			"gx:dataProtectionRegime": []interface{}{"GDPR2016"},
+		},
+		{
+			"type": "gx:ServiceOffering",
+			"https://w3id.org/gaia-x/core#dataProtectionRegime": "GDPR2016",
+		},
+	}
+
+	assert.Equal(t, []string{"DE-BE", "FR-75"}, claims(subjects, "countrySubdivisionCode"))
+	assert.Equal(t, []string{"GDPR2016"}, claims(subjects, "dataProtectionRegime"))
+	assert.Empty(t, claims(subjects, "unknown"))
+}
+
+func Test_normalize(t *testing.T) {
+	nquads, err := normalize(map[string]interface{}{
+		"@context":          []interface{}{"https://www.w3.org/2018/credentials/v1"},
+		"type":              []interface{}{"VerifiableCredential"},
+		"issuer":            "did:web:issuer.example",
+		"credentialSubject": map[string]interface{}{"id": "did:web:provider.example", "gx:legalName": "<Provider & Co>"},
+	}, newContextLoader(http.DefaultClient))
+	assert.NoError(t, err)
+	assert.Equal(t, `<did:web:provider.example> <gx:legalName> "<Provider & Co>" .
+_:c14n0 <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <https://www.w3.org/2018/credentials#VerifiableCredential> .
+_:c14n0 <https://www.w3.org/2018/credentials#credentialSubject> <did:web:provider.example> .
+_:c14n0 <https://www.w3.org/2018/credentials#issuer> <did:web:issuer.example> .
+`, nquads)
+
+	_, err = normalize(map[string]interface{}{"type": "VerifiableCredential"}, newContextLoader(http.DefaultClient))
+	assert.ErrorIs(t, err, ErrNoLinkedData)
+}
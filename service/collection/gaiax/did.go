@@ -0,0 +1,196 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package gaiax
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+var (
+	// ErrUnsupportedDIDMethod is returned, if a DID does not use the did:web method
+	ErrUnsupportedDIDMethod = errors.New("only did:web is supported")
+	// ErrVerificationMethodNotFound is returned, if the DID document does not contain the verification method
+	ErrVerificationMethodNotFound = errors.New("verification method not found in DID document")
+)
+
+// DIDDocument is a DID document, as far as it is needed to verify proofs
+type DIDDocument struct {
+	ID                 string               `json:"id"`
+	VerificationMethod []VerificationMethod `json:"verificationMethod"`
+}
+
+// VerificationMethod is a verification method of a DID document. Only public keys in JWK format are supported.
+type VerificationMethod struct {
+	ID           string `json:"id"`
+	Type         string `json:"type"`
+	Controller   string `json:"controller"`
+	PublicKeyJwk *JWK   `json:"publicKeyJwk"`
+}
+
+// JWK is a public JSON Web Key (RFC 7517)
+type JWK struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+}
+
+// didWebURL returns the URL of the DID document of a did:web DID, e.g., did:web:example.com:user:alice resolves to
+// https://example.com/user/alice/did.json and did:web:example.com to https://example.com/.well-known/did.json.
+func didWebURL(did string, scheme string) (u string, err error) {
+	if !strings.HasPrefix(did, "did:web:") {
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedDIDMethod, did)
+	}
+
+	parts := strings.Split(strings.TrimPrefix(did, "did:web:"), ":")
+	for i := range parts {
+		if parts[i], err = url.PathUnescape(parts[i]); err != nil {
+			return "", fmt.Errorf("invalid did:web %s: %w", did, err)
+		}
+	}
+	if parts[0] == "" {
+		return "", fmt.Errorf("invalid did:web %s: domain is missing", did)
+	}
+
+	if len(parts) == 1 {
+		return fmt.Sprintf("%s://%s/.well-known/did.json", scheme, parts[0]), nil
+	}
+
+	return fmt.Sprintf("%s://%s/%s/did.json", scheme, parts[0], strings.Join(parts[1:], "/")), nil
+}
+
+// resolveDID resolves a did:web DID into its DID document.
+func resolveDID(client *http.Client, scheme string, did string) (doc *DIDDocument, err error) {
+	u, err := didWebURL(did, scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := fetch(client, u)
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve %s: %w", did, err)
+	}
+
+	doc = new(DIDDocument)
+	if err = json.Unmarshal(b, doc); err != nil {
+		return nil, fmt.Errorf("invalid DID document of %s: %w", did, err)
+	}
+
+	if doc.ID != did {
+		return nil, fmt.Errorf("DID document of %s has a different id %s", did, doc.ID)
+	}
+
+	return
+}
+
+// publicKey returns the public key of the verification method with the given ID. Relative IDs (fragments only) are
+// resolved against the DID of the document.
+func (doc *DIDDocument) publicKey(id string) (key crypto.PublicKey, err error) {
+	for _, m := range doc.VerificationMethod {
+		mid := m.ID
+		if strings.HasPrefix(mid, "#") {
+			mid = doc.ID + mid
+		}
+
+		if mid != id {
+			continue
+		}
+		if m.PublicKeyJwk == nil {
+			return nil, fmt.Errorf("verification method %s has no publicKeyJwk", id)
+		}
+
+		return m.PublicKeyJwk.PublicKey()
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrVerificationMethodNotFound, id)
+}
+
+// PublicKey converts the JWK into an RSA, ECDSA or Ed25519 public key.
+func (k *JWK) PublicKey() (key crypto.PublicKey, err error) {
+	switch k.Kty {
+	case "RSA":
+		var n, e []byte
+		if n, err = decodeBase64URL("n", k.N); err != nil {
+			return nil, err
+		}
+		if e, err = decodeBase64URL("e", k.E); err != nil {
+			return nil, err
+		}
+
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var (
+			curve elliptic.Curve
+			x, y  []byte
+		)
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %s", k.Crv)
+		}
+		if x, err = decodeBase64URL("x", k.X); err != nil {
+			return nil, err
+		}
+		if y, err = decodeBase64URL("y", k.Y); err != nil {
+			return nil, err
+		}
+
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	case "OKP":
+		var x []byte
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %s", k.Crv)
+		}
+		if x, err = decodeBase64URL("x", k.X); err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 public key")
+		}
+
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %s", k.Kty)
+	}
+}
+
+func decodeBase64URL(name string, s string) (b []byte, err error) {
+	b, err = base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(b) == 0 {
+		return nil, fmt.Errorf("invalid JWK parameter %s", name)
+	}
+
+	return
+}
@@ -0,0 +1,105 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package gaiax
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_didWebURL(t *testing.T) {
+	tests := []struct {
+		did     string
+		want    string
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{did: "did:web:example.com", want: "https://example.com/.well-known/did.json", wantErr: assert.NoError},
+		{did: "did:web:example.com:user:alice", want: "https://example.com/user/alice/did.json", wantErr: assert.NoError},
+		{did: "did:web:localhost%3A8443", want: "https://localhost:8443/.well-known/did.json", wantErr: assert.NoError},
+		{did: "did:key:z6Mk", wantErr: assert.Error},
+		{did: "did:web:", wantErr: assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.did, func(t *testing.T) {
+			got, err := didWebURL(tt.did, "https")
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestJWK_PublicKey(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	edKey, _, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		jwk     *JWK
+		want    crypto.PublicKey
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "EC key",
+			jwk:     ecJWK(&ecKey.PublicKey),
+			want:    &ecKey.PublicKey,
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "Ed25519 key",
+			jwk:     &JWK{Kty: "OKP", Crv: "Ed25519", X: base64.RawURLEncoding.EncodeToString(edKey)},
+			want:    edKey,
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "Unsupported curve",
+			jwk:     &JWK{Kty: "EC", Crv: "secp256k1"},
+			wantErr: assert.Error,
+		},
+		{
+			name:    "Missing parameter",
+			jwk:     &JWK{Kty: "RSA", E: "AQAB"},
+			wantErr: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.jwk.PublicKey()
+			tt.wantErr(t, err)
+			if err == nil {
+				assert.True(t, tt.want.(interface{ Equal(x crypto.PublicKey) bool }).Equal(got))
+			}
+		})
+	}
+}
+
+func ecJWK(key *ecdsa.PublicKey) *JWK {
+	return &JWK{
+		Kty: "EC",
+		Crv: key.Curve.Params().Name,
+		X:   base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+		Y:   base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, 32))),
+	}
+}
@@ -0,0 +1,493 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+// Package gaiax contains service specific code for the Gaia-X Self-Description Collection Module
+package gaiax
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	clapi "clouditor.io/clouditor/api"
+	"clouditor.io/clouditor/voc"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/oauth2/clientcredentials"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eclipse-xfsc/cam/api"
+	"github.com/eclipse-xfsc/cam/api/collection"
+	"github.com/eclipse-xfsc/cam/api/common"
+	"github.com/eclipse-xfsc/cam/api/evaluation"
+	"github.com/eclipse-xfsc/cam/internal/config"
+	"github.com/eclipse-xfsc/cam/internal/protobuf"
+	"github.com/eclipse-xfsc/cam/service"
+	servicecollection "github.com/eclipse-xfsc/cam/service/collection"
+)
+
+const (
+	// DefaultHTTPTimeout is the default timeout for retrieving self-descriptions and DID documents
+	DefaultHTTPTimeout = 10 * time.Second
+
+	// DefaultDIDWebScheme is the scheme used to resolve did:web DIDs
+	DefaultDIDWebScheme = "https"
+)
+
+var (
+	log         = logrus.WithField("service", "collection-gaiax")
+	ComponentID = config.DefaultCollectionGaiaXID
+)
+
+type Server struct {
+	collection.UnimplementedCollectionServer
+	streams  *clapi.StreamsOf[evaluation.Evaluation_SendEvidencesClient, *common.Evidence]
+	grpcOpts []grpc.DialOption
+
+	// shapes are the SHACL shapes of the trust framework, which the credential subjects are validated against
+	shapes *Shapes
+
+	// didWebScheme is the scheme used to resolve did:web DIDs. It is only changed to http for local test setups.
+	didWebScheme string
+
+	client *http.Client
+	// loader loads the JSON-LD contexts of self-descriptions with client
+	loader *contextLoader
+	// allowedContexts are the URL prefixes of the remote contexts, which the loader retrieves
+	allowedContexts []string
+
+	authorizer clapi.Authorizer
+	signer     *servicecollection.Signer
+}
+
+// WithAdditionalGRPCOpts is an option to configure additional gRPC options.
+func WithAdditionalGRPCOpts(opts ...grpc.DialOption) service.ServiceOption[Server] {
+	return func(s *Server) {
+		s.grpcOpts = opts
+	}
+}
+
+// WithShapes is an option to configure the SHACL shapes of the trust framework instead of the built-in ones.
+func WithShapes(shapes *Shapes) service.ServiceOption[Server] {
+	return func(s *Server) {
+		s.shapes = shapes
+	}
+}
+
+// WithDIDWebScheme is an option to configure the scheme used to resolve did:web DIDs, e.g., http for a local DID
+// server.
+func WithDIDWebScheme(scheme string) service.ServiceOption[Server] {
+	return func(s *Server) {
+		s.didWebScheme = scheme
+	}
+}
+
+// WithHTTPClient is an option to configure the HTTP client that is used to retrieve self-descriptions and DID
+// documents.
+func WithHTTPClient(client *http.Client) service.ServiceOption[Server] {
+	return func(s *Server) {
+		s.client = client
+	}
+}
+
+// WithAllowedContexts is an option to configure the URL prefixes of the remote JSON-LD contexts, which are retrieved
+// to verify the proofs of self-descriptions, instead of DefaultAllowedContexts. The prefixes should end with a slash,
+// so that they only match the intended hosts.
+func WithAllowedContexts(prefixes ...string) service.ServiceOption[Server] {
+	return func(s *Server) {
+		s.allowedContexts = prefixes
+	}
+}
+
+// WithSigner is an option to sign the evidences with the signer, so that the Evaluation Manager can verify them
+func WithSigner(signer *servicecollection.Signer) service.ServiceOption[Server] {
+	return func(s *Server) {
+		s.signer = signer
+	}
+}
+
+// WithOAuth2Authorizer is an option to use an OAuth 2.0 authorizer
+func WithOAuth2Authorizer(config *clientcredentials.Config) service.ServiceOption[Server] {
+	return func(srv *Server) {
+		srv.SetAuthorizer(clapi.NewOAuthAuthorizerFromClientCredentials(config))
+	}
+}
+
+// SetAuthorizer implements UsesAuthorizer
+func (srv *Server) SetAuthorizer(auth clapi.Authorizer) {
+	srv.authorizer = auth
+}
+
+// Authorizer implements UsesAuthorizer
+func (srv *Server) Authorizer() clapi.Authorizer {
+	return srv.authorizer
+}
+
+func NewServer(opts ...service.ServiceOption[Server]) collection.CollectionServer {
+	var err error
+
+	s := &Server{
+		streams:         clapi.NewStreamsOf(clapi.WithLogger[evaluation.Evaluation_SendEvidencesClient, *common.Evidence](log)),
+		didWebScheme:    DefaultDIDWebScheme,
+		client:          &http.Client{Timeout: DefaultHTTPTimeout},
+		allowedContexts: DefaultAllowedContexts,
+	}
+
+	s.shapes, err = LoadShapes(defaultShapes)
+	if err != nil {
+		log.Errorf("Could not load the built-in shapes: %v", err)
+	}
+
+	// Apply any options
+	for _, o := range opts {
+		o(s)
+	}
+
+	s.loader = newContextLoader(s.client, s.allowedContexts...)
+
+	return s
+}
+
+// checkConfiguration performs some basic input validation on the configuration data sent with the request. Problems
+// with the self-description itself are reported to the evaluation manager instead.
+func checkConfiguration(req *collection.StartCollectingRequest) (config *collection.GaiaXSelfDescriptionConfig,
+	err error) {
+	var u *url.URL
+
+	if req.Configuration == nil {
+		err = errors.New("configuration is missing")
+		return
+	}
+	if req.Configuration.RawConfiguration == nil {
+		err = errors.New("RawConfiguration in Configuration is missing")
+		return
+	}
+
+	config = new(collection.GaiaXSelfDescriptionConfig)
+	if err = req.Configuration.RawConfiguration.UnmarshalTo(config); err != nil {
+		err = errors.New("RawConfiguration is not a Gaia-X Self-Description Config")
+		return
+	}
+
+	if config.SelfDescription == "" {
+		err = errors.New("missing required config parameter: self_description")
+		return
+	}
+	if u, err = url.ParseRequestURI(config.SelfDescription); err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		err = fmt.Errorf("self_description is not an HTTP(S) URL: %s", config.SelfDescription)
+		return
+	}
+
+	for _, issuer := range config.TrustedIssuers {
+		if !strings.HasPrefix(issuer, "did:") {
+			err = fmt.Errorf("trusted issuer is not a DID: %s", issuer)
+			return
+		}
+	}
+
+	return
+}
+
+func (s *Server) StartCollecting(ctx context.Context, req *collection.StartCollectingRequest) (
+	*collection.StartCollectingResponse, error) {
+	log.Infof("Received StartCollecting Request for Service ID '%v'", req.ServiceId)
+
+	// Parse and check configuration data. If problems are detected at this stage, they are returned to the caller
+	// instead of being forwarded to the evaluation manager
+	config, err := checkConfiguration(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	// Get stream for the Evaluation Manager
+	component := "Evaluation Manager"
+	stream, err := s.streams.GetStream(req.EvalManager, component, api.InitEvalStream,
+		clapi.DefaultGrpcDialOptions(req.EvalManager, s, s.grpcOpts...)...)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Could not connect to Eval Manager: %v", err)
+	}
+
+	// Verify the self-description in a separate goroutine. StartCollecting will return and later collection problems
+	// are reported to the evaluation manager
+	ctx, done := servicecollection.TrackCollection(ctx, ComponentID, req.RunId)
+	go func() {
+		defer done()
+
+		evidence := s.collect(req.ServiceId, config, time.Now())
+		evidence.TenantId = req.TenantId
+		if err := servicecollection.SignAndSend(ctx, stream, s.signer, evidence); err != nil {
+			log.Errorf("Could not send evidence %s: %v", evidence.Id, err)
+			return
+		}
+		log.Infof("Sent evidence {id: %s, target_resource: %s } to evaluation manager", evidence.Id,
+			evidence.TargetResource)
+	}()
+
+	return &collection.StartCollectingResponse{Id: uuid.NewString()}, nil
+}
+
+// TestCollection checks the configuration and retrieves and parses the self-description, without verifying it or
+// collecting any evidences
+func (s *Server) TestCollection(_ context.Context, req *collection.TestCollectionRequest) (
+	*collection.TestCollectionResponse, error) {
+	log.Infof("Received TestCollection Request for Service ID '%v'", req.ServiceId)
+
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	config, err := checkConfiguration(req.StartCollectingRequest())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	start := time.Now()
+	b, err := fetch(s.client, config.SelfDescription)
+	servicecollection.ObserveDiscoverer(ComponentID, "self-description", start, err)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "could not retrieve self-description: %v", err)
+	}
+
+	creds, _, err := parseSelfDescription(b)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	return &collection.TestCollectionResponse{
+		Checks: []string{fmt.Sprintf("retrieved self-description with %d credential(s) from %s", len(creds),
+			config.SelfDescription)},
+	}, nil
+}
+
+func (s *Server) StopCollecting(_ context.Context, _ *collection.StopCollectingRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "StopCollection not implemented")
+}
+
+// Describe returns the metrics and the configuration type supported by this collection module
+func (*Server) Describe(_ context.Context, _ *collection.DescribeRequest) (*collection.DescribeResponse, error) {
+	return servicecollection.Describe(&collection.GaiaXSelfDescriptionConfig{}, "GaiaXCredentialValidity", "GaiaXCredentialExpiry",
+		"GaiaXServiceLocation", "GaiaXDataProtection"), nil
+}
+
+// collect retrieves and verifies the self-description of a service and creates the corresponding evidence. If the
+// self-description could not be retrieved or parsed, the evidence contains the error.
+func (s *Server) collect(serviceID string, config *collection.GaiaXSelfDescriptionConfig, now time.Time) (
+	evidence *common.Evidence) {
+	var (
+		subjects []document
+		expiry   *time.Time
+	)
+
+	evidenceID := uuid.NewString()
+	evidence = &common.Evidence{
+		Id:             evidenceID,
+		Name:           evidenceID,
+		TargetService:  serviceID,
+		TargetResource: config.SelfDescription,
+		GatheredAt:     timestamppb.Now(),
+		ToolId:         ComponentID,
+	}
+
+	b, err := fetch(s.client, config.SelfDescription)
+	if err != nil {
+		evidence.Error = &common.Error{
+			Code:        common.Error_ERROR_CONNECTION_FAILURE,
+			Description: fmt.Sprintf("could not retrieve self-description: %v", err),
+		}
+		return
+	}
+	evidence.RawEvidence = string(b)
+
+	creds, vp, err := parseSelfDescription(b)
+	if err != nil {
+		evidence.Error = &common.Error{Code: common.Error_ERROR_PROTOCOL_VIOLATION, Description: err.Error()}
+		return
+	}
+
+	resolve := s.resolver()
+	result := &GaiaXCredential{Valid: true, Credentials: []CredentialResult{}}
+
+	// The proof of the presentation is optional, but it must be valid if present
+	if _, signed := vp["proof"]; signed {
+		if _, err = vp.verifyProof(resolve, s.loader); err != nil {
+			result.Valid = false
+			result.Details = append(result.Details, fmt.Sprintf("proof of presentation: %v", err))
+		}
+	}
+
+	for _, c := range creds {
+		r, valid, until := s.verify(c, config.TrustedIssuers, resolve, now)
+		result.Credentials = append(result.Credentials, r)
+		result.Valid = result.Valid && valid
+
+		if until != nil && (expiry == nil || until.Before(*expiry)) {
+			expiry = until
+		}
+
+		// The claims are only taken from credentials with a valid proof, since the claims of others are not protected
+		if r.ProofValid {
+			subjects = append(subjects, c.subjects()...)
+		}
+	}
+
+	if expiry != nil {
+		days := int(math.Floor(expiry.Sub(now).Hours() / 24))
+		result.ExpiresInDays = &days
+	}
+
+	result.Locations = countryCodes(claims(subjects, "countrySubdivisionCode", "countryCode", "location",
+		"locations"))
+	result.DataProtectionRegimes = append([]string{}, claims(subjects, "dataProtectionRegime")...)
+
+	evidence.Value, err = protobuf.ToValue(Value{
+		Resource: voc.Resource{
+			// ID and Type has to be set. Otherwise, evaluation will fail due to evidence validation
+			ID:   voc.ResourceID(config.SelfDescription),
+			Type: []string{"GaiaXCredential"},
+		},
+		GaiaXCredential: result,
+	})
+	if err != nil {
+		log.Errorf("Could not convert evidence value: %v", err)
+		evidence.Error = &common.Error{Code: common.Error_ERROR_UNKNOWN, Description: err.Error()}
+	}
+
+	return
+}
+
+// verify verifies the proof, issuer, validity period and shape conformance of a credential. The credential is valid,
+// if all checks except the expiry succeed, since the expiry is assessed by its own metric. The expiration date is
+// returned, if the credential has one.
+func (s *Server) verify(c document, trusted []string, resolve func(did string) (*DIDDocument, error),
+	now time.Time) (r CredentialResult, valid bool, until *time.Time) {
+	r = CredentialResult{
+		ID:     c.id(),
+		Types:  c.types(),
+		Issuer: c.issuer(),
+	}
+
+	detail := func(format string, a ...interface{}) {
+		r.Details = append(r.Details, fmt.Sprintf(format, a...))
+	}
+
+	did, err := c.verifyProof(resolve, s.loader)
+	switch {
+	case err != nil:
+		detail("proof: %v", err)
+	case did != r.Issuer:
+		detail("proof is created by %s instead of the issuer %s", did, r.Issuer)
+	default:
+		r.ProofValid = true
+	}
+
+	trustedIssuer := len(trusted) == 0
+	for _, t := range trusted {
+		trustedIssuer = trustedIssuer || t == r.Issuer
+	}
+	if !trustedIssuer {
+		detail("issuer %s is not trusted", r.Issuer)
+	}
+
+	// Invalid dates make the credential invalid, an expiration date in the past does not
+	datesValid := true
+
+	from, ok, err := c.date("issuanceDate", "validFrom")
+	if err != nil {
+		datesValid = false
+		detail("%v", err)
+	} else if ok && now.Before(from) {
+		datesValid = false
+		detail("credential is not valid before %s", from.Format(time.RFC3339))
+	}
+
+	expiration, ok, err := c.date("expirationDate", "validUntil")
+	if err != nil {
+		datesValid = false
+		detail("%v", err)
+	} else if ok {
+		until = &expiration
+		r.ExpirationDate = expiration.Format(time.RFC3339)
+		if now.After(expiration) {
+			detail("credential expired on %s", r.ExpirationDate)
+		}
+	}
+
+	var violations []string
+	if s.shapes != nil {
+		violations = s.shapes.validate(c.subjects())
+	}
+	r.Conforms = len(violations) == 0
+	r.Details = append(r.Details, violations...)
+
+	valid = r.ProofValid && trustedIssuer && datesValid && r.Conforms
+	r.Valid = valid
+
+	return
+}
+
+// resolver returns a function which resolves DIDs. DID documents are cached for a single collection, since all
+// credentials of a self-description are often issued by the same issuer.
+func (s *Server) resolver() func(did string) (*DIDDocument, error) {
+	type entry struct {
+		doc *DIDDocument
+		err error
+	}
+	cache := make(map[string]entry)
+
+	return func(did string) (*DIDDocument, error) {
+		e, ok := cache[did]
+		if !ok {
+			e.doc, e.err = resolveDID(s.client, s.didWebScheme, did)
+			cache[did] = e
+		}
+
+		return e.doc, e.err
+	}
+}
+
+// countryCodes extracts the ISO 3166-1 alpha-2 country codes of locations, e.g., DE of the ISO 3166-2 subdivision
+// code DE-BE. Values that are not country or subdivision codes are ignored.
+func countryCodes(locations []string) (codes []string) {
+	seen := make(map[string]bool)
+	codes = []string{}
+
+	for _, l := range locations {
+		country, _, _ := strings.Cut(l, "-")
+		if len(country) != 2 || !isLetter(country[0]) || !isLetter(country[1]) {
+			continue
+		}
+
+		country = strings.ToUpper(country)
+		if !seen[country] {
+			seen[country] = true
+			codes = append(codes, country)
+		}
+	}
+
+	return
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
@@ -0,0 +1,386 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package gaiax
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/eclipse-xfsc/cam/api/collection"
+	"github.com/eclipse-xfsc/cam/api/common"
+	"github.com/eclipse-xfsc/cam/internal/testutil/testevaluation"
+	"github.com/eclipse-xfsc/cam/internal/testutil/testproto"
+)
+
+func TestMain(m *testing.M) {
+	// Mock the Evaluation Manager to stream evidences to
+	evaluationManagerServer, _, _ := testevaluation.StartBufConnServerToEvaluation()
+
+	code := m.Run()
+
+	evaluationManagerServer.Stop()
+
+	os.Exit(code)
+}
+
+// mockGaiaX is a local HTTP stand-in for the web server of a provider, which serves the DID document of the issuer
+// (did:web) as well as self-descriptions.
+type mockGaiaX struct {
+	*httptest.Server
+	issuer *mockIssuer
+	docs   map[string]document
+}
+
+func newMockGaiaX(t *testing.T) (m *mockGaiaX) {
+	m = &mockGaiaX{docs: make(map[string]document)}
+	m.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var v interface{}
+
+		if r.URL.Path == "/.well-known/did.json" {
+			v = m.issuer.didDocument()
+		} else if doc, ok := m.docs[r.URL.Path]; ok {
+			v = doc
+		} else {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(v)
+	}))
+	t.Cleanup(m.Close)
+
+	u, _ := url.Parse(m.URL)
+	m.issuer = newMockIssuer(t, "did:web:"+strings.ReplaceAll(u.Host, ":", "%3A"))
+
+	return
+}
+
+func TestServer_collect(t *testing.T) {
+	var (
+		now   = time.Now().Truncate(time.Second)
+		year  = now.Add(365 * 24 * time.Hour)
+		gaiax = newMockGaiaX(t)
+	)
+
+	newCredential := func(subject map[string]interface{}, expiration time.Time) document {
+		return document{
+			"@context":          []interface{}{"https://www.w3.org/2018/credentials/v1"},
+			"type":              []interface{}{"VerifiableCredential"},
+			"id":                gaiax.URL + "/credentials/" + subject["id"].(string),
+			"issuer":            gaiax.issuer.did,
+			"issuanceDate":      now.Add(-time.Hour).Format(time.RFC3339),
+			"expirationDate":    expiration.Format(time.RFC3339),
+			"credentialSubject": subject,
+		}
+	}
+
+	participant := map[string]interface{}{
+		"id":                         "lp1",
+		"type":                       "gx:LegalParticipant",
+		"gx:legalName":               "Provider",
+		"gx:legalRegistrationNumber": map[string]interface{}{"id": "lrn1"},
+		"gx:headquarterAddress":      map[string]interface{}{"gx:countrySubdivisionCode": "DE-BE"},
+		"gx:legalAddress":            map[string]interface{}{"gx:countrySubdivisionCode": "FR-75"},
+	}
+	offering := map[string]interface{}{
+		"id":                      "so1",
+		"type":                    "gx:ServiceOffering",
+		"gx:providedBy":           map[string]interface{}{"id": "lp1"},
+		"gx:policy":               "",
+		"gx:termsAndConditions":   map[string]interface{}{"gx:URL": "https://provider.example/tc"},
+		"gx:dataAccountExport":    map[string]interface{}{"gx:requestType": "API"},
+		"gx:dataProtectionRegime": "GDPR2016",
+	}
+
+	gaiax.docs["/valid.json"] = document{
+		"type": "VerifiablePresentation",
+		"verifiableCredential": []interface{}{
+			map[string]interface{}(gaiax.issuer.sign(t, newCredential(participant, year))),
+			map[string]interface{}(gaiax.issuer.sign(t, newCredential(offering, now.Add(10*24*time.Hour+time.Hour)))),
+		},
+	}
+	gaiax.docs["/expired.json"] = gaiax.issuer.sign(t, newCredential(offering, now.Add(-36*time.Hour)))
+	gaiax.docs["/unsigned.json"] = newCredential(offering, year)
+	gaiax.docs["/extra.json"] = func() document {
+		subject := make(map[string]interface{})
+		for k, v := range participant {
+			subject[k] = v
+		}
+
+		d := gaiax.issuer.sign(t, newCredential(subject, year))
+		subject["countryCode"] = "US"
+		return d
+	}()
+	gaiax.docs["/nonconforming.json"] = gaiax.issuer.sign(t, newCredential(map[string]interface{}{
+		"id": "so2", "type": "gx:ServiceOffering",
+	}, year))
+
+	tests := []struct {
+		name              string
+		config            *collection.GaiaXSelfDescriptionConfig
+		wantValid         bool
+		wantExpiresInDays int
+		wantLocations     []string
+		wantRegimes       []string
+		wantDetails       int
+		wantErr           *common.Error
+	}{
+		{
+			name:              "Valid presentation",
+			config:            &collection.GaiaXSelfDescriptionConfig{SelfDescription: gaiax.URL + "/valid.json"},
+			wantValid:         true,
+			wantExpiresInDays: 10,
+			wantLocations:     []string{"DE", "FR"},
+			wantRegimes:       []string{"GDPR2016"},
+		},
+		{
+			name: "Untrusted issuer",
+			config: &collection.GaiaXSelfDescriptionConfig{
+				SelfDescription: gaiax.URL + "/valid.json",
+				TrustedIssuers:  []string{"did:web:someone.else"},
+			},
+			wantValid:         false,
+			wantExpiresInDays: 10,
+			wantLocations:     []string{"DE", "FR"},
+			wantRegimes:       []string{"GDPR2016"},
+			wantDetails:       2,
+		},
+		{
+			name:              "Expired credential",
+			config:            &collection.GaiaXSelfDescriptionConfig{SelfDescription: gaiax.URL + "/expired.json"},
+			wantValid:         true,
+			wantExpiresInDays: -2,
+			wantLocations:     []string{},
+			wantRegimes:       []string{"GDPR2016"},
+			wantDetails:       1,
+		},
+		{
+			name:              "Unsigned credential",
+			config:            &collection.GaiaXSelfDescriptionConfig{SelfDescription: gaiax.URL + "/unsigned.json"},
+			wantValid:         false,
+			wantExpiresInDays: 365,
+			wantLocations:     []string{},
+			wantRegimes:       []string{},
+			wantDetails:       1,
+		},
+		{
+			name:              "Signed credential with unsigned property",
+			config:            &collection.GaiaXSelfDescriptionConfig{SelfDescription: gaiax.URL + "/extra.json"},
+			wantValid:         false,
+			wantExpiresInDays: 365,
+			wantLocations:     []string{},
+			wantRegimes:       []string{},
+			wantDetails:       1,
+		},
+		{
+			name:              "Credential does not conform to shapes",
+			config:            &collection.GaiaXSelfDescriptionConfig{SelfDescription: gaiax.URL + "/nonconforming.json"},
+			wantValid:         false,
+			wantExpiresInDays: 365,
+			wantLocations:     []string{},
+			wantRegimes:       []string{},
+			wantDetails:       4,
+		},
+		{
+			name:    "Self-description not found",
+			config:  &collection.GaiaXSelfDescriptionConfig{SelfDescription: gaiax.URL + "/unknown.json"},
+			wantErr: &common.Error{Code: common.Error_ERROR_CONNECTION_FAILURE},
+		},
+		{
+			// The DID document is no credential, so it is treated as an unsigned credential without subjects
+			name:          "Self-description is no credential",
+			config:        &collection.GaiaXSelfDescriptionConfig{SelfDescription: gaiax.URL + "/.well-known/did.json"},
+			wantValid:     false,
+			wantLocations: []string{},
+			wantRegimes:   []string{},
+			wantDetails:   1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewServer(WithDIDWebScheme("http"), WithHTTPClient(gaiax.Client())).(*Server)
+
+			evidence := s.collect("service1", tt.config, now)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr.Code, evidence.Error.GetCode())
+				return
+			}
+			assert.Nil(t, evidence.Error)
+
+			var value Value
+			b, err := evidence.Value.MarshalJSON()
+			assert.NoError(t, err)
+			assert.NoError(t, json.Unmarshal(b, &value))
+
+			cred := value.GaiaXCredential
+			assert.Equal(t, tt.wantValid, cred.Valid)
+			if tt.wantExpiresInDays != 0 {
+				assert.Equal(t, tt.wantExpiresInDays, *cred.ExpiresInDays)
+			} else {
+				assert.Nil(t, cred.ExpiresInDays)
+			}
+			assert.Equal(t, tt.wantLocations, cred.Locations)
+			assert.Equal(t, tt.wantRegimes, cred.DataProtectionRegimes)
+
+			var details []string
+			for _, c := range cred.Credentials {
+				details = append(details, c.Details...)
+			}
+			assert.Equal(t, tt.wantDetails, len(details), strings.Join(details, "\n"))
+		})
+	}
+}
+
+func Test_checkConfiguration(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *collection.GaiaXSelfDescriptionConfig
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "Missing self-description",
+			config:  &collection.GaiaXSelfDescriptionConfig{},
+			wantErr: assert.Error,
+		},
+		{
+			name:    "Self-description is no HTTP URL",
+			config:  &collection.GaiaXSelfDescriptionConfig{SelfDescription: "file:///etc/passwd"},
+			wantErr: assert.Error,
+		},
+		{
+			name: "Trusted issuer is no DID",
+			config: &collection.GaiaXSelfDescriptionConfig{
+				SelfDescription: "https://provider.example/sd.json",
+				TrustedIssuers:  []string{"https://issuer.example"},
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "Valid configuration",
+			config: &collection.GaiaXSelfDescriptionConfig{
+				SelfDescription: "https://provider.example/sd.json",
+				TrustedIssuers:  []string{"did:web:issuer.example"},
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := checkConfiguration(&collection.StartCollectingRequest{
+				Configuration: &collection.ServiceConfiguration{RawConfiguration: testproto.NewAny(t, tt.config)},
+			})
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestStartCollecting(t *testing.T) {
+	gaiax := newMockGaiaX(t)
+
+	tests := []struct {
+		name     string
+		config   *collection.GaiaXSelfDescriptionConfig
+		wantCode codes.Code
+	}{
+		{
+			name:     "Invalid configuration",
+			config:   &collection.GaiaXSelfDescriptionConfig{},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Collect Success",
+			config:   &collection.GaiaXSelfDescriptionConfig{SelfDescription: gaiax.URL + "/sd.json"},
+			wantCode: codes.OK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewServer(WithAdditionalGRPCOpts(grpc.WithTransportCredentials(insecure.NewCredentials()),
+				grpc.WithContextDialer(testevaluation.BufConnDialer)), WithDIDWebScheme("http"))
+
+			res, err := s.StartCollecting(context.Background(), &collection.StartCollectingRequest{
+				ServiceId:   "service1",
+				EvalManager: "bufnet",
+				Configuration: &collection.ServiceConfiguration{
+					ServiceId:        "service1",
+					RawConfiguration: testproto.NewAny(t, tt.config),
+				},
+			})
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if err == nil {
+				assert.NotEmpty(t, res.Id)
+			}
+		})
+	}
+}
+
+func TestServer_TestCollection(t *testing.T) {
+	gaiax := newMockGaiaX(t)
+	gaiax.docs["/sd.json"] = document{"type": "VerifiablePresentation", "verifiableCredential": []interface{}{
+		map[string]interface{}{"type": []interface{}{"VerifiableCredential"}, "credentialSubject": map[string]interface{}{}},
+	}}
+
+	tests := []struct {
+		name       string
+		config     *collection.GaiaXSelfDescriptionConfig
+		wantChecks []string
+		wantCode   codes.Code
+	}{
+		{
+			name:     "Invalid configuration",
+			config:   &collection.GaiaXSelfDescriptionConfig{SelfDescription: "sd.json"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Self-description not found",
+			config:   &collection.GaiaXSelfDescriptionConfig{SelfDescription: gaiax.URL + "/missing.json"},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:   "Self-description retrieved",
+			config: &collection.GaiaXSelfDescriptionConfig{SelfDescription: gaiax.URL + "/sd.json"},
+			wantChecks: []string{
+				fmt.Sprintf("retrieved self-description with 1 credential(s) from %s/sd.json", gaiax.URL)},
+			wantCode: codes.OK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewServer()
+
+			res, err := s.TestCollection(context.Background(), &collection.TestCollectionRequest{
+				ServiceId:     "00000000-0000-0000-0000-000000000000",
+				Configuration: &collection.ServiceConfiguration{RawConfiguration: testproto.NewAny(t, tt.config)},
+			})
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantChecks, res.GetChecks())
+		})
+	}
+}
@@ -0,0 +1,221 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package gaiax
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// defaultShapes contains the SHACL shapes of the Gaia-X trust framework for participants and service offerings
+//
+//go:embed shapes.json
+var defaultShapes []byte
+
+// Shapes are SHACL node shapes in compacted JSON-LD. Only a subset of SHACL is supported, i.e., node shapes with
+// sh:targetClass and property shapes with sh:path, sh:minCount, sh:maxCount, sh:datatype and sh:in, all other
+// constraints are ignored. Classes and paths are compared by their local name, so that the shapes do not depend on the
+// prefixes used by a self-description.
+type Shapes struct {
+	nodes []nodeShape
+}
+
+type nodeShape struct {
+	targetClass string
+	properties  []propertyShape
+}
+
+type propertyShape struct {
+	path     string
+	minCount *int
+	maxCount *int
+	datatype string
+	in       []interface{}
+}
+
+// LoadShapes parses SHACL shapes in compacted JSON-LD, either a single node shape, a list of node shapes or a
+// document with a @graph.
+func LoadShapes(b []byte) (shapes *Shapes, err error) {
+	var v interface{}
+
+	if err = json.Unmarshal(b, &v); err != nil {
+		return nil, fmt.Errorf("shapes are not a JSON-LD document: %w", err)
+	}
+	if m, ok := v.(map[string]interface{}); ok && m["@graph"] != nil {
+		v = m["@graph"]
+	}
+
+	shapes = new(Shapes)
+	for _, n := range list(v) {
+		m, ok := n.(map[string]interface{})
+		if !ok || !contains(document(m).types(), "NodeShape") {
+			continue
+		}
+
+		node := nodeShape{targetClass: localName(str(m["sh:targetClass"]))}
+		for _, p := range list(m["sh:property"]) {
+			pm, ok := p.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			node.properties = append(node.properties, propertyShape{
+				path:     localName(str(pm["sh:path"])),
+				minCount: count(pm["sh:minCount"]),
+				maxCount: count(pm["sh:maxCount"]),
+				datatype: localName(str(pm["sh:datatype"])),
+				in:       list(pm["sh:in"]),
+			})
+		}
+		shapes.nodes = append(shapes.nodes, node)
+	}
+
+	if len(shapes.nodes) == 0 {
+		return nil, fmt.Errorf("no sh:NodeShape found")
+	}
+
+	return
+}
+
+// validate validates the credential subjects against the node shapes targeting their classes. It returns the
+// violations, i.e., the subjects conform, if no violation is returned.
+func (s *Shapes) validate(subjects []document) (violations []string) {
+	for _, subject := range subjects {
+		for _, node := range s.nodes {
+			if !contains(subject.types(), node.targetClass) {
+				continue
+			}
+
+			for _, p := range node.properties {
+				violations = append(violations, p.validate(subject)...)
+			}
+		}
+	}
+
+	return
+}
+
+func (p *propertyShape) validate(subject document) (violations []string) {
+	var values []interface{}
+
+	for k, v := range subject {
+		if localName(k) == p.path {
+			values = append(values, list(v)...)
+		}
+	}
+
+	violation := func(format string, a ...interface{}) {
+		violations = append(violations, fmt.Sprintf("%s: property %s: %s", subject.id(), p.path,
+			fmt.Sprintf(format, a...)))
+	}
+
+	if p.minCount != nil && len(values) < *p.minCount {
+		violation("less than %d values", *p.minCount)
+	}
+	if p.maxCount != nil && len(values) > *p.maxCount {
+		violation("more than %d values", *p.maxCount)
+	}
+
+	for _, v := range values {
+		if p.datatype != "" && !hasDatatype(v, p.datatype) {
+			violation("value %v is not of datatype %s", valueOf(v), p.datatype)
+		}
+		if len(p.in) > 0 && !isIn(p.in, v) {
+			violation("value %v is not in the list of allowed values", valueOf(v))
+		}
+	}
+
+	return
+}
+
+// hasDatatype checks the XML schema datatype of a (literal) value
+func hasDatatype(v interface{}, datatype string) bool {
+	v = valueOf(v)
+
+	switch datatype {
+	case "string", "anyURI":
+		_, ok := v.(string)
+		return ok
+	case "boolean":
+		_, ok := v.(bool)
+		return ok
+	case "integer":
+		f, ok := v.(float64)
+		return ok && f == float64(int64(f))
+	case "decimal", "float", "double":
+		_, ok := v.(float64)
+		return ok
+	case "dateTime":
+		s, ok := v.(string)
+		if !ok {
+			return false
+		}
+		_, err := time.Parse(time.RFC3339, s)
+		return err == nil
+	default:
+		// Other datatypes are not checked
+		return true
+	}
+}
+
+func isIn(allowed []interface{}, v interface{}) bool {
+	v = valueOf(v)
+
+	// Only literals can be compared
+	switch v.(type) {
+	case map[string]interface{}, []interface{}:
+		return false
+	}
+
+	for _, a := range allowed {
+		switch a := valueOf(a).(type) {
+		case map[string]interface{}, []interface{}:
+			continue
+		default:
+			if a == v {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// valueOf unwraps value objects ({"@value": ...}) and node references ({"@id": ...})
+func valueOf(v interface{}) interface{} {
+	if m, ok := v.(map[string]interface{}); ok {
+		if value, ok := m["@value"]; ok {
+			return value
+		}
+		if id, ok := m["@id"]; ok {
+			return id
+		}
+	}
+
+	return v
+}
+
+func count(v interface{}) *int {
+	f, ok := valueOf(v).(float64)
+	if !ok {
+		return nil
+	}
+
+	n := int(f)
+	return &n
+}
@@ -0,0 +1,94 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package gaiax
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShapes_validate(t *testing.T) {
+	shapes, err := LoadShapes(defaultShapes)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		subject        document
+		wantViolations []string
+	}{
+		{
+			name: "Conforming service offering",
+			subject: document{
+				"id":                      "so1",
+				"type":                    "gx:ServiceOffering",
+				"gx:providedBy":           map[string]interface{}{"id": "did:web:provider.example"},
+				"gx:policy":               "",
+				"gx:termsAndConditions":   map[string]interface{}{"gx:URL": "https://provider.example/tc"},
+				"gx:dataAccountExport":    map[string]interface{}{"gx:requestType": "API"},
+				"gx:dataProtectionRegime": []interface{}{"GDPR2016"},
+			},
+		},
+		{
+			name: "Service offering with missing properties",
+			subject: document{
+				"id":                      "so2",
+				"type":                    "gx:ServiceOffering",
+				"gx:providedBy":           map[string]interface{}{"id": "did:web:provider.example"},
+				"gx:policy":               "",
+				"gx:termsAndConditions":   map[string]interface{}{"gx:URL": "https://provider.example/tc"},
+				"gx:dataProtectionRegime": "Unknown",
+			},
+			wantViolations: []string{
+				"so2: property dataAccountExport: less than 1 values",
+				"so2: property dataProtectionRegime: value Unknown is not in the list of allowed values",
+			},
+		},
+		{
+			name: "Participant with invalid legal name",
+			subject: document{
+				"id":                         "lp1",
+				"@type":                      []interface{}{"https://w3id.org/gaia-x/core#LegalParticipant"},
+				"gx:legalName":               map[string]interface{}{"@value": 42.0},
+				"gx:legalRegistrationNumber": map[string]interface{}{"id": "lrn1"},
+				"gx:headquarterAddress":      map[string]interface{}{"gx:countrySubdivisionCode": "DE-BE"},
+				"gx:legalAddress":            map[string]interface{}{"gx:countrySubdivisionCode": "DE-BE"},
+			},
+			wantViolations: []string{"lp1: property legalName: value 42 is not of datatype string"},
+		},
+		{
+			name:    "No shape for subject",
+			subject: document{"id": "x", "type": "gx:DataResource"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantViolations, shapes.validate([]document{tt.subject}))
+		})
+	}
+}
+
+func TestLoadShapes(t *testing.T) {
+	_, err := LoadShapes([]byte(`{"@graph": []}`))
+	assert.Error(t, err)
+
+	shapes, err := LoadShapes([]byte(`{"@type": "sh:NodeShape", "sh:targetClass": "ex:Thing",
+		"sh:property": {"sh:path": "ex:name", "sh:minCount": 1}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(shapes.nodes))
+	assert.Equal(t, "Thing", shapes.nodes[0].targetClass)
+	assert.Equal(t, "name", shapes.nodes[0].properties[0].path)
+}
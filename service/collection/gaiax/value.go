@@ -0,0 +1,61 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package gaiax
+
+import "clouditor.io/clouditor/voc"
+
+// Value represents the Value of an evidence in the case of the Gaia-X Self-Description CM
+type Value struct {
+	// Clouditor's Resource properties ID and Types have to be set that Evaluation will not fail
+	voc.Resource
+
+	// Properties of the GaiaXCredentialValidity, GaiaXCredentialExpiry, GaiaXServiceLocation and GaiaXDataProtection
+	// metrics
+	*GaiaXCredential `json:"gaiaXCredential,omitempty"`
+}
+
+type GaiaXCredential struct {
+	// Valid is true, if the proofs of all credentials (and the presentation) are valid, the issuers are trusted and the
+	// credential subjects conform to the shapes of the trust framework. The expiry is reported separately.
+	Valid bool `json:"valid"`
+
+	// ExpiresInDays is the number of days until the first credential expires. It is negative, if a credential has
+	// already expired and missing, if no credential has an expiration date.
+	ExpiresInDays *int `json:"expiresInDays,omitempty"`
+
+	// Locations contains the ISO 3166-1 alpha-2 country codes of the declared locations
+	Locations []string `json:"locations"`
+
+	// DataProtectionRegimes contains the declared data protection regimes, e.g., GDPR2016
+	DataProtectionRegimes []string `json:"dataProtectionRegimes"`
+
+	Credentials []CredentialResult `json:"credentials"`
+
+	// Details contains problems of the presentation itself, e.g., an invalid proof of the holder
+	Details []string `json:"details,omitempty"`
+}
+
+// CredentialResult contains the result of the verification of a single verifiable credential
+type CredentialResult struct {
+	ID             string   `json:"id,omitempty"`
+	Types          []string `json:"types"`
+	Issuer         string   `json:"issuer"`
+	ExpirationDate string   `json:"expirationDate,omitempty"`
+	ProofValid     bool     `json:"proofValid"`
+	Conforms       bool     `json:"conforms"`
+	Valid          bool     `json:"valid"`
+	Details        []string `json:"details,omitempty"`
+}
@@ -0,0 +1,209 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package integrity
+
+import (
+	ar "github.com/Fraunhofer-AISEC/cmc/attestationreport"
+)
+
+const (
+	tpmVerification = "TPM Verification"
+	snpVerification = "SNP Verification"
+	swVerification  = "SW Verification"
+)
+
+// kernelPcrs are the PCRs the boot loader extends with the kernel image, initrd and command line.
+var kernelPcrs = map[int]bool{8: true, 9: true}
+
+// measurements holds the measured values of an attestation report, indexed for look-up by verification.
+type measurements struct {
+	// tpm contains the measured hashes per PCR
+	tpm map[int]map[string]bool
+	// sw contains the measured hashes per software measurement name
+	sw map[string]string
+	// swVer maps the names of software verifications to the matched software measurement
+	swVer map[string]ar.SwMeasurementResult
+	// snp is set if an SNP measurement was verified
+	snp *ar.SnpMeasurementResult
+}
+
+func newMeasurements(result *ar.VerificationResult) (m *measurements) {
+	m = &measurements{
+		tpm:   make(map[int]map[string]bool),
+		sw:    make(map[string]string),
+		swVer: make(map[string]ar.SwMeasurementResult),
+		snp:   result.MeasResult.SnpMeasResult,
+	}
+
+	if result.PlainAttReport.TpmM != nil {
+		for _, elem := range result.PlainAttReport.TpmM.HashChain {
+			if elem == nil {
+				continue
+			}
+
+			pcr := int(elem.Pcr)
+			if m.tpm[pcr] == nil {
+				m.tpm[pcr] = make(map[string]bool)
+			}
+			for _, hash := range elem.Sha256 {
+				m.tpm[pcr][hash] = true
+			}
+		}
+	}
+
+	for _, swm := range result.PlainAttReport.SWM {
+		m.sw[swm.Name] = swm.Sha256
+	}
+
+	for _, res := range result.MeasResult.SwMeasResult {
+		if res.VerName != "" {
+			m.swVer[res.VerName] = res
+		}
+	}
+
+	return
+}
+
+// compare compares the reference value of the verification v with the corresponding measured value.
+func (m *measurements) compare(v ar.Verification) (meas Measurement) {
+	meas = Measurement{
+		Name:      v.Name,
+		Pcr:       v.Pcr,
+		Reference: v.Sha256,
+	}
+
+	switch v.Type {
+	case tpmVerification:
+		if v.Pcr != nil && m.tpm[*v.Pcr][v.Sha256] {
+			meas.Measured = v.Sha256
+			meas.Success = true
+		}
+	case swVerification:
+		if res, ok := m.swVer[v.Name]; ok {
+			meas.Measured = m.sw[res.MeasName]
+			meas.Success = res.Validation.Success
+		}
+	case snpVerification:
+		meas.Reference = v.Sha384
+		// The SNP report contains only a single launch measurement, which either matches or not
+		if m.snp != nil && m.snp.MeasurementMatch.Success {
+			meas.Measured = v.Sha384
+			meas.Success = true
+		}
+	}
+
+	return
+}
+
+// components creates the per-component results of the verification result. The RTM manifest describes the
+// firmware, the OS manifest the kernel (measured into the kernel PCRs) and the remaining OS, and each app
+// manifest an application.
+func components(result *ar.VerificationResult) (comps []Component) {
+	var (
+		m     = newMeasurements(result)
+		plain = result.PlainAttReport
+	)
+
+	comps = append(comps, newComponent(ComponentFirmware, plain.RtmManifest.Name, plain.RtmManifest.Version,
+		plain.RtmManifest.Verifications, &result.RtmResult, m))
+
+	var kernelVers, osVers []ar.Verification
+	for _, v := range plain.OsManifest.Verifications {
+		if v.Type == tpmVerification && v.Pcr != nil && kernelPcrs[*v.Pcr] {
+			kernelVers = append(kernelVers, v)
+		} else {
+			osVers = append(osVers, v)
+		}
+	}
+	if len(kernelVers) > 0 {
+		comps = append(comps, newComponent(ComponentKernel, plain.OsManifest.Name, plain.OsManifest.Version,
+			kernelVers, &result.OsResult, m))
+	}
+	comps = append(comps, newComponent(ComponentOS, plain.OsManifest.Name, plain.OsManifest.Version,
+		osVers, &result.OsResult, m))
+
+	for _, app := range plain.AppManifests {
+		comps = append(comps, newComponent(ComponentApplication, app.Name, app.Version, app.Verifications,
+			appResult(result, app.Name), m))
+	}
+
+	return
+}
+
+// newComponent creates a component result out of the verifications of a manifest and its manifest result.
+// The manifest result is nil, if the manifest was not validated at all.
+func newComponent(kind string, name string, version string, verifications []ar.Verification,
+	res *ar.ManifestResult, m *measurements) (c Component) {
+	c = Component{
+		Name:         name,
+		Kind:         kind,
+		Version:      version,
+		Measurements: []Measurement{},
+		PolicyResult: res != nil,
+	}
+
+	if res == nil {
+		c.Details = append(c.Details, "manifest was not validated")
+		return
+	}
+
+	// The certificate chain is valid, if all signatures have been created by a trusted certificate
+	c.CertChainValid = len(res.SignatureCheck) > 0
+	for _, sig := range res.SignatureCheck {
+		if !sig.CertCheck.Success {
+			c.CertChainValid = false
+			c.Details = append(c.Details, sig.CertCheck.Details)
+		}
+		if !sig.Signature.Success {
+			c.PolicyResult = false
+			c.Details = append(c.Details, sig.Signature.Details)
+		}
+	}
+
+	if !res.ValidityCheck.Success {
+		c.PolicyResult = false
+		c.Details = append(c.Details, res.ValidityCheck.Details)
+	}
+
+	if !res.Summary.Success {
+		c.PolicyResult = false
+		c.Details = append(c.Details, res.Summary.Details...)
+	}
+
+	for _, v := range verifications {
+		meas := m.compare(v)
+		if !meas.Success {
+			c.PolicyResult = false
+		}
+
+		c.Measurements = append(c.Measurements, meas)
+	}
+
+	c.PolicyResult = c.PolicyResult && c.CertChainValid
+
+	return
+}
+
+// appResult returns the manifest result of the app with the given name or nil, if there is none.
+func appResult(result *ar.VerificationResult, name string) *ar.ManifestResult {
+	for i := range result.AppResults {
+		if result.AppResults[i].Name == name {
+			return &result.AppResults[i]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package integrity
+
+import (
+	"testing"
+
+	ar "github.com/Fraunhofer-AISEC/cmc/attestationreport"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_components(t *testing.T) {
+	var (
+		pcr0  = 0
+		pcr8  = 8
+		pcr10 = 10
+	)
+
+	validSignature := []ar.SignatureResult{{
+		Signature: ar.Result{Success: true},
+		CertCheck: ar.Result{Success: true},
+	}}
+	validManifest := ar.ManifestResult{
+		Summary:        ar.ResultMulti{Success: true},
+		SignatureCheck: validSignature,
+		ValidityCheck:  ar.Result{Success: true},
+	}
+
+	type args struct {
+		result *ar.VerificationResult
+	}
+	tests := []struct {
+		name string
+		args args
+		want []Component
+	}{
+		{
+			name: "Firmware, kernel and app are fine, OS measurement is missing",
+			args: args{
+				result: &ar.VerificationResult{
+					RtmResult: validManifest,
+					OsResult:  validManifest,
+					AppResults: []ar.ManifestResult{
+						{
+							Name:           "app",
+							Summary:        ar.ResultMulti{Success: true},
+							SignatureCheck: validSignature,
+							ValidityCheck:  ar.Result{Success: true},
+						},
+					},
+					MeasResult: ar.MeasurementResult{
+						SwMeasResult: []ar.SwMeasurementResult{
+							{MeasName: "app-binary", VerName: "app-ref", Validation: ar.Result{Success: true}},
+						},
+					},
+					PlainAttReport: ar.ArPlain{
+						TpmM: &ar.TpmMeasurement{
+							HashChain: []*ar.HashChainElem{
+								{Pcr: 0, Sha256: []string{"aa"}},
+								{Pcr: 8, Sha256: []string{"bb"}},
+							},
+						},
+						SWM: []ar.SwMeasurement{{Name: "app-binary", Sha256: "dd"}},
+						RtmManifest: ar.RtmManifest{
+							Name:          "rtm",
+							Verifications: []ar.Verification{{Type: tpmVerification, Name: "bios", Pcr: &pcr0, Sha256: "aa"}},
+						},
+						OsManifest: ar.OsManifest{
+							Name: "os",
+							Verifications: []ar.Verification{
+								{Type: tpmVerification, Name: "kernel", Pcr: &pcr8, Sha256: "bb"},
+								{Type: tpmVerification, Name: "rootfs", Pcr: &pcr10, Sha256: "cc"},
+							},
+						},
+						AppManifests: []ar.AppManifest{
+							{
+								Name:          "app",
+								Verifications: []ar.Verification{{Type: swVerification, Name: "app-ref", Sha256: "dd"}},
+							},
+						},
+					},
+				},
+			},
+			want: []Component{
+				{
+					Name: "rtm", Kind: ComponentFirmware, CertChainValid: true, PolicyResult: true,
+					Measurements: []Measurement{{Name: "bios", Pcr: &pcr0, Measured: "aa", Reference: "aa", Success: true}},
+				},
+				{
+					Name: "os", Kind: ComponentKernel, CertChainValid: true, PolicyResult: true,
+					Measurements: []Measurement{{Name: "kernel", Pcr: &pcr8, Measured: "bb", Reference: "bb", Success: true}},
+				},
+				{
+					Name: "os", Kind: ComponentOS, CertChainValid: true, PolicyResult: false,
+					Measurements: []Measurement{{Name: "rootfs", Pcr: &pcr10, Measured: "", Reference: "cc", Success: false}},
+				},
+				{
+					Name: "app", Kind: ComponentApplication, CertChainValid: true, PolicyResult: true,
+					Measurements: []Measurement{{Name: "app-ref", Measured: "dd", Reference: "dd", Success: true}},
+				},
+			},
+		},
+		{
+			name: "Invalid certificate chain and unvalidated app manifest",
+			args: args{
+				result: &ar.VerificationResult{
+					RtmResult: ar.ManifestResult{
+						Summary: ar.ResultMulti{Success: true},
+						SignatureCheck: []ar.SignatureResult{{
+							Signature: ar.Result{Success: true},
+							CertCheck: ar.Result{Success: false, Details: "unknown authority"},
+						}},
+						ValidityCheck: ar.Result{Success: true},
+					},
+					OsResult: validManifest,
+					PlainAttReport: ar.ArPlain{
+						RtmManifest:  ar.RtmManifest{Name: "rtm"},
+						OsManifest:   ar.OsManifest{Name: "os"},
+						AppManifests: []ar.AppManifest{{Name: "app"}},
+					},
+				},
+			},
+			want: []Component{
+				{
+					Name: "rtm", Kind: ComponentFirmware, CertChainValid: false, PolicyResult: false,
+					Measurements: []Measurement{}, Details: []string{"unknown authority"},
+				},
+				{
+					Name: "os", Kind: ComponentOS, CertChainValid: true, PolicyResult: true,
+					Measurements: []Measurement{},
+				},
+				{
+					Name: "app", Kind: ComponentApplication, CertChainValid: false, PolicyResult: false,
+					Measurements: []Measurement{}, Details: []string{"manifest was not validated"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, components(tt.args.result))
+		})
+	}
+}
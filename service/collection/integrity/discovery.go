@@ -0,0 +1,125 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package integrity
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	clapi "clouditor.io/clouditor/api"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc"
+
+	"github.com/eclipse-xfsc/cam/api/common"
+	"github.com/eclipse-xfsc/cam/api/evaluation"
+	"github.com/eclipse-xfsc/cam/internal/config"
+)
+
+const (
+	// DefaultDiscoveryPort is the port of the attestation interface on discovered virtual machines, if none is
+	// configured.
+	DefaultDiscoveryPort = 9955
+
+	// discoveryDays is the period of time (in days) of workload evidences that are considered for discovery.
+	discoveryDays = 1
+)
+
+// discoverTargets discovers attestation targets from the virtual machines, which the workload collection module has
+// reported to the evaluation manager for the given service. Evidences of other collection modules are ignored, in
+// particular the ones of this module, which describe the attested virtual machines as well.
+func (s *Server) discoverTargets(evalManager string, serviceID string, port uint32) (targets []string, err error) {
+	var (
+		conn *grpc.ClientConn
+		res  *evaluation.ListEvidencesResponse
+		req  = &evaluation.ListEvidencesRequest{ServiceId: serviceID, Days: discoveryDays}
+	)
+
+	if port == 0 {
+		port = DefaultDiscoveryPort
+	}
+
+	conn, err = grpc.Dial(evalManager, clapi.DefaultGrpcDialOptions(evalManager, s, s.grpcOpts...)...)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to evaluation manager: %w", err)
+	}
+	defer conn.Close()
+
+	client := evaluation.NewEvaluationClient(conn)
+
+	// Loop through all pages of evidences
+	for {
+		res, err = client.ListEvidences(context.Background(), req)
+		if err != nil {
+			return nil, fmt.Errorf("could not list evidences: %w", err)
+		}
+
+		for _, e := range res.Evidences {
+			if e.ToolId != config.DefaultCollectionWorkloadID {
+				continue
+			}
+
+			host := vmHost(e)
+			if host == "" {
+				continue
+			}
+
+			target := targetAddress(host, port)
+			if !slices.Contains(targets, target) {
+				targets = append(targets, target)
+			}
+		}
+
+		if res.NextPageToken == "" {
+			break
+		}
+		req.PageToken = res.NextPageToken
+	}
+
+	log.Debugf("Discovered %d attestation target(s) for service %s", len(targets), serviceID)
+
+	return
+}
+
+// vmHost returns the host name of the virtual machine described by the evidence or an empty string, if the evidence
+// does not describe a virtual machine.
+func vmHost(e *common.Evidence) string {
+	types, err := e.ResourceTypes()
+	if err != nil || !slices.Contains(types, "VirtualMachine") {
+		return ""
+	}
+
+	m := e.Value.GetStructValue().AsMap()
+
+	// Prefer the name of the virtual machine, since the ID is usually a provider-specific identifier
+	if name, ok := m["name"].(string); ok && name != "" {
+		return name
+	}
+
+	id, _ := m["id"].(string)
+	return id
+}
+
+// targetAddress returns the address of the attestation interface on the host. A port contained in the host is replaced.
+func targetAddress(host string, port uint32) string {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	return net.JoinHostPort(strings.Trim(host, "[]"), strconv.FormatUint(uint64(port), 10))
+}
@@ -20,6 +20,9 @@ import (
 	"crypto/rand"
 	"encoding/json"
 	"fmt"
+	"net"
+	"sync"
+	"time"
 
 	clapi "clouditor.io/clouditor/api"
 	"clouditor.io/clouditor/voc"
@@ -37,9 +40,23 @@ import (
 	"github.com/eclipse-xfsc/cam/api/collection"
 	apicollection "github.com/eclipse-xfsc/cam/api/collection"
 	"github.com/eclipse-xfsc/cam/api/common"
+	"github.com/eclipse-xfsc/cam/api/configuration"
 	"github.com/eclipse-xfsc/cam/api/evaluation"
 	"github.com/eclipse-xfsc/cam/internal/config"
 	"github.com/eclipse-xfsc/cam/service"
+	servicecollection "github.com/eclipse-xfsc/cam/service/collection"
+)
+
+const (
+	// DefaultMaxParallelAttestations is the default number of targets which are attested concurrently
+	DefaultMaxParallelAttestations = 8
+
+	// DefaultRequirementsManagerAddress is the default address of the requirements manager, which provides the
+	// attestation trust store
+	DefaultRequirementsManagerAddress = "localhost:50100"
+
+	// testDialTimeout is the time a target has to accept a connection, when a configuration is tested
+	testDialTimeout = 5 * time.Second
 )
 
 var (
@@ -54,7 +71,20 @@ type Server struct {
 	streams  *clapi.StreamsOf[evaluation.Evaluation_SendEvidencesClient, *common.Evidence]
 	grpcOpts []grpc.DialOption
 
+	// maxParallel is the maximum number of targets that are attested concurrently
+	maxParallel int
+
+	// reqManagerAddress is the gRPC address of the requirements manager, which provides the attestation trust store
+	reqManagerAddress string
+
+	// cachedTrustStore is the last retrieved attestation trust store
+	cachedTrustStore *trustStore
+	trustStoreMutex  sync.Mutex
+
 	authorizer clapi.Authorizer
+
+	// signer signs the evidences, if configured
+	signer *servicecollection.Signer
 }
 
 // WithAdditionalGRPCOpts is an option to configure additional gRPC options.
@@ -64,6 +94,28 @@ func WithAdditionalGRPCOpts(opts ...grpc.DialOption) service.ServiceOption[Serve
 	}
 }
 
+// WithMaxParallelAttestations is an option to configure the maximum number of targets that are attested concurrently.
+func WithMaxParallelAttestations(n int) service.ServiceOption[Server] {
+	return func(s *Server) {
+		s.maxParallel = n
+	}
+}
+
+// WithRequirementsManagerAddress is an option to configure the address of the requirements manager, which provides
+// the attestation trust store.
+func WithRequirementsManagerAddress(address string) service.ServiceOption[Server] {
+	return func(s *Server) {
+		s.reqManagerAddress = address
+	}
+}
+
+// WithSigner is an option to sign the evidences with the signer, so that the Evaluation Manager can verify them
+func WithSigner(signer *servicecollection.Signer) service.ServiceOption[Server] {
+	return func(s *Server) {
+		s.signer = signer
+	}
+}
+
 // WithOAuth2Authorizer is an option to use an OAuth 2.0 authorizer
 func WithOAuth2Authorizer(config *clientcredentials.Config) service.ServiceOption[Server] {
 	return func(srv *Server) {
@@ -83,7 +135,8 @@ func (srv *Server) Authorizer() clapi.Authorizer {
 
 func NewServer(opts ...service.ServiceOption[Server]) apicollection.CollectionServer {
 	s := &Server{
-		streams: clapi.NewStreamsOf(clapi.WithLogger[evaluation.Evaluation_SendEvidencesClient, *common.Evidence](log)),
+		streams:           clapi.NewStreamsOf(clapi.WithLogger[evaluation.Evaluation_SendEvidencesClient, *common.Evidence](log)),
+		reqManagerAddress: DefaultRequirementsManagerAddress,
 	}
 
 	// Apply any options
@@ -94,7 +147,7 @@ func NewServer(opts ...service.ServiceOption[Server]) apicollection.CollectionSe
 	return s
 }
 
-func (s *Server) StartCollecting(_ context.Context, req *apicollection.StartCollectingRequest) (
+func (s *Server) StartCollecting(ctx context.Context, req *apicollection.StartCollectingRequest) (
 	res *apicollection.StartCollectingResponse, err error) {
 	log.Infof("Received StartCollecting Request for Service ID %v", req.ServiceId)
 
@@ -104,78 +157,294 @@ func (s *Server) StartCollecting(_ context.Context, req *apicollection.StartColl
 		return nil, status.Errorf(codes.InvalidArgument, apicollection.ErrInvalidRemoteIntegrityRawConfiguration.Error())
 	}
 
-	capem := []byte(rawConfig.Certificate)
+	// The certificate can be referenced instead of being contained in the configuration. If it cannot be resolved, no
+	// targets can be attested.
+	if _, err = servicecollection.ResolveSecretRefs(ctx, req.ServiceId, &rawConfig); err != nil {
+		log.Warnf("Invalid configuration of service %s: %v", req.ServiceId, err)
+		return s.reportInvalidConfiguration(ctx, req, err)
+	}
 
-	// Collecting integrity information from external service requires nonce
-	// to avoid replay attacks
-	nonce := make([]byte, 8)
-	_, err = rand.Read(nonce)
+	start := time.Now()
+	targets, err := s.targets(req, &rawConfig)
+	servicecollection.ObserveDiscoverer(ComponentID, "targets", start, err)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to generate random bytes (%v)", err)
+		return nil, status.Errorf(codes.Internal, "could not discover targets of service %v: %v", req.ServiceId, err)
+	}
+	if len(targets) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, apicollection.ErrMissingRemoteIntegrityTargets.Error())
 	}
 
-	log.Tracef("Collecting integrity information from service: %v\n", req.ServiceId)
-	ar, err := Collect(rawConfig.Target, nonce)
+	ts, err := s.verificationTrustStore(&rawConfig)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to collect information from service %v: %v",
-			req.ServiceId, err)
+		return nil, status.Errorf(codes.Unavailable, "%v", err)
 	}
-	log.Tracef("Collected integrity information from service: %v\n", req.ServiceId)
 
-	result := attestationreport.Verify(string(ar), nonce, capem, nil)
-	if !result.Success {
-		log.Tracef("Verification of integrity information failed - Service is not trustworthy")
+	log.Tracef("Sending evidences to eval manager %v", req.EvalManager)
+
+	// Get stream for the Evaluation Manager
+	component := "Evaluation Manager"
+	stream, err := s.streams.GetStream(req.EvalManager, component, api.InitEvalStream,
+		clapi.DefaultGrpcDialOptions(req.EvalManager, s, s.grpcOpts...)...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
 	}
 
-	rawEvidence, err := json.Marshal(result)
+	// Attest the targets in a separate goroutine. StartCollecting will return and problems with individual targets
+	// are reported to the evaluation manager
+	ctx, done := servicecollection.TrackCollection(ctx, ComponentID, req.RunId)
+	go func() {
+		defer done()
+
+		s.attestTargets(req.ServiceId, targets, ts, func(evidence *common.Evidence) {
+			// Send evidence to stream via channel
+			evidence.TenantId = req.TenantId
+			if err := servicecollection.SignAndSend(ctx, stream, s.signer, evidence); err != nil {
+				log.Errorf("Could not send evidence %s: %v", evidence.Id, err)
+				return
+			}
+			log.Infof("Sending evidence '%s' to evaluation manager stream", evidence.Id)
+		})
+	}()
+
+	res = &apicollection.StartCollectingResponse{
+		Id: uuid.NewString(),
+	}
+	return
+}
+
+// reportInvalidConfiguration sends an evidence to the evaluation manager, which reports that the configuration of the
+// service is invalid
+func (s *Server) reportInvalidConfiguration(ctx context.Context, req *apicollection.StartCollectingRequest,
+	cause error) (res *apicollection.StartCollectingResponse, err error) {
+	stream, err := s.streams.GetStream(req.EvalManager, "Evaluation Manager", api.InitEvalStream,
+		clapi.DefaultGrpcDialOptions(req.EvalManager, s, s.grpcOpts...)...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to marshal integrity result: %v", err)
+		return nil, status.Errorf(codes.Internal, "%v", err)
 	}
 
-	value := Value{
-		Resource: voc.Resource{
-			// ID and Type has to be set. Otherwise, evaluation will fail due to evidence validation
-			ID:   "JustNotEmpty",
-			Type: []string{"SomeType"},
-		},
-		SystemComponentsIntegrity: SystemComponentsIntegrity{Status: result.Success},
+	evidence := servicecollection.InvalidConfigurationEvidence(ComponentID, req.ServiceId, req.ServiceId, cause)
+	evidence.TenantId = req.TenantId
+	evidence.RunId = req.RunId
+	if err = servicecollection.SignAndSend(ctx, stream, s.signer, evidence); err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
 	}
-	evidenceValue, err := toStruct(value)
+
+	return &apicollection.StartCollectingResponse{Id: uuid.NewString()}, nil
+}
+
+// TestCollection checks the configuration, resolves its certificate reference, retrieves the attestation trust store
+// and checks that the configured targets accept connections, without attesting them. Targets, which would be
+// discovered, are not checked, since their discovery relies on the evidences of the evaluation manager.
+func (s *Server) TestCollection(ctx context.Context, req *apicollection.TestCollectionRequest) (
+	res *apicollection.TestCollectionResponse, err error) {
+	var rawConfig collection.RemoteIntegrityConfig
+
+	log.Infof("Received TestCollection Request for Service ID %v", req.ServiceId)
+
+	if err = req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	err = req.Configuration.RawConfiguration.UnmarshalTo(&rawConfig)
 	if err != nil {
-		err = fmt.Errorf("could not convert struct to structpb.Value: %w", err)
-		log.Error(err)
+		return nil, status.Errorf(codes.InvalidArgument, apicollection.ErrInvalidRemoteIntegrityRawConfiguration.Error())
 	}
 
-	log.Tracef("Sending evidences to eval manager %v", req.EvalManager)
+	resolved, err := servicecollection.ResolveSecretRefs(ctx, req.ServiceId, &rawConfig)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
 
-	// Get stream for the Evaluation Manager
-	component := "Evaluation Manager"
-	stream, err := s.streams.GetStream(req.EvalManager, component, api.InitEvalStream,
-		clapi.DefaultGrpcDialOptions(req.EvalManager, s, s.grpcOpts...)...)
+	res = &apicollection.TestCollectionResponse{Checks: servicecollection.ResolvedChecks(resolved)}
+
+	if _, err = s.verificationTrustStore(&rawConfig); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	res.Checks = append(res.Checks, "retrieved attestation trust store")
+
+	rawConfig.Discovery = nil
+	targets, _ := s.targets(req.StartCollectingRequest(), &rawConfig)
+	for _, target := range targets {
+		conn, err := net.DialTimeout("tcp", target, testDialTimeout)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "could not reach target %s: %v", target, err)
+		}
+		_ = conn.Close()
+
+		res.Checks = append(res.Checks, fmt.Sprintf("reached target %s", target))
+	}
+
+	return
+}
+
+// verificationTrustStore returns the attestation trust store of the requirements manager. The certificate of the
+// service configuration is deprecated, but still trusted in addition to the trust anchors of the trust store. If the
+// trust store cannot be retrieved, the certificate of the service configuration is used on its own.
+func (s *Server) verificationTrustStore(rawConfig *collection.RemoteIntegrityConfig) (ts *trustStore, err error) {
+	if rawConfig.Certificate != "" {
+		log.Warn("The certificate of the remote integrity configuration is deprecated, use attestation trust " +
+			"anchors of the requirements manager instead")
+	}
+
+	ts, err = s.trustStore()
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "%v", err)
+		if rawConfig.Certificate == "" {
+			return nil, err
+		}
+
+		log.Errorf("Could not retrieve attestation trust store, using configured certificate only: %v", err)
+		ts = newTrustStore(&configuration.AttestationTrustStore{})
+	}
+
+	return ts.withCertificate(rawConfig.Certificate), nil
+}
+
+// targets returns the configured targets, including the targets which are discovered from the workload inventory
+// (if enabled). Duplicate targets are removed.
+func (s *Server) targets(req *apicollection.StartCollectingRequest, rawConfig *collection.RemoteIntegrityConfig) (
+	targets []string, err error) {
+	var (
+		candidates []string
+		discovered []string
+		seen       = make(map[string]bool)
+	)
+
+	if rawConfig.Target != "" {
+		candidates = append(candidates, rawConfig.Target)
+	}
+	candidates = append(candidates, rawConfig.Targets...)
+
+	if rawConfig.Discovery != nil {
+		discovered, err = s.discoverTargets(req.EvalManager, req.ServiceId, rawConfig.Discovery.Port)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, discovered...)
+	}
+
+	for _, target := range candidates {
+		if target == "" || seen[target] {
+			continue
+		}
+
+		seen[target] = true
+		targets = append(targets, target)
+	}
+
+	return
+}
+
+// attestTargets attests all targets concurrently, while at most maxParallel attestations are running at the same
+// time. One evidence per target is passed to send.
+func (s *Server) attestTargets(serviceID string, targets []string, ts *trustStore, send func(*common.Evidence)) {
+	var (
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, s.parallelism())
+	)
+
+	for _, target := range targets {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(target string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			send(attest(serviceID, target, ts))
+		}(target)
+	}
+
+	wg.Wait()
+}
+
+// parallelism returns the maximum number of concurrent attestations
+func (s *Server) parallelism() int {
+	if s.maxParallel <= 0 {
+		return DefaultMaxParallelAttestations
 	}
 
-	requestId := uuid.NewString()
+	return s.maxParallel
+}
 
-	evidence := &common.Evidence{
-		Id:             requestId,
-		Name:           requestId,
-		TargetService:  req.ServiceId,
-		TargetResource: result.PlainAttReport.DeviceDescription.Fqdn,
+// attest collects and verifies the attestation report of a single target and creates the corresponding evidence. If
+// the target could not be attested, the evidence contains the error. The report is verified against the trust anchors,
+// revoked certificates and reference manifests of the trust store.
+func attest(serviceID string, target string, ts *trustStore) (evidence *common.Evidence) {
+	evidenceID := uuid.NewString()
+
+	evidence = &common.Evidence{
+		Id:             evidenceID,
+		Name:           evidenceID,
+		TargetService:  serviceID,
+		TargetResource: target,
 		ToolId:         ComponentID,
 		GatheredAt:     timestamppb.Now(),
-		Value:          evidenceValue,
-		RawEvidence:    string(rawEvidence),
 	}
 
-	// Send evidence to stream via channel
-	stream.Send(evidence)
-	log.Infof("Sending evidence '%s' to evaluation manager stream", evidence.Id)
+	// Collecting integrity information from external service requires nonce
+	// to avoid replay attacks
+	nonce := make([]byte, 8)
+	_, err := rand.Read(nonce)
+	if err != nil {
+		evidence.Error = &common.Error{
+			Code:        common.Error_ERROR_UNKNOWN,
+			Description: fmt.Sprintf("failed to generate random bytes: %v", err),
+		}
+		return
+	}
 
-	res = &apicollection.StartCollectingResponse{
-		Id: requestId,
+	log.Tracef("Collecting integrity information from target %v of service %v", target, serviceID)
+	ar, err := Collect(target, nonce)
+	if err != nil {
+		log.Errorf("Failed to collect information from target %v of service %v: %v", target, serviceID, err)
+		evidence.Error = &common.Error{
+			Code:        common.Error_ERROR_CONNECTION_FAILURE,
+			Description: fmt.Sprintf("failed to collect information from target %v: %v", target, err),
+		}
+		return
+	}
+	log.Tracef("Collected integrity information from target %v of service %v", target, serviceID)
+
+	result := attestationreport.Verify(string(ar), nonce, ts.capem, nil)
+	comps := components(&result)
+	result.Success = ts.check(&result, comps) && result.Success
+	if !result.Success {
+		log.Tracef("Verification of integrity information of target %v failed - Target is not trustworthy", target)
 	}
+
+	rawEvidence, err := json.Marshal(result)
+	if err != nil {
+		log.Errorf("Failed to marshal integrity result: %v", err)
+	} else {
+		evidence.RawEvidence = string(rawEvidence)
+	}
+
+	// The attested device is identified by its FQDN. If the device description is missing, we fall back to the
+	// target so that the evidence can still be assigned to a resource
+	resourceID := result.PlainAttReport.DeviceDescription.Fqdn
+	if resourceID == "" {
+		resourceID = target
+	}
+	evidence.TargetResource = resourceID
+
+	value := Value{
+		Resource: voc.Resource{
+			ID:   voc.ResourceID(resourceID),
+			Type: []string{"VirtualMachine", "Compute", "Resource"},
+		},
+		SystemComponentsIntegrity: SystemComponentsIntegrity{
+			Status:     result.Success,
+			Components: comps,
+		},
+	}
+	evidence.Value, err = toStruct(value)
+	if err != nil {
+		err = fmt.Errorf("could not convert struct to structpb.Value: %w", err)
+		log.Error(err)
+	}
+
 	return
 }
 
@@ -184,3 +453,8 @@ func (s *Server) StopCollecting(_ context.Context, req *apicollection.StopCollec
 
 	return nil, status.Error(codes.Unimplemented, "StopCollection not implemented")
 }
+
+// Describe returns the metrics and the configuration type supported by this collection module
+func (*Server) Describe(_ context.Context, _ *apicollection.DescribeRequest) (*apicollection.DescribeResponse, error) {
+	return servicecollection.Describe(&apicollection.RemoteIntegrityConfig{}, metricId), nil
+}
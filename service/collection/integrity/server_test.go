@@ -29,6 +29,7 @@ import (
 	"math/big"
 	"net"
 	"os"
+	"sync"
 	"testing"
 	"time"
 
@@ -39,12 +40,19 @@ import (
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/eclipse-xfsc/cam/api/collection"
 	"github.com/eclipse-xfsc/cam/api/common"
 	"github.com/eclipse-xfsc/cam/api/evaluation"
+	"github.com/eclipse-xfsc/cam/internal/authz"
+	"github.com/eclipse-xfsc/cam/internal/config"
+	"github.com/eclipse-xfsc/cam/internal/testutil"
 	"github.com/eclipse-xfsc/cam/internal/testutil/testevaluation"
 	"github.com/eclipse-xfsc/cam/internal/testutil/testproto"
+	"github.com/eclipse-xfsc/cam/service"
+	service_evaluation "github.com/eclipse-xfsc/cam/service/evaluation"
 )
 
 // TestCA used to verify the integrity information
@@ -275,3 +283,249 @@ func mockRawConfig() (conf *collection.RemoteIntegrityConfig) {
 	}
 	return
 }
+
+func TestServer_targets(t *testing.T) {
+	type args struct {
+		req       *collection.StartCollectingRequest
+		rawConfig *collection.RemoteIntegrityConfig
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []string
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Single target",
+			args: args{
+				req:       &collection.StartCollectingRequest{},
+				rawConfig: &collection.RemoteIntegrityConfig{Target: "host1:9955"},
+			},
+			want:    []string{"host1:9955"},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Target and list of targets without duplicates",
+			args: args{
+				req: &collection.StartCollectingRequest{},
+				rawConfig: &collection.RemoteIntegrityConfig{
+					Target:  "host1:9955",
+					Targets: []string{"host2:9955", "", "host1:9955", "host3:9955"},
+				},
+			},
+			want:    []string{"host1:9955", "host2:9955", "host3:9955"},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "No targets",
+			args: args{
+				req:       &collection.StartCollectingRequest{},
+				rawConfig: &collection.RemoteIntegrityConfig{},
+			},
+			want:    nil,
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Server{}
+			got, err := s.targets(tt.args.req, tt.args.rawConfig)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestServer_attestTargets(t *testing.T) {
+	type fields struct {
+		maxParallel int
+	}
+	type args struct {
+		targets []string
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   int
+	}{
+		{
+			name:   "Sequential",
+			fields: fields{maxParallel: 1},
+			args:   args{targets: []string{tcpAddr.String(), tcpAddr.String(), tcpAddr.String()}},
+			want:   3,
+		},
+		{
+			name:   "Default parallelism",
+			fields: fields{},
+			args:   args{targets: []string{tcpAddr.String(), tcpAddr.String()}},
+			want:   2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mu        sync.Mutex
+				evidences []*common.Evidence
+			)
+
+			s := &Server{maxParallel: tt.fields.maxParallel}
+			s.attestTargets(testutil.DefaultServiceID, tt.args.targets, &trustStore{capem: signer.certChain.Ca}, func(e *common.Evidence) {
+				mu.Lock()
+				defer mu.Unlock()
+				evidences = append(evidences, e)
+			})
+
+			assert.Equal(t, tt.want, len(evidences))
+			for _, e := range evidences {
+				assert.Nil(t, e.Error)
+				assert.NotNil(t, e.Value)
+				assert.Equal(t, testutil.DefaultServiceID, e.TargetService)
+			}
+		})
+	}
+}
+
+// evidenceLister is an Evaluation Manager, which lists its evidences for discovery
+type evidenceLister struct {
+	evaluation.UnimplementedEvaluationServer
+
+	evidences []*common.Evidence
+}
+
+func (l *evidenceLister) ListEvidences(context.Context, *evaluation.ListEvidencesRequest) (
+	*evaluation.ListEvidencesResponse, error) {
+	return &evaluation.ListEvidencesResponse{Evidences: l.evidences}, nil
+}
+
+func TestServer_discoverTargets(t *testing.T) {
+	vm := func(toolID string, id string, name string) *common.Evidence {
+		return &common.Evidence{ToolId: toolID, Value: testproto.ToValue(t, map[string]interface{}{
+			"id":   id,
+			"name": name,
+			"type": []interface{}{"VirtualMachine", "Compute", "Resource"},
+		})}
+	}
+
+	// Since the attested virtual machines are described by the evidences of this module as well, they are listed
+	// along with the ones of the workload module
+	lister := &evidenceLister{evidences: []*common.Evidence{
+		vm(config.DefaultCollectionWorkloadID, "vm-1", "node1.example.com"),
+		vm(config.DefaultCollectionWorkloadID, "vm-2", "node2.example.com:22"),
+		vm(config.DefaultCollectionWorkloadID, "fd00::1", ""),
+		vm(config.DefaultCollectionWorkloadID, "vm-1", "node1.example.com"),
+		vm(ComponentID, "node1.example.com:9955", ""),
+		vm(ComponentID, "node3.example.com:9955", ""),
+	}}
+
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	evaluation.RegisterEvaluationServer(srv, lister)
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	defer srv.Stop()
+
+	s := NewServer(WithAdditionalGRPCOpts(grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return lis.Dial()
+	}))).(*Server)
+
+	targets, err := s.discoverTargets("bufnet", testutil.DefaultServiceID, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"node1.example.com:9955", "node2.example.com:9955", "[fd00::1]:9955"}, targets)
+}
+
+func TestServer_discoverTargets_tenant(t *testing.T) {
+	storage := testutil.NewInMemoryStorage(t)
+	assert.NoError(t, storage.Create(&common.Evidence{
+		Id:            "00000000-0000-0000-0000-000000000001",
+		TargetService: testutil.DefaultServiceID,
+		TenantId:      "tenant",
+		ToolId:        config.DefaultCollectionWorkloadID,
+		GatheredAt:    timestamppb.Now(),
+		Value: testproto.ToValue(t, map[string]interface{}{
+			"id":   "vm-1",
+			"name": "node1.example.com",
+			"type": []interface{}{"VirtualMachine", "Compute", "Resource"},
+		}),
+	}))
+
+	// The token of the collection module has the collector role for the service, but no tenant, since the collection
+	// module collects the evidences of all tenants
+	claims := &service.Claims{Roles: []string{string(authz.RoleCollector)}, Services: []string{testutil.DefaultServiceID}}
+
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
+			interface{}, error) {
+			return handler(service.ContextWithClaims(ctx, claims), req)
+		},
+		authz.DefaultPolicy.UnaryServerInterceptor,
+	))
+	evaluation.RegisterEvaluationServer(srv, service_evaluation.NewServer(service_evaluation.WithStorage(storage)))
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	defer srv.Stop()
+
+	s := NewServer(WithAdditionalGRPCOpts(grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return lis.Dial()
+	}))).(*Server)
+
+	targets, err := s.discoverTargets("bufnet", testutil.DefaultServiceID, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"node1.example.com:9955"}, targets)
+
+	// Services outside of the claims cannot be discovered
+	_, err = s.discoverTargets("bufnet", "other", 0)
+	assert.ErrorContains(t, err, "PermissionDenied")
+}
+
+func Test_targetAddress(t *testing.T) {
+	assert.Equal(t, "node1.example.com:9955", targetAddress("node1.example.com", 9955))
+	assert.Equal(t, "node1.example.com:9955", targetAddress("node1.example.com:9955", 9955))
+	assert.Equal(t, "10.0.0.1:1234", targetAddress("10.0.0.1:22", 1234))
+	assert.Equal(t, "[fd00::1]:9955", targetAddress("fd00::1", 9955))
+	assert.Equal(t, "[fd00::1]:9955", targetAddress("[fd00::1]:22", 9955))
+	assert.Equal(t, "[fd00::1]:9955", targetAddress("[fd00::1]", 9955))
+}
+
+func Test_vmHost(t *testing.T) {
+	tests := []struct {
+		name string
+		e    *common.Evidence
+		want string
+	}{
+		{
+			name: "Virtual machine with name",
+			e: &common.Evidence{Value: testproto.ToValue(t, map[string]interface{}{
+				"id":   "vm-1",
+				"name": "node1.example.com",
+				"type": []interface{}{"VirtualMachine", "Compute", "Resource"},
+			})},
+			want: "node1.example.com",
+		},
+		{
+			name: "Virtual machine without name",
+			e: &common.Evidence{Value: testproto.ToValue(t, map[string]interface{}{
+				"id":   "10.0.0.1",
+				"type": []interface{}{"VirtualMachine", "Compute", "Resource"},
+			})},
+			want: "10.0.0.1",
+		},
+		{
+			name: "No virtual machine",
+			e: &common.Evidence{Value: testproto.ToValue(t, map[string]interface{}{
+				"id":   "volume-1",
+				"name": "volume",
+				"type": []interface{}{"BlockStorage", "Storage", "Resource"},
+			})},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, vmHost(tt.e))
+		})
+	}
+}
@@ -0,0 +1,239 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package integrity
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	clapi "clouditor.io/clouditor/api"
+	ar "github.com/Fraunhofer-AISEC/cmc/attestationreport"
+	"google.golang.org/grpc"
+
+	"github.com/eclipse-xfsc/cam/api/configuration"
+)
+
+// trustStore is the attestation trust store of the requirements manager, prepared for the verification of
+// attestation reports.
+type trustStore struct {
+	version int64
+
+	// capem contains the PEM-encoded certificates of all trust anchors
+	capem []byte
+
+	// references contains the reference manifests by manifest name
+	references map[string]*configuration.AttestationReferenceManifest
+
+	// revoked contains the subject key IDs of the revoked certificates
+	revoked map[string]bool
+}
+
+func newTrustStore(store *configuration.AttestationTrustStore) (ts *trustStore) {
+	ts = &trustStore{
+		version:    store.Version,
+		references: make(map[string]*configuration.AttestationReferenceManifest),
+		revoked:    make(map[string]bool),
+	}
+
+	var buf bytes.Buffer
+	for _, anchor := range store.TrustAnchors {
+		buf.WriteString(strings.TrimSpace(anchor.Certificate))
+		buf.WriteString("\n")
+	}
+	ts.capem = buf.Bytes()
+
+	for _, m := range store.ReferenceManifests {
+		ts.references[m.Name] = m
+	}
+
+	for _, c := range store.RevokedCertificates {
+		ts.revoked[normalizeKeyID(c.SubjectKeyId)] = true
+	}
+
+	return
+}
+
+// withCertificate returns a copy of the trust store which additionally trusts the given PEM-encoded certificate(s).
+func (ts *trustStore) withCertificate(capem string) *trustStore {
+	if capem == "" {
+		return ts
+	}
+
+	c := *ts
+	c.capem = append(append(append([]byte{}, ts.capem...), []byte(strings.TrimSpace(capem))...), '\n')
+
+	return &c
+}
+
+// trustStore retrieves the attestation trust store from the requirements manager. The trust store is cached and only
+// re-transferred if its version has changed.
+func (s *Server) trustStore() (ts *trustStore, err error) {
+	var (
+		conn  *grpc.ClientConn
+		store *configuration.AttestationTrustStore
+	)
+
+	s.trustStoreMutex.Lock()
+	defer s.trustStoreMutex.Unlock()
+
+	req := &configuration.GetAttestationTrustStoreRequest{}
+	if s.cachedTrustStore != nil {
+		req.KnownVersion = s.cachedTrustStore.version
+	}
+
+	conn, err = grpc.Dial(s.reqManagerAddress, clapi.DefaultGrpcDialOptions(s.reqManagerAddress, s, s.grpcOpts...)...)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to requirements manager: %w", err)
+	}
+	defer conn.Close()
+
+	store, err = configuration.NewConfigurationClient(conn).GetAttestationTrustStore(context.Background(), req)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve attestation trust store: %w", err)
+	}
+
+	if s.cachedTrustStore == nil || s.cachedTrustStore.version != store.Version {
+		log.Debugf("Retrieved attestation trust store version %d", store.Version)
+		s.cachedTrustStore = newTrustStore(store)
+	}
+
+	return s.cachedTrustStore, nil
+}
+
+// check checks the components of an attestation report against the revoked certificates and the reference
+// manifests of the trust store. It returns false, if any component failed the check.
+func (ts *trustStore) check(result *ar.VerificationResult, comps []Component) (ok bool) {
+	ok = true
+
+	for i := range comps {
+		c := &comps[i]
+
+		if sig, revoked := ts.revokedSignature(manifestResult(result, c)); revoked {
+			c.CertChainValid = false
+			c.PolicyResult = false
+			c.Details = append(c.Details, fmt.Sprintf("certificate %s of signer '%s' is revoked", sig.SubjectKeyId,
+				sig.Name))
+		}
+
+		ref, found := ts.references[c.Name]
+		if found && !ts.matchReference(c, ref) {
+			c.PolicyResult = false
+		}
+
+		ok = ok && c.PolicyResult
+	}
+
+	// The attestation report itself must not be signed with a revoked certificate either
+	if sig, revoked := ts.revokedSignature(result.ReportSignature); revoked {
+		log.Debugf("Attestation report is signed with revoked certificate %s", sig.SubjectKeyId)
+		ok = false
+	}
+
+	return
+}
+
+// revokedSignature returns the first signature, which was created with a revoked certificate or a certificate issued
+// by a revoked certificate.
+func (ts *trustStore) revokedSignature(sigs []ar.SignatureResult) (sig ar.SignatureResult, revoked bool) {
+	for _, sig = range sigs {
+		if ts.revoked[normalizeKeyID(sig.SubjectKeyId)] || ts.revoked[normalizeKeyID(sig.AuthorityKeyId)] {
+			return sig, true
+		}
+	}
+
+	return ar.SignatureResult{}, false
+}
+
+// matchReference compares the measurements of the component with the reference values of the reference manifest.
+// Reference values which do not match or have not been measured at all are added to the details of the component.
+func (ts *trustStore) matchReference(c *Component, ref *configuration.AttestationReferenceManifest) (ok bool) {
+	ok = true
+
+	for _, v := range ref.ReferenceValues {
+		var (
+			hash     = v.Sha256
+			measured bool
+		)
+		if hash == "" {
+			hash = v.Sha384
+		}
+
+		for _, m := range c.Measurements {
+			if m.Name != v.Name || (v.Pcr != nil && (m.Pcr == nil || *m.Pcr != int(*v.Pcr))) {
+				continue
+			}
+
+			// The measured value must match the trusted reference, not only the reference of the (device-provided)
+			// manifest
+			measured = true
+			if !m.Success || !strings.EqualFold(m.Measured, hash) {
+				ok = false
+				c.Details = append(c.Details, fmt.Sprintf("measurement '%s' does not match reference value %s "+
+					"of reference manifest version %d", v.Name, hash, ref.Version))
+			}
+		}
+
+		if !measured && kindOf(c, v) {
+			ok = false
+			c.Details = append(c.Details, fmt.Sprintf("reference value '%s' of reference manifest version %d "+
+				"was not measured", v.Name, ref.Version))
+		}
+	}
+
+	return
+}
+
+// kindOf returns whether the reference value belongs to the kind of the component. The OS manifest is split into
+// kernel and OS components, so that the kernel PCRs are only expected in the kernel component and vice versa.
+func kindOf(c *Component, v *configuration.AttestationReferenceValue) bool {
+	isKernel := v.Pcr != nil && kernelPcrs[int(*v.Pcr)]
+
+	switch c.Kind {
+	case ComponentKernel:
+		return isKernel
+	case ComponentOS:
+		return !isKernel
+	default:
+		return true
+	}
+}
+
+// manifestResult returns the signature results of the manifest the component is described by.
+func manifestResult(result *ar.VerificationResult, c *Component) []ar.SignatureResult {
+	switch c.Kind {
+	case ComponentFirmware:
+		return result.RtmResult.SignatureCheck
+	case ComponentKernel, ComponentOS:
+		return result.OsResult.SignatureCheck
+	default:
+		if res := appResult(result, c.Name); res != nil {
+			return res.SignatureCheck
+		}
+		return nil
+	}
+}
+
+// normalizeKeyID normalizes the key ID for the comparison with the revoked certificates. Key IDs, which are not
+// hex-encoded, are compared lower-case.
+func normalizeKeyID(keyID string) string {
+	if normalized, err := configuration.NormalizeKeyID(keyID); err == nil {
+		return normalized
+	}
+
+	return strings.ToLower(keyID)
+}
@@ -0,0 +1,146 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package integrity
+
+import (
+	"testing"
+
+	ar "github.com/Fraunhofer-AISEC/cmc/attestationreport"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eclipse-xfsc/cam/api/configuration"
+)
+
+func Test_trustStore_check(t *testing.T) {
+	var (
+		pcr8      = 8
+		pcr8Ref   = int32(8)
+		signature = ar.SignatureResult{Name: "os-signer", SubjectKeyId: "aabb", AuthorityKeyId: "ccdd"}
+	)
+
+	newComps := func() []Component {
+		return []Component{
+			{
+				Name: "os", Kind: ComponentKernel, CertChainValid: true, PolicyResult: true,
+				Measurements: []Measurement{{Name: "kernel", Pcr: &pcr8, Measured: "bb", Reference: "bb", Success: true}},
+			},
+			{
+				Name: "os", Kind: ComponentOS, CertChainValid: true, PolicyResult: true,
+				Measurements: []Measurement{},
+			},
+		}
+	}
+	result := &ar.VerificationResult{
+		OsResult: ar.ManifestResult{SignatureCheck: []ar.SignatureResult{signature}},
+	}
+
+	type args struct {
+		store *configuration.AttestationTrustStore
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        bool
+		wantDetails []string
+	}{
+		{
+			name:        "Empty trust store",
+			args:        args{store: &configuration.AttestationTrustStore{}},
+			want:        true,
+			wantDetails: nil,
+		},
+		{
+			name: "Matching reference manifest",
+			args: args{store: &configuration.AttestationTrustStore{
+				ReferenceManifests: []*configuration.AttestationReferenceManifest{{
+					Name:            "os",
+					ReferenceValues: []*configuration.AttestationReferenceValue{{Name: "kernel", Pcr: &pcr8Ref, Sha256: "BB"}},
+				}},
+			}},
+			want:        true,
+			wantDetails: nil,
+		},
+		{
+			name: "Reference value does not match",
+			args: args{store: &configuration.AttestationTrustStore{
+				ReferenceManifests: []*configuration.AttestationReferenceManifest{{
+					Name:            "os",
+					Version:         2,
+					ReferenceValues: []*configuration.AttestationReferenceValue{{Name: "kernel", Pcr: &pcr8Ref, Sha256: "aa"}},
+				}},
+			}},
+			want:        false,
+			wantDetails: []string{"measurement 'kernel' does not match reference value aa of reference manifest version 2"},
+		},
+		{
+			name: "Reference value not measured",
+			args: args{store: &configuration.AttestationTrustStore{
+				ReferenceManifests: []*configuration.AttestationReferenceManifest{{
+					Name:            "os",
+					Version:         2,
+					ReferenceValues: []*configuration.AttestationReferenceValue{{Name: "rootfs", Sha256: "cc"}},
+				}},
+			}},
+			want:        false,
+			wantDetails: []string{"reference value 'rootfs' of reference manifest version 2 was not measured"},
+		},
+		{
+			name: "Issuer of manifest signer is revoked",
+			args: args{store: &configuration.AttestationTrustStore{
+				RevokedCertificates: []*configuration.RevokedCertificate{{SubjectKeyId: "CCDD"}},
+			}},
+			want: false,
+			// Kernel and OS are both described by the OS manifest
+			wantDetails: []string{
+				"certificate aabb of signer 'os-signer' is revoked",
+				"certificate aabb of signer 'os-signer' is revoked",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comps := newComps()
+
+			got := newTrustStore(tt.args.store).check(result, comps)
+			assert.Equal(t, tt.want, got)
+
+			var details []string
+			for _, c := range comps {
+				details = append(details, c.Details...)
+			}
+			assert.Equal(t, tt.wantDetails, details)
+		})
+	}
+}
+
+func Test_trustStore_withCertificate(t *testing.T) {
+	ts := newTrustStore(&configuration.AttestationTrustStore{
+		Version: 1,
+		TrustAnchors: []*configuration.AttestationTrustAnchor{
+			{Certificate: "anchor1\n"},
+			{Certificate: "anchor2"},
+		},
+	})
+	assert.Equal(t, "anchor1\nanchor2\n", string(ts.capem))
+
+	// The cached trust store must not be modified
+	got := ts.withCertificate("legacy")
+	assert.Equal(t, "anchor1\nanchor2\nlegacy\n", string(got.capem))
+	assert.Equal(t, "anchor1\nanchor2\n", string(ts.capem))
+	assert.Equal(t, int64(1), got.version)
+
+	assert.Same(t, ts, ts.withCertificate(""))
+}
@@ -23,6 +23,14 @@ import (
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
+// Component kinds of an attested device
+const (
+	ComponentFirmware    = "firmware"
+	ComponentKernel      = "kernel"
+	ComponentOS          = "os"
+	ComponentApplication = "application"
+)
+
 type Value struct {
 	voc.Resource
 
@@ -30,7 +38,39 @@ type Value struct {
 }
 
 type SystemComponentsIntegrity struct {
+	// Status is true if all components of the device could be verified successfully
 	Status bool `json:"status"`
+
+	// Components contains the verification result of each individual component (firmware, kernel, OS, apps)
+	Components []Component `json:"components"`
+}
+
+// Component is the verification result of a single manifest-described component of the attested device.
+type Component struct {
+	Name    string `json:"name"`
+	Kind    string `json:"kind"`
+	Version string `json:"version,omitempty"`
+
+	// Measurements compares the measured values with the reference values of the manifest
+	Measurements []Measurement `json:"measurements"`
+
+	// CertChainValid is true if the certificate chains of all manifest signatures could be validated
+	CertChainValid bool `json:"certChainValid"`
+
+	// PolicyResult is the overall result of the component, i.e. whether measurements, signatures and
+	// validity period of the manifest are all fine
+	PolicyResult bool `json:"policyResult"`
+
+	Details []string `json:"details,omitempty"`
+}
+
+// Measurement compares a single measured value with the expected reference value.
+type Measurement struct {
+	Name      string `json:"name,omitempty"`
+	Pcr       *int   `json:"pcr,omitempty"`
+	Measured  string `json:"measured"`
+	Reference string `json:"reference"`
+	Success   bool   `json:"success"`
 }
 
 func toStruct(v Value) (s *structpb.Value, err error) {
@@ -48,21 +88,3 @@ func toStruct(v Value) (s *structpb.Value, err error) {
 	}
 	return
 }
-
-/*
-func toStruct(value *structpb.Value) (conf *collection.IntegrityConfig, err error) {
-	strct, err := testutil.ToStruct(value)
-	if err != nil {
-		return
-	}
-
-	var ok bool
-	conf, ok = strct.(*collection.IntegrityConfig)
-	if !ok {
-		err = errors.New("")
-		return
-	}
-	return
-}
-
-*/
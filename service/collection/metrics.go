@@ -0,0 +1,94 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package collection
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/eclipse-xfsc/cam/internal/metrics"
+	"github.com/eclipse-xfsc/cam/internal/tracing"
+)
+
+var (
+	evidencesSent = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cam_collection_evidences_sent_total",
+		Help: "Number of evidences sent to the Evaluation Manager",
+	}, []string{"tool"})
+	evidencesNotSigned = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cam_collection_evidences_not_signed_total",
+		Help: "Number of evidences, which could not be signed and, thus, are not sent",
+	}, []string{"tool"})
+	collections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cam_collections_total",
+		Help: "Number of collections of evidences",
+	}, []string{"tool"})
+	collectionDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "cam_collection_duration_seconds",
+		Help:    "Duration of a collection of evidences",
+		Buckets: metrics.SlowBuckets,
+	}, []string{"tool"})
+	collectionLastFinished = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "cam_collection_last_finished_timestamp_seconds",
+		Help: "Time, at which the last collection of evidences finished",
+	}, []string{"tool"})
+	discovererDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "cam_collection_discoverer_duration_seconds",
+		Help:    "Duration of the discovery of resources by a discoverer",
+		Buckets: metrics.SlowBuckets,
+	}, []string{"tool", "discoverer"})
+	discovererFailed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cam_collection_discoverer_failed_total",
+		Help: "Number of discoveries of resources, which failed",
+	}, []string{"tool", "discoverer"})
+)
+
+// runKey is the context key of the ID of the collection run
+type runKey struct{}
+
+// TrackCollection records the start of a collection of evidences by the collection module toolID and starts its span
+// as a child of the span in ctx, e.g., the one of the StartCollecting call of the Requirements Manager. The returned
+// context carries the span and the run ID of the collection and outlives the call, so that evidences sent later on are
+// part of the trace and of the run. The returned function records the end of the collection and is meant to be
+// deferred by the goroutine collecting the evidences.
+func TrackCollection(ctx context.Context, toolID string, runID string) (context.Context, func()) {
+	start := time.Now()
+	collections.WithLabelValues(toolID).Inc()
+
+	ctx, span := tracing.Start(tracing.Detach(ctx), "collection.Collect",
+		trace.WithAttributes(attribute.String("collection.tool", toolID)))
+	ctx = context.WithValue(ctx, runKey{}, runID)
+
+	return ctx, func() {
+		collectionDuration.WithLabelValues(toolID).Observe(time.Since(start).Seconds())
+		collectionLastFinished.WithLabelValues(toolID).SetToCurrentTime()
+		span.End()
+	}
+}
+
+// ObserveDiscoverer records the duration since start and the error, if any, of the discovery of resources by the
+// discoverer of the collection module toolID
+func ObserveDiscoverer(toolID string, discoverer string, start time.Time, err error) {
+	discovererDuration.WithLabelValues(toolID, discoverer).Observe(time.Since(start).Seconds())
+	if err != nil {
+		discovererFailed.WithLabelValues(toolID, discoverer).Inc()
+	}
+}
@@ -0,0 +1,69 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package collection
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/eclipse-xfsc/cam/internal/testutil"
+	"github.com/eclipse-xfsc/cam/internal/tracing"
+)
+
+func TestTrackCollection(t *testing.T) {
+	recorder := testutil.RecordSpans(t)
+
+	rpc, cancel := context.WithCancel(context.Background())
+	rpc, parent := tracing.Start(rpc, "collection.StartCollecting")
+
+	ctx, done := TrackCollection(rpc, "test-tool", "run-1")
+	assert.Equal(t, float64(1), promtestutil.ToFloat64(collections.WithLabelValues("test-tool")))
+	assert.Zero(t, promtestutil.ToFloat64(collectionLastFinished.WithLabelValues("test-tool")))
+
+	// The collection outlives the call, which started it, and its evidences are counted for the run
+	cancel()
+	assert.NoError(t, ctx.Err())
+	assert.Equal(t, "run-1", ctx.Value(runKey{}))
+
+	done()
+	assert.NotZero(t, promtestutil.ToFloat64(collectionLastFinished.WithLabelValues("test-tool")))
+
+	spans := testutil.FindSpans(recorder, "collection.Collect")
+	assert.Len(t, spans, 1)
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	assert.Equal(t, "test-tool", testutil.SpanAttribute(spans[0], "collection.tool").AsString())
+	assert.Equal(t, spans[0].SpanContext(), trace.SpanContextFromContext(ctx))
+
+	count, _ := testutil.Observations(t, collectionDuration.WithLabelValues("test-tool"))
+	assert.Equal(t, uint64(1), count)
+}
+
+func TestObserveDiscoverer(t *testing.T) {
+	ObserveDiscoverer("test-tool", "k8s", time.Now().Add(-time.Second), nil)
+	ObserveDiscoverer("test-tool", "k8s", time.Now(), errors.New("unavailable"))
+
+	assert.Equal(t, float64(1), promtestutil.ToFloat64(discovererFailed.WithLabelValues("test-tool", "k8s")))
+
+	count, sum := testutil.Observations(t, discovererDuration.WithLabelValues("test-tool", "k8s"))
+	assert.Equal(t, uint64(2), count)
+	assert.GreaterOrEqual(t, sum, float64(1))
+}
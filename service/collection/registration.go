@@ -0,0 +1,155 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package collection
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"clouditor.io/clouditor/api"
+	"clouditor.io/clouditor/api/assessment"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/eclipse-xfsc/cam/api/collection"
+	"github.com/eclipse-xfsc/cam/api/configuration"
+)
+
+// DefaultRegistrationRetryInterval is the interval in which a failed registration or lease renewal is retried
+var DefaultRegistrationRetryInterval = 10 * time.Second
+
+// Registration contains the information, which a collection module uses to register itself with the Requirements
+// Manager
+type Registration struct {
+	// ID identifies the collection module. All instances of a collection module use the same ID.
+	ID string
+
+	Name string
+
+	// Address is the address under which the collection module is reachable by the Requirements Manager
+	Address string
+
+	// RequirementsManager is the address of the Requirements Manager
+	RequirementsManager string
+}
+
+// Register registers the collection module srv with the Requirements Manager and renews its lease until ctx is done.
+// The metrics and configuration type of the collection module are retrieved using its Describe RPC. Failed
+// registrations and renewals are retried, so the Requirements Manager does not need to be available on startup.
+// Register blocks and should therefore be run in a separate goroutine.
+func Register(ctx context.Context, reg *Registration, srv collection.CollectionServer, opts ...grpc.DialOption) (
+	err error) {
+	var (
+		conn  *grpc.ClientConn
+		desc  *collection.DescribeResponse
+		lease *configuration.CollectionModuleLease
+		log   = logrus.WithField("service", "collection-registration")
+	)
+
+	desc, err = srv.Describe(ctx, &collection.DescribeRequest{})
+	if err != nil {
+		return fmt.Errorf("could not describe collection module: %w", err)
+	}
+
+	module := &collection.CollectionModule{
+		Id:                   reg.ID,
+		Name:                 reg.Name,
+		Address:              reg.Address,
+		ConfigMessageTypeUrl: desc.ConfigMessageTypeUrl,
+	}
+	for _, id := range desc.MetricIds {
+		module.Metrics = append(module.Metrics, &assessment.Metric{Id: id})
+	}
+
+	// The collection module's authorizer is also used for the Requirements Manager
+	auth, ok := srv.(api.UsesAuthorizer)
+	if !ok {
+		auth = noAuthorizer{}
+	}
+
+	conn, err = grpc.Dial(reg.RequirementsManager,
+		api.DefaultGrpcDialOptions(reg.RequirementsManager, auth, opts...)...)
+	if err != nil {
+		return fmt.Errorf("could not dial requirements manager: %w", err)
+	}
+	defer conn.Close()
+
+	client := configuration.NewConfigurationClient(conn)
+
+	for {
+		wait := DefaultRegistrationRetryInterval
+
+		if lease == nil {
+			lease, err = client.RegisterCollectionModule(ctx,
+				&configuration.RegisterCollectionModuleRequest{Module: module})
+			if err != nil {
+				log.Warnf("Could not register collection module `%s` with %s: %v", reg.Name,
+					reg.RequirementsManager, err)
+			} else {
+				log.Infof("Registered collection module `%s` (address: %s) with %s", reg.Name, reg.Address,
+					reg.RequirementsManager)
+			}
+		} else {
+			var renewed *configuration.CollectionModuleLease
+
+			renewed, err = client.RenewCollectionModuleLease(ctx,
+				&configuration.RenewCollectionModuleLeaseRequest{ModuleId: module.Id, Address: module.Address})
+			if status.Code(err) == codes.NotFound {
+				// The Requirements Manager has lost our lease, e.g., because of a restart. Register again right away.
+				log.Infof("Lease of collection module `%s` not found, registering again", reg.Name)
+				lease = nil
+				continue
+			} else if err != nil {
+				log.Warnf("Could not renew lease of collection module `%s`: %v", reg.Name, err)
+			} else {
+				lease = renewed
+			}
+		}
+
+		// Renew the lease after a third of its duration, so that a single failed renewal does not let it expire
+		if err == nil && lease.Ttl > 0 {
+			wait = time.Duration(lease.Ttl) * time.Second / 3
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(wait):
+		}
+	}
+}
+
+// DefaultAddress returns the address, under which a collection module listening on port is reachable by default, i.e.,
+// the host name of the machine (or container) and the port
+func DefaultAddress(port int) string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "localhost"
+	}
+
+	return fmt.Sprintf("%s:%d", host, port)
+}
+
+// noAuthorizer is used for collection modules, which do not use an authorizer
+type noAuthorizer struct{}
+
+func (noAuthorizer) Authorizer() api.Authorizer { return nil }
+
+func (noAuthorizer) SetAuthorizer(_ api.Authorizer) {}
@@ -0,0 +1,137 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package collection
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eclipse-xfsc/cam/api/collection"
+	"github.com/eclipse-xfsc/cam/api/configuration"
+	"github.com/eclipse-xfsc/cam/internal/protobuf"
+)
+
+// mockRequirementsManager records registrations and renewals. The first renewal fails with NotFound, as if the
+// Requirements Manager had been restarted.
+type mockRequirementsManager struct {
+	configuration.UnimplementedConfigurationServer
+
+	mutex     sync.Mutex
+	modules   []*collection.CollectionModule
+	renewals  int
+	done      chan struct{}
+	doneAfter int
+}
+
+func (m *mockRequirementsManager) RegisterCollectionModule(_ context.Context,
+	req *configuration.RegisterCollectionModuleRequest) (*configuration.CollectionModuleLease, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.modules = append(m.modules, req.Module)
+	m.check()
+
+	return &configuration.CollectionModuleLease{ModuleId: req.Module.Id, ExpiresAt: timestamppb.Now(), Ttl: 0}, nil
+}
+
+func (m *mockRequirementsManager) RenewCollectionModuleLease(_ context.Context,
+	req *configuration.RenewCollectionModuleLeaseRequest) (*configuration.CollectionModuleLease, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.renewals++
+	defer m.check()
+	if m.renewals == 1 {
+		return nil, status.Error(codes.NotFound, "no lease")
+	}
+
+	return &configuration.CollectionModuleLease{ModuleId: req.ModuleId, ExpiresAt: timestamppb.Now(), Ttl: 0}, nil
+}
+
+func (m *mockRequirementsManager) check() {
+	if len(m.modules)+m.renewals == m.doneAfter {
+		close(m.done)
+	}
+}
+
+// mockCollection only implements Describe
+type mockCollection struct {
+	collection.UnimplementedCollectionServer
+}
+
+func (mockCollection) Describe(_ context.Context, _ *collection.DescribeRequest) (*collection.DescribeResponse,
+	error) {
+	return Describe(&collection.WorkloadSecurityConfig{}, "MetricA", "MetricB"), nil
+}
+
+func TestRegister(t *testing.T) {
+	defer func(d time.Duration) { DefaultRegistrationRetryInterval = d }(DefaultRegistrationRetryInterval)
+	DefaultRegistrationRetryInterval = 10 * time.Millisecond
+
+	rm := &mockRequirementsManager{done: make(chan struct{}), doneAfter: 4}
+
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	configuration.RegisterConfigurationServer(srv, rm)
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	defer srv.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errc := make(chan error)
+	go func() {
+		errc <- Register(ctx, &Registration{
+			ID:                  "CM1",
+			Name:                "Mock",
+			Address:             "cm:1",
+			RequirementsManager: "bufnet",
+		}, mockCollection{},
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return lis.DialContext(ctx)
+			}))
+	}()
+
+	select {
+	case <-rm.done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("collection module did not register and renew its lease in time")
+	}
+	cancel()
+	assert.NoError(t, <-errc)
+
+	rm.mutex.Lock()
+	defer rm.mutex.Unlock()
+
+	// Register, renew (not found), register again, renew
+	assert.Len(t, rm.modules, 2)
+	assert.Equal(t, 2, rm.renewals)
+	assert.Equal(t, "cm:1", rm.modules[0].Address)
+	assert.Equal(t, protobuf.TypeURL(&collection.WorkloadSecurityConfig{}), rm.modules[0].ConfigMessageTypeUrl)
+	assert.Len(t, rm.modules[0].Metrics, 2)
+}
@@ -0,0 +1,263 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package collection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/eclipse-xfsc/cam/api/collection"
+	"github.com/eclipse-xfsc/cam/api/common"
+)
+
+// auditLog records which references to external secrets have been resolved. The values of the secrets are never
+// logged.
+var auditLog = logrus.WithField("audit", "secret-refs")
+
+// KubernetesSecretFunc returns the data of the Kubernetes Secret name in namespace
+type KubernetesSecretFunc func(ctx context.Context, namespace string, name string) (map[string][]byte, error)
+
+// SecretResolver resolves references to external secrets in service configurations. Since the references are
+// configured via the API, only the files, environment variables and Kubernetes namespaces allowed by the operator of
+// the collection module can be referenced. By default, no references are allowed.
+type SecretResolver struct {
+	// AllowedFilePrefixes are the directories, whose files can be referenced, e.g., /run/secrets
+	AllowedFilePrefixes []string
+
+	// AllowedEnvPrefix is the prefix of the environment variables, which can be referenced, e.g., CAM_SECRET_
+	AllowedEnvPrefix string
+
+	// AllowedNamespaces are the Kubernetes namespaces, whose Secrets can be referenced
+	AllowedNamespaces []string
+
+	// KubernetesSecret retrieves Kubernetes Secrets. If it is nil, the Secrets are retrieved from the cluster, in
+	// which the collection module is running.
+	KubernetesSecret KubernetesSecretFunc
+
+	// LookupEnv retrieves environment variables. If it is nil, os.LookupEnv is used.
+	LookupEnv func(key string) (string, bool)
+}
+
+// DefaultSecretResolver is used by the collection modules to resolve references to external secrets
+var DefaultSecretResolver = &SecretResolver{}
+
+// ResolveSecretRefs resolves the references to external secrets of the configuration message in place using the
+// DefaultSecretResolver. It returns the names of the resolved fields.
+func ResolveSecretRefs(ctx context.Context, serviceID string, msg proto.Message) (resolved []string, err error) {
+	return DefaultSecretResolver.Resolve(ctx, serviceID, msg)
+}
+
+// Resolve resolves the references to external secrets of the configuration message in place. It returns the names of
+// the resolved fields. If a reference cannot be resolved, an error wrapping collection.ErrSecretRefNotFound or
+// collection.ErrInvalidSecretRef is returned.
+func (r *SecretResolver) Resolve(ctx context.Context, serviceID string, msg proto.Message) (resolved []string,
+	err error) {
+	holder, ok := msg.(collection.SecretRefHolder)
+	if !ok {
+		return nil, nil
+	}
+
+	for name, value := range holder.SecretRefFields() {
+		if !collection.IsSecretRef(*value) {
+			continue
+		}
+
+		ref, err := collection.ParseSecretRef(*value)
+		if err != nil {
+			return resolved, fmt.Errorf("%s: %w", name, err)
+		}
+
+		secret, err := r.resolve(ctx, ref)
+		if err != nil {
+			auditLog.WithFields(logrus.Fields{"service": serviceID, "field": name, "ref": ref.String()}).
+				Warnf("Could not resolve secret reference: %v", err)
+			return resolved, fmt.Errorf("%s: %w", name, err)
+		}
+
+		auditLog.WithFields(logrus.Fields{"service": serviceID, "field": name, "ref": ref.String()}).
+			Info("Resolved secret reference")
+
+		*value = secret
+		resolved = append(resolved, name)
+	}
+
+	return resolved, nil
+}
+
+// resolve retrieves the value of a single reference, if it is allowed
+func (r *SecretResolver) resolve(ctx context.Context, ref *collection.SecretRef) (value string, err error) {
+	if err = r.allowed(ref); err != nil {
+		return "", err
+	}
+
+	switch ref.Kind {
+	case collection.SecretRefFile:
+		// A symbolic link must not point outside of the allowed directories
+		path, err := filepath.EvalSymlinks(ref.Path)
+		if errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("%w: file %s does not exist", collection.ErrSecretRefNotFound, ref.Path)
+		} else if err != nil {
+			return "", fmt.Errorf("could not read file %s: %w", ref.Path, err)
+		} else if !hasPathPrefix(path, r.AllowedFilePrefixes) {
+			return "", fmt.Errorf("%w: file %s is linked to %s, which is not allowed", collection.ErrInvalidSecretRef,
+				ref.Path, path)
+		}
+
+		b, err := os.ReadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("%w: file %s does not exist", collection.ErrSecretRefNotFound, ref.Path)
+		} else if err != nil {
+			return "", fmt.Errorf("could not read file %s: %w", ref.Path, err)
+		}
+
+		// Files usually end with a newline, which is not part of the secret
+		return strings.TrimRight(string(b), "\r\n"), nil
+	case collection.SecretRefEnv:
+		lookupEnv := r.LookupEnv
+		if lookupEnv == nil {
+			lookupEnv = os.LookupEnv
+		}
+
+		value, ok := lookupEnv(ref.Path)
+		if !ok {
+			return "", fmt.Errorf("%w: environment variable %s is not set", collection.ErrSecretRefNotFound,
+				ref.Path)
+		}
+
+		return value, nil
+	case collection.SecretRefKubernetes:
+		kubernetesSecret := r.KubernetesSecret
+		if kubernetesSecret == nil {
+			kubernetesSecret = inClusterSecret
+		}
+
+		data, err := kubernetesSecret(ctx, ref.Namespace, ref.Name)
+		if err != nil {
+			return "", err
+		}
+
+		b, ok := data[ref.Key]
+		if !ok {
+			return "", fmt.Errorf("%w: key %s is missing in secret %s/%s", collection.ErrSecretRefNotFound, ref.Key,
+				ref.Namespace, ref.Name)
+		}
+
+		return string(b), nil
+	default:
+		return "", fmt.Errorf("%w: unknown kind %s", collection.ErrInvalidSecretRef, ref.Kind)
+	}
+}
+
+// allowed checks that the reference is allowed by the operator of the collection module
+func (r *SecretResolver) allowed(ref *collection.SecretRef) error {
+	var ok bool
+
+	switch ref.Kind {
+	case collection.SecretRefFile:
+		ok = hasPathPrefix(ref.Path, r.AllowedFilePrefixes)
+	case collection.SecretRefEnv:
+		ok = r.AllowedEnvPrefix != "" && strings.HasPrefix(ref.Path, r.AllowedEnvPrefix)
+	case collection.SecretRefKubernetes:
+		for _, namespace := range r.AllowedNamespaces {
+			ok = ok || ref.Namespace == namespace
+		}
+	}
+
+	if !ok {
+		return fmt.Errorf("%w: %s is not allowed by the collection module", collection.ErrInvalidSecretRef,
+			ref.String())
+	}
+
+	return nil
+}
+
+// hasPathPrefix returns whether path is one of the directories or contained in it
+func hasPathPrefix(path string, dirs []string) bool {
+	for _, dir := range dirs {
+		dir = filepath.Clean(dir)
+		if path == dir || strings.HasPrefix(path, strings.TrimSuffix(dir, string(filepath.Separator))+
+			string(filepath.Separator)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// inClusterSecret retrieves a Kubernetes Secret using the service account of the collection module
+func inClusterSecret(ctx context.Context, namespace string, name string) (map[string][]byte, error) {
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, fmt.Errorf("could not access kubernetes cluster: %w", err)
+	}
+
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", collection.ErrKubernetesClientset, err)
+	}
+
+	secret, err := client.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: secret %s/%s does not exist", collection.ErrSecretRefNotFound, namespace, name)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not retrieve secret %s/%s: %w", namespace, name, err)
+	}
+
+	return secret.Data, nil
+}
+
+// InvalidConfigurationEvidence creates the evidence, which reports that the collection module could not collect any
+// evidences of the service, because its configuration is invalid, e.g., a referenced secret is missing
+func InvalidConfigurationEvidence(toolID string, serviceID string, resource string, err error) *common.Evidence {
+	id := uuid.NewString()
+
+	return &common.Evidence{
+		Id:             id,
+		Name:           id,
+		TargetService:  serviceID,
+		TargetResource: resource,
+		ToolId:         toolID,
+		GatheredAt:     timestamppb.Now(),
+		Error: &common.Error{
+			Code:        common.Error_ERROR_INVALID_CONFIGURATION,
+			Description: err.Error(),
+		},
+	}
+}
+
+// ResolvedChecks returns the checks of a TestCollection response for the resolved fields
+func ResolvedChecks(resolved []string) (checks []string) {
+	sort.Strings(resolved)
+	for _, name := range resolved {
+		checks = append(checks, fmt.Sprintf("resolved secret reference of %s", name))
+	}
+
+	return
+}
@@ -0,0 +1,198 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package collection
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/eclipse-xfsc/cam/api/collection"
+	"github.com/eclipse-xfsc/cam/api/common"
+	"github.com/eclipse-xfsc/cam/internal/testutil"
+)
+
+func TestSecretResolver_Resolve(t *testing.T) {
+	dir, err := filepath.EvalSymlinks(t.TempDir())
+	assert.NoError(t, err)
+	file := filepath.Join(dir, "secrets", "client-secret")
+	assert.NoError(t, os.Mkdir(filepath.Dir(file), 0700))
+	assert.NoError(t, os.WriteFile(file, []byte("file-secret\n"), 0600))
+
+	// Files outside of the allowed directory cannot be referenced, neither directly nor via a link
+	other := filepath.Join(dir, "other-secret")
+	assert.NoError(t, os.WriteFile(other, []byte("other-secret"), 0600))
+	assert.NoError(t, os.Symlink(other, filepath.Join(dir, "secrets", "link")))
+
+	r := &SecretResolver{
+		AllowedFilePrefixes: []string{filepath.Dir(file)},
+		AllowedEnvPrefix:    "CLIENT_",
+		AllowedNamespaces:   []string{"cam"},
+		LookupEnv: func(key string) (string, bool) {
+			if key == "CLIENT_SECRET" || key == "OTHER_SECRET" {
+				return "env-secret", true
+			}
+			return "", false
+		},
+		KubernetesSecret: func(_ context.Context, namespace string, name string) (map[string][]byte, error) {
+			if (namespace == "cam" || namespace == "other") && name == "aws" {
+				return map[string][]byte{"secretAccessKey": []byte("k8s-secret")}, nil
+			}
+			return nil, errors.New("secret does not exist")
+		},
+	}
+
+	tests := []struct {
+		name         string
+		msg          proto.Message
+		want         proto.Message
+		wantResolved []string
+		wantErr      error
+	}{
+		{
+			name:         "File",
+			msg:          &collection.AuthenticationSecurityConfig{ClientSecret: "secretref:file:" + file},
+			want:         &collection.AuthenticationSecurityConfig{ClientSecret: "file-secret"},
+			wantResolved: []string{"client_secret"},
+		},
+		{
+			name:         "Environment variable",
+			msg:          &collection.AuthenticationSecurityConfig{ClientSecret: "secretref:env:CLIENT_SECRET"},
+			want:         &collection.AuthenticationSecurityConfig{ClientSecret: "env-secret"},
+			wantResolved: []string{"client_secret"},
+		},
+		{
+			name: "Kubernetes Secret",
+			msg: &collection.WorkloadSecurityConfig{Aws: structpb.NewStructValue(&structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					"secretAccessKey": structpb.NewStringValue("secretref:k8s:cam/aws/secretAccessKey"),
+				},
+			})},
+			want: &collection.WorkloadSecurityConfig{Aws: structpb.NewStructValue(&structpb.Struct{
+				Fields: map[string]*structpb.Value{"secretAccessKey": structpb.NewStringValue("k8s-secret")},
+			})},
+			wantResolved: []string{"aws.secretAccessKey"},
+		},
+		{
+			name: "No reference",
+			msg:  &collection.RemoteIntegrityConfig{Certificate: "certificate"},
+			want: &collection.RemoteIntegrityConfig{Certificate: "certificate"},
+		},
+		{
+			name:    "Missing file",
+			msg:     &collection.RemoteIntegrityConfig{Certificate: "secretref:file:" + file + ".missing"},
+			wantErr: collection.ErrSecretRefNotFound,
+		},
+		{
+			name:    "Missing environment variable",
+			msg:     &collection.AuthenticationSecurityConfig{ClientSecret: "secretref:env:CLIENT_MISSING"},
+			wantErr: collection.ErrSecretRefNotFound,
+		},
+		{
+			name: "Missing key of Kubernetes Secret",
+			msg: &collection.WorkloadSecurityConfig{
+				Kubernetes: structpb.NewStringValue("secretref:k8s:cam/aws/kubeconfig"),
+			},
+			wantErr: collection.ErrSecretRefNotFound,
+		},
+		{
+			name:    "Invalid reference",
+			msg:     &collection.AuthenticationSecurityConfig{ClientSecret: "secretref:vault:secret"},
+			wantErr: collection.ErrInvalidSecretRef,
+		},
+		{
+			name:    "File outside of the allowed directory",
+			msg:     &collection.AuthenticationSecurityConfig{ClientSecret: "secretref:file:" + other},
+			wantErr: collection.ErrInvalidSecretRef,
+		},
+		{
+			name: "File in a directory with the allowed directory as prefix",
+			msg: &collection.AuthenticationSecurityConfig{
+				ClientSecret: "secretref:file:" + filepath.Dir(file) + "-other/client-secret",
+			},
+			wantErr: collection.ErrInvalidSecretRef,
+		},
+		{
+			name: "Link to a file outside of the allowed directory",
+			msg: &collection.AuthenticationSecurityConfig{
+				ClientSecret: "secretref:file:" + filepath.Join(dir, "secrets", "link"),
+			},
+			wantErr: collection.ErrInvalidSecretRef,
+		},
+		{
+			name:    "Environment variable without the allowed prefix",
+			msg:     &collection.AuthenticationSecurityConfig{ClientSecret: "secretref:env:OTHER_SECRET"},
+			wantErr: collection.ErrInvalidSecretRef,
+		},
+		{
+			name: "Kubernetes Secret of another namespace",
+			msg: &collection.WorkloadSecurityConfig{
+				Kubernetes: structpb.NewStringValue("secretref:k8s:other/aws/secretAccessKey"),
+			},
+			wantErr: collection.ErrInvalidSecretRef,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolved, err := r.Resolve(context.Background(), testutil.DefaultServiceID, tt.msg)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantResolved, resolved)
+			assert.True(t, proto.Equal(tt.want, tt.msg))
+		})
+	}
+}
+
+func TestSecretResolver_Resolve_notAllowed(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "client-secret")
+	assert.NoError(t, os.WriteFile(file, []byte("file-secret"), 0600))
+
+	// By default, no references are allowed
+	r := &SecretResolver{
+		LookupEnv: func(key string) (string, bool) { return "env-secret", true },
+		KubernetesSecret: func(_ context.Context, namespace string, name string) (map[string][]byte, error) {
+			return map[string][]byte{"key": []byte("k8s-secret")}, nil
+		},
+	}
+
+	for _, ref := range []string{"secretref:file:" + file, "secretref:env:CLIENT_SECRET", "secretref:k8s:cam/aws/key"} {
+		msg := &collection.AuthenticationSecurityConfig{ClientSecret: ref}
+		_, err := r.Resolve(context.Background(), testutil.DefaultServiceID, msg)
+		assert.ErrorIs(t, err, collection.ErrInvalidSecretRef, ref)
+		assert.Equal(t, ref, msg.ClientSecret)
+	}
+}
+
+func TestInvalidConfigurationEvidence(t *testing.T) {
+	evidence := InvalidConfigurationEvidence("tool", testutil.DefaultServiceID, "resource",
+		collection.ErrSecretRefNotFound)
+
+	assert.Equal(t, testutil.DefaultServiceID, evidence.TargetService)
+	assert.Equal(t, common.Error_ERROR_INVALID_CONFIGURATION, evidence.Error.Code)
+	assert.Equal(t, collection.ErrSecretRefNotFound.Error(), evidence.Error.Description)
+	assert.Equal(t, []string{"resolved secret reference of a", "resolved secret reference of b"},
+		ResolvedChecks([]string{"b", "a"}))
+}
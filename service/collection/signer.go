@@ -0,0 +1,111 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package collection
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+
+	"clouditor.io/clouditor/api"
+	"gopkg.in/square/go-jose.v2"
+
+	"github.com/eclipse-xfsc/cam/api/common"
+	"github.com/eclipse-xfsc/cam/api/evaluation"
+	"github.com/eclipse-xfsc/cam/internal/signing"
+	"github.com/eclipse-xfsc/cam/internal/tracing"
+)
+
+// Signer signs the evidences of a collection module with its private key, so that the Evaluation Manager can verify
+// their origin and integrity. The signature is a JWS with detached payload (RFC 7515, Appendix F). A nil Signer leaves
+// evidences unsigned.
+type Signer struct {
+	signer jose.Signer
+}
+
+// NewSigner creates a new Signer with the private key. The key ID is included in the signature, e.g., to distinguish
+// the keys of a collection module during a key rotation.
+func NewSigner(key crypto.PrivateKey, keyID string) (s *Signer, err error) {
+	alg, err := signing.Algorithm(key)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: alg, Key: jose.JSONWebKey{Key: key, KeyID: keyID}}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not create signer: %w", err)
+	}
+
+	return &Signer{signer: signer}, nil
+}
+
+// LoadSigner creates a new Signer with the PEM encoded private key in the file at path
+func LoadSigner(path string, keyID string) (s *Signer, err error) {
+	key, err := signing.LoadPrivateKey(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSigner(key, keyID)
+}
+
+// Sign sets the signature of the evidence. The evidence must not be modified afterwards.
+func (s *Signer) Sign(evidence *common.Evidence) (err error) {
+	if s == nil {
+		return nil
+	}
+
+	payload, err := evidence.SigningPayload()
+	if err != nil {
+		return fmt.Errorf("could not sign evidence: %w", err)
+	}
+
+	jws, err := s.signer.Sign(payload)
+	if err != nil {
+		return fmt.Errorf("could not sign evidence: %w", err)
+	}
+
+	evidence.Signature, err = jws.DetachedCompactSerialize()
+	if err != nil {
+		return fmt.Errorf("could not sign evidence: %w", err)
+	}
+
+	return nil
+}
+
+// SignAndSend signs the evidence with the signer, if any, and sends it into the stream to the Evaluation Manager.
+// Evidences, which cannot be signed, are not sent, since the Evaluation Manager would reject them anyway. The evidence
+// carries the trace context and the run ID of ctx (see TrackCollection), so that the Evaluation Manager continues the
+// trace of the collection and counts the evidence for its run.
+func SignAndSend(ctx context.Context, stream *api.StreamChannelOf[evaluation.Evaluation_SendEvidencesClient, *common.Evidence],
+	signer *Signer, evidence *common.Evidence) (err error) {
+	if evidence.TraceParent == "" {
+		evidence.TraceParent = tracing.TraceParent(ctx)
+	}
+	if evidence.RunId == "" {
+		evidence.RunId, _ = ctx.Value(runKey{}).(string)
+	}
+
+	if err = signer.Sign(evidence); err != nil {
+		evidencesNotSigned.WithLabelValues(evidence.ToolId).Inc()
+		return err
+	}
+
+	stream.Send(evidence)
+	evidencesSent.WithLabelValues(evidence.ToolId).Inc()
+
+	return nil
+}
@@ -0,0 +1,68 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package collection
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/square/go-jose.v2"
+
+	"github.com/eclipse-xfsc/cam/api/common"
+	"github.com/eclipse-xfsc/cam/internal/signing"
+)
+
+func TestSigner_Sign(t *testing.T) {
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	evidence := &common.Evidence{
+		Id:            "00000000-0000-0000-0000-000000000001",
+		TargetService: "00000000-0000-0000-0000-000000000000",
+		GatheredAt:    timestamppb.Now(),
+	}
+
+	// Without signer, evidences are not signed
+	var s *Signer
+	assert.NoError(t, s.Sign(evidence))
+	assert.Empty(t, evidence.Signature)
+
+	s, err = NewSigner(key, "key-1")
+	assert.NoError(t, err)
+	assert.NoError(t, s.Sign(evidence))
+
+	// The payload is detached
+	assert.Contains(t, evidence.Signature, "..")
+
+	payload, err := evidence.SigningPayload()
+	assert.NoError(t, err)
+
+	jws, err := jose.ParseDetached(evidence.Signature, payload)
+	assert.NoError(t, err)
+	_, err = jws.Verify(pub)
+	assert.NoError(t, err)
+	assert.Equal(t, "key-1", jws.Signatures[0].Header.KeyID)
+	assert.Equal(t, string(jose.EdDSA), jws.Signatures[0].Header.Algorithm)
+
+	_, err = NewSigner("no key", "")
+	assert.ErrorIs(t, err, signing.ErrUnsupportedKey)
+
+	_, err = LoadSigner("missing.pem", "")
+	assert.Error(t, err)
+}
@@ -131,6 +131,13 @@ class CollectorTls(CollectionServicer):
             self.StartCollecting(screq, context=None)
         return empty_pb2.Empty()
 
+    def Describe(self, request, context):
+        return DescribeResponse(
+            metric_ids=['TlsVersion', 'TlsCipherSuite', 'TlsCommonWeaknesses'],
+            config_message_type_url='type.googleapis.com/' +
+            CommunicationSecurityConfig.DESCRIPTOR.full_name,
+            version=os.environ.get('CAM_VERSION', 'dev'))
+
     def stop(self, grace=None):
         # for jobid,futu in self.monkeys.items(): futu.cancel()
         executor.shutdown(cancel_futures=True)
@@ -120,6 +120,15 @@ func (d *Discovery) authorize() (err error) {
 	return
 }
 
+// Authorize checks that the authentication options are accepted by OpenStack and that the compute and block storage
+// services can be reached. No resources are listed.
+func Authorize(o *AuthOptions) error {
+	d := new(Discovery)
+	WithAuthOpts(o)(d)
+
+	return d.authorize()
+}
+
 type ClientFunc func() (*gophercloud.ServiceClient, error)
 type ListFunc[O any] func(client *gophercloud.ServiceClient, opts O) pagination.Pager
 type HandlerFunc[T any, R voc.IsCloudResource] func(in *T) (r R, err error)
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 	"sync"
+	"time"
 
 	clapi "clouditor.io/clouditor/api"
 	clapidiscovery "clouditor.io/clouditor/api/discovery"
@@ -67,6 +68,9 @@ type Server struct {
 
 	authorizer clapi.Authorizer
 
+	// signer signs the evidences, if configured
+	signer *Signer
+
 	// Provider Configuration with the Cloud serviceID as key
 	providerConfigs map[string]providerConfiguration
 	// Mutex for provider configs
@@ -82,6 +86,13 @@ type providerConfiguration struct {
 	aws        *aws.Client
 }
 
+// WithSigner is an option to sign the evidences with the signer, so that the Evaluation Manager can verify them
+func WithSigner(signer *Signer) service.ServiceOption[Server] {
+	return func(s *Server) {
+		s.signer = signer
+	}
+}
+
 // WithOAuth2Authorizer is an option to use an OAuth 2.0 authorizer
 func WithOAuth2Authorizer(config *clientcredentials.Config) service.ServiceOption[Server] {
 	return func(srv *Server) {
@@ -117,7 +128,7 @@ func NewServer(opts ...service.ServiceOption[Server]) collection.CollectionServe
 
 // StartCollecting starts collecting configurations from Kubernetes and OpenStack, creates evidences and sends the
 // evidences to the Evaluation Manager.
-func (srv *Server) StartCollecting(_ context.Context, req *collection.StartCollectingRequest) (
+func (srv *Server) StartCollecting(ctx context.Context, req *collection.StartCollectingRequest) (
 	resp *collection.StartCollectingResponse, err error) {
 	var conf collection.WorkloadSecurityConfig
 
@@ -138,15 +149,11 @@ func (srv *Server) StartCollecting(_ context.Context, req *collection.StartColle
 		return
 	}
 
-	// Validate the StartCollectingRequest.RawConfiguration
-	// TODO (anatheka): after merging MR #74 (...Validate Method) we must add the CollectionModuleValidator call here
-	// err := collection.Validate[Config](req, validator)
-	// Convert and store provider configuration
-	err = srv.addProviderConfig(req, &conf)
-	if err != nil {
-		err = fmt.Errorf("could not add provider configuration: %w", err)
-		log.Error(err)
-		return nil, status.Errorf(codes.Internal, "%v", err)
+	// Validate the provider configurations
+	if err = conf.Validate(); err != nil {
+		err = status.Error(codes.InvalidArgument, err.Error())
+		log.Debug(err)
+		return
 	}
 
 	// Get stream for the Evaluation Manager
@@ -158,6 +165,39 @@ func (srv *Server) StartCollecting(_ context.Context, req *collection.StartColle
 		return nil, status.Errorf(codes.Internal, "%v", err)
 	}
 
+	// Generate a new ID
+	resp = &collection.StartCollectingResponse{
+		Id: uuid.NewString(),
+	}
+
+	// Secrets can be referenced instead of being contained in the configuration. The resolved configuration is
+	// validated again, since e.g. a referenced kube config could not be validated before. If this fails, no evidences
+	// can be collected.
+	if _, err = ResolveSecretRefs(ctx, req.ServiceId, &conf); err == nil {
+		err = conf.Validate()
+	}
+	if err != nil {
+		log.Warnf("Invalid configuration of service %s: %v", req.ServiceId, err)
+		evidence := InvalidConfigurationEvidence(ComponentID, req.ServiceId, req.ServiceId, err)
+		evidence.TenantId = req.TenantId
+		evidence.RunId = req.RunId
+		if err = SignAndSend(ctx, stream, srv.signer, evidence); err != nil {
+			return nil, status.Errorf(codes.Internal, "%v", err)
+		}
+		return resp, nil
+	}
+
+	// Convert and store provider configuration
+	err = srv.addProviderConfig(req, &conf)
+	if err != nil {
+		err = fmt.Errorf("could not add provider configuration: %w", err)
+		log.Error(err)
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	ctx, done := TrackCollection(ctx, ComponentID, req.RunId)
+	defer done()
+
 	// Get workload configurations
 	results, err := srv.getWorkloadConfigurations(req)
 	if err != nil {
@@ -167,18 +207,13 @@ func (srv *Server) StartCollecting(_ context.Context, req *collection.StartColle
 	}
 
 	// Create CAM evidence and send to stream channel
-	err = EnqueueEvidences(ComponentID, req, results, stream, log)
+	err = EnqueueEvidences(ctx, ComponentID, req, results, stream, srv.signer, log)
 	if err != nil {
 		err = fmt.Errorf("could not enqueue CAM evidence in stream channel: %v", err)
 		log.Error(err)
 		return nil, status.Errorf(codes.Internal, "%v", err)
 	}
 
-	// Generate a new ID
-	resp = &collection.StartCollectingResponse{
-		Id: uuid.NewString(),
-	}
-
 	return
 }
 
@@ -188,6 +223,68 @@ func (*Server) StopCollecting(_ context.Context, _ *collection.StopCollectingReq
 	return nil, status.Error(codes.Unimplemented, "StopCollection not implemented")
 }
 
+// Describe returns the metrics and the configuration type supported by this collection module
+func (*Server) Describe(_ context.Context, _ *collection.DescribeRequest) (*collection.DescribeResponse, error) {
+	return Describe(&collection.WorkloadSecurityConfig{}, "AtRestEncryption"), nil
+}
+
+// TestCollection validates the provider configurations, resolves their secret references and checks that the
+// Kubernetes cluster and OpenStack can be reached with them. No resources are retrieved. The AWS configuration is only
+// validated, since the AWS client is configured using the environment of the collection module.
+func (srv *Server) TestCollection(ctx context.Context, req *collection.TestCollectionRequest) (
+	res *collection.TestCollectionResponse, err error) {
+	var conf collection.WorkloadSecurityConfig
+
+	log.Infof("Received TestCollection Request for Service ID '%v'", req.ServiceId)
+
+	// Validate the request including the provider configurations
+	if err = req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = req.Configuration.RawConfiguration.UnmarshalTo(&conf)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, collection.ErrInvalidWorkloadConfigurationRawConfiguration.Error())
+	}
+
+	resolved, err := ResolveSecretRefs(ctx, req.ServiceId, &conf)
+	if err == nil {
+		err = conf.Validate()
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res = &collection.TestCollectionResponse{Checks: ResolvedChecks(resolved)}
+
+	if !isEmpty(conf.Kubernetes) {
+		clientset, err := workload.AuthFromKubeConfig([]byte(conf.Kubernetes.GetStringValue()))
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s: %v", collection.ErrKubernetesClientset, err)
+		}
+
+		version, err := clientset.Discovery().ServerVersion()
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "could not reach kubernetes cluster: %v", err)
+		}
+		res.Checks = append(res.Checks, fmt.Sprintf("reached kubernetes cluster (version %s)", version.GitVersion))
+	}
+
+	if !isEmpty(conf.Openstack) {
+		authOpts, err := openstackstrct.ToAuthOptions(conf.Openstack)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, collection.ErrConversionProtobufToAuthOptions.Error())
+		}
+
+		if err = openstack.Authorize(authOpts); err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "could not reach openstack: %v", err)
+		}
+		res.Checks = append(res.Checks, fmt.Sprintf("authorized to openstack at %s", authOpts.IdentityEndpoint))
+	}
+
+	return
+}
+
 // getWorkloadConfigurations configures discoverers based on the given ServiceID and retrieves resources
 func (srv *Server) getWorkloadConfigurations(req *collection.StartCollectingRequest) ([]voc.IsCloudResource, error) {
 	var (
@@ -206,7 +303,9 @@ func (srv *Server) getWorkloadConfigurations(req *collection.StartCollectingRequ
 
 	// Retrieve resources
 	for _, v := range discoverer {
+		start := time.Now()
 		list, err := v.List()
+		ObserveDiscoverer(ComponentID, v.Name(), start, err)
 		if err != nil {
 			err = fmt.Errorf("could not retrieve resources from %s: %v", v.Name(), err)
 			log.Error(err)
@@ -366,20 +465,3 @@ func isEmpty(value *structpb.Value) bool {
 
 	return false
 }
-
-// Not used now
-//func validator(req *collection.StartCollectingRequest) (conf *collection.ServiceConfiguration_WorkloadSecurityConfig, err error) {
-//	if req.Configuration.CollectionModule != collection.ServiceConfiguration_WORKLOAD_CONFIGURATION {
-//		err = collection.ErrInvalidCollectionModule
-//		return
-//	}
-//
-//	// TODO(anatheka): Use addProvider logic here?
-//	//conf, err = strct.ToStruct[collection.WorkloadConfig](req.Configuration.GetRawConfiguration())
-//	//if err != nil {
-//	//	err = fmt.Errorf("invalid configuration: raw configuration is not of type 'Config' ('kubernetes' or 'openstack'): %s", err)
-//	//	return
-//	//}
-//
-//	return
-//}
@@ -18,7 +18,11 @@ package workload
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"testing"
 
 	. "clouditor.io/clouditor/api"
@@ -35,6 +39,7 @@ import (
 	"github.com/eclipse-xfsc/cam/api/evaluation"
 	"github.com/eclipse-xfsc/cam/internal/testutil/testevaluation"
 	"github.com/eclipse-xfsc/cam/internal/testutil/testproto"
+	servicecollection "github.com/eclipse-xfsc/cam/service/collection"
 )
 
 func TestMain(m *testing.M) {
@@ -112,7 +117,6 @@ func Test_Server_StartCollecting(t *testing.T) {
 			},
 		},
 		{
-			// TODO(anatheka)
 			name: "Error in rawConfiguration",
 			fields: fields{
 				streams:  NewStreamsOf[evaluation.Evaluation_SendEvidencesClient, *common.Evidence](),
@@ -142,9 +146,31 @@ func Test_Server_StartCollecting(t *testing.T) {
 				},
 			},
 			wantErr: func(tt assert.TestingT, err error, i2 ...interface{}) bool {
-				assert.Equal(t, status.Code(err), codes.Internal)
-				return assert.ErrorContains(t, err, "could not add provider configuration:")
+				assert.Equal(t, status.Code(err), codes.InvalidArgument)
+				return assert.ErrorContains(t, err, collection.ErrInvalidKubernetesServiceConfiguration.Error())
+			},
+		},
+		{
+			name: "Missing secret reference",
+			fields: fields{
+				streams:  NewStreamsOf[evaluation.Evaluation_SendEvidencesClient, *common.Evidence](),
+				grpcOpts: []grpc.DialOption{grpc.WithContextDialer(testevaluation.BufConnDialer)},
+			},
+			args: args{
+				in0: context.Background(),
+				req: &collection.StartCollectingRequest{
+					EvalManager: "bufnet",
+					ServiceId:   "00000000-0000-0000-0000-000000000000",
+					Configuration: &collection.ServiceConfiguration{
+						ServiceId: "00000000-0000-0000-0000-000000000000",
+						RawConfiguration: testproto.NewAny(t, &collection.WorkloadSecurityConfig{
+							Kubernetes: structpb.NewStringValue("secretref:env:CAM_TEST_MISSING_KUBECONFIG"),
+						}),
+					},
+				},
 			},
+			// The missing secret is reported to the evaluation manager as evidence with an error
+			wantErr: nil,
 		},
 		// {
 		// TODO(anatheka: Add test when I know how the service config must look like
@@ -503,3 +529,108 @@ func Test_Server_openstackConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_Server_TestCollection(t *testing.T) {
+	// A fake Kubernetes API server, which only serves its version
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/version" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"major":"1","minor":"27","gitVersion":"v1.27.3"}`))
+	}))
+	t.Cleanup(api.Close)
+
+	kubeConfig := func(server string) *structpb.Value {
+		return structpb.NewStringValue(fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- cluster:
+    server: %s
+  name: test
+contexts:
+- context:
+    cluster: test
+    user: test
+  name: test
+current-context: test
+users:
+- name: test
+  user:
+    token: secret
+`, server))
+	}
+
+	kubeConfigFile := filepath.Join(t.TempDir(), "kubeconfig")
+	assert.NoError(t, os.WriteFile(kubeConfigFile, []byte(kubeConfig(api.URL).GetStringValue()), 0600))
+
+	// The operator of the collection module allows to reference the kube config
+	servicecollection.DefaultSecretResolver.AllowedFilePrefixes = []string{filepath.Dir(kubeConfigFile)}
+	t.Cleanup(func() { servicecollection.DefaultSecretResolver.AllowedFilePrefixes = nil })
+
+	tests := []struct {
+		name       string
+		config     *collection.WorkloadSecurityConfig
+		wantChecks []string
+		wantCode   codes.Code
+	}{
+		{
+			name:       "Kubernetes cluster is reachable",
+			config:     &collection.WorkloadSecurityConfig{Kubernetes: kubeConfig(api.URL)},
+			wantChecks: []string{"reached kubernetes cluster (version v1.27.3)"},
+			wantCode:   codes.OK,
+		},
+		{
+			name: "Referenced kube config",
+			config: &collection.WorkloadSecurityConfig{
+				Kubernetes: structpb.NewStringValue("secretref:file:" + kubeConfigFile),
+			},
+			wantChecks: []string{
+				"resolved secret reference of kubernetes",
+				"reached kubernetes cluster (version v1.27.3)",
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "Missing referenced kube config",
+			config: &collection.WorkloadSecurityConfig{
+				Kubernetes: structpb.NewStringValue("secretref:env:CAM_TEST_MISSING_KUBECONFIG"),
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Kubernetes cluster is not reachable",
+			config:   &collection.WorkloadSecurityConfig{Kubernetes: kubeConfig("http://127.0.0.1:1")},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "Invalid kube config",
+			config:   &collection.WorkloadSecurityConfig{Kubernetes: structpb.NewStringValue("apiVersion: [")},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "No provider",
+			config:   &collection.WorkloadSecurityConfig{},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := NewServer()
+
+			res, err := srv.TestCollection(context.Background(), &collection.TestCollectionRequest{
+				ServiceId: "00000000-0000-0000-0000-000000000000",
+				Configuration: &collection.ServiceConfiguration{
+					ServiceId:        "00000000-0000-0000-0000-000000000000",
+					RawConfiguration: testproto.NewAny(t, tt.config),
+				},
+			})
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantChecks, res.GetChecks())
+
+			// Testing a configuration does not store it
+			assert.Empty(t, srv.(*Server).providerConfigs)
+		})
+	}
+}
@@ -0,0 +1,248 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package configuration
+
+import (
+	"context"
+	"errors"
+
+	"clouditor.io/clouditor/persistence"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eclipse-xfsc/cam/api/configuration"
+)
+
+// trustStoreVersionID is the ID of the single entry that holds the version of the attestation trust store
+const trustStoreVersionID = 1
+
+// StoreAttestationTrustAnchor adds or updates a trust anchor of the attestation trust store
+func (srv *Server) StoreAttestationTrustAnchor(_ context.Context, req *configuration.StoreAttestationTrustAnchorRequest) (
+	res *configuration.AttestationTrustAnchor, err error) {
+	if err = req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	res = req.Anchor
+	if res.Id == "" {
+		res.Id = uuid.NewString()
+	}
+
+	err = srv.changeTrustStore(func(version int64) error {
+		res.Version = version
+		res.UpdatedAt = timestamppb.Now()
+
+		return srv.storage.Save(res, "id = ?", res.Id)
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "DB error: %v", err)
+	}
+
+	log.Infof("Stored attestation trust anchor '%s' (version %d)", res.Id, res.Version)
+
+	return
+}
+
+// ListAttestationTrustAnchors returns all trust anchors of the attestation trust store
+func (srv *Server) ListAttestationTrustAnchors(_ context.Context, _ *configuration.ListAttestationTrustAnchorsRequest) (
+	res *configuration.ListAttestationTrustAnchorsResponse, err error) {
+	res = new(configuration.ListAttestationTrustAnchorsResponse)
+	err = srv.storage.List(&res.Anchors, "id", true, 0, -1)
+	if err != nil {
+		err = status.Errorf(codes.Internal, "DB error: %v", err)
+	}
+	return
+}
+
+// RemoveAttestationTrustAnchor removes the trust anchor with the ID specified in the request
+func (srv *Server) RemoveAttestationTrustAnchor(_ context.Context, req *configuration.RemoveAttestationTrustAnchorRequest) (
+	res *emptypb.Empty, err error) {
+	err = srv.changeTrustStore(func(_ int64) error {
+		return srv.storage.Delete(&configuration.AttestationTrustAnchor{}, "id = ?", req.AnchorId)
+	})
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.NotFound, "Could not delete trust anchor: %v", err)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "DB error: %v", err)
+	}
+
+	res = &emptypb.Empty{}
+	return
+}
+
+// StoreAttestationReferenceManifest adds or updates a reference manifest of the attestation trust store
+func (srv *Server) StoreAttestationReferenceManifest(_ context.Context,
+	req *configuration.StoreAttestationReferenceManifestRequest) (res *configuration.AttestationReferenceManifest, err error) {
+	if err = req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	res = req.Manifest
+	if res.Id == "" {
+		res.Id = uuid.NewString()
+	}
+
+	err = srv.changeTrustStore(func(version int64) error {
+		res.Version = version
+		res.UpdatedAt = timestamppb.Now()
+
+		return srv.storage.Save(res, "id = ?", res.Id)
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "DB error: %v", err)
+	}
+
+	log.Infof("Stored attestation reference manifest '%s' (version %d)", res.Name, res.Version)
+
+	return
+}
+
+// ListAttestationReferenceManifests returns all reference manifests of the attestation trust store
+func (srv *Server) ListAttestationReferenceManifests(_ context.Context,
+	_ *configuration.ListAttestationReferenceManifestsRequest) (
+	res *configuration.ListAttestationReferenceManifestsResponse, err error) {
+	res = new(configuration.ListAttestationReferenceManifestsResponse)
+	err = srv.storage.List(&res.Manifests, "id", true, 0, -1)
+	if err != nil {
+		err = status.Errorf(codes.Internal, "DB error: %v", err)
+	}
+	return
+}
+
+// RemoveAttestationReferenceManifest removes the reference manifest with the ID specified in the request
+func (srv *Server) RemoveAttestationReferenceManifest(_ context.Context,
+	req *configuration.RemoveAttestationReferenceManifestRequest) (res *emptypb.Empty, err error) {
+	err = srv.changeTrustStore(func(_ int64) error {
+		return srv.storage.Delete(&configuration.AttestationReferenceManifest{}, "id = ?", req.ManifestId)
+	})
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.NotFound, "Could not delete reference manifest: %v", err)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "DB error: %v", err)
+	}
+
+	res = &emptypb.Empty{}
+	return
+}
+
+// RevokeAttestationCertificate adds a certificate to the revoked certificates of the attestation trust store
+func (srv *Server) RevokeAttestationCertificate(_ context.Context, req *configuration.RevokeAttestationCertificateRequest) (
+	res *configuration.RevokedCertificate, err error) {
+	if err = req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	res = req.Certificate
+
+	// The key ID is stored normalized, since the integrity module compares it with the key IDs of the signatures
+	res.SubjectKeyId, _ = configuration.NormalizeKeyID(res.SubjectKeyId)
+
+	err = srv.changeTrustStore(func(version int64) error {
+		res.Version = version
+		res.RevokedAt = timestamppb.Now()
+
+		return srv.storage.Save(res, "subject_key_id = ?", res.SubjectKeyId)
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "DB error: %v", err)
+	}
+
+	log.Infof("Revoked attestation certificate with subject key ID %s (version %d)", res.SubjectKeyId, res.Version)
+
+	return
+}
+
+// ListRevokedAttestationCertificates returns all revoked certificates of the attestation trust store
+func (srv *Server) ListRevokedAttestationCertificates(_ context.Context,
+	_ *configuration.ListRevokedAttestationCertificatesRequest) (
+	res *configuration.ListRevokedAttestationCertificatesResponse, err error) {
+	res = new(configuration.ListRevokedAttestationCertificatesResponse)
+	err = srv.storage.List(&res.Certificates, "subject_key_id", true, 0, -1)
+	if err != nil {
+		err = status.Errorf(codes.Internal, "DB error: %v", err)
+	}
+	return
+}
+
+// GetAttestationTrustStore returns the complete attestation trust store. If the trust store has not changed since
+// the version known to the caller, only the version is returned.
+func (srv *Server) GetAttestationTrustStore(_ context.Context, req *configuration.GetAttestationTrustStoreRequest) (
+	res *configuration.AttestationTrustStore, err error) {
+	srv.trustStoreMutex.Lock()
+	defer srv.trustStoreMutex.Unlock()
+
+	res = new(configuration.AttestationTrustStore)
+
+	res.Version, err = srv.trustStoreVersion()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "DB error: %v", err)
+	}
+
+	if req.KnownVersion != 0 && req.KnownVersion == res.Version {
+		return
+	}
+
+	if err = srv.storage.List(&res.TrustAnchors, "id", true, 0, -1); err != nil {
+		return nil, status.Errorf(codes.Internal, "DB error: %v", err)
+	}
+	if err = srv.storage.List(&res.ReferenceManifests, "id", true, 0, -1); err != nil {
+		return nil, status.Errorf(codes.Internal, "DB error: %v", err)
+	}
+	if err = srv.storage.List(&res.RevokedCertificates, "subject_key_id", true, 0, -1); err != nil {
+		return nil, status.Errorf(codes.Internal, "DB error: %v", err)
+	}
+
+	return
+}
+
+// changeTrustStore applies the change to the attestation trust store and increases its version, if the change was
+// successful. The change is called with the new version.
+func (srv *Server) changeTrustStore(change func(version int64) error) (err error) {
+	var version int64
+
+	srv.trustStoreMutex.Lock()
+	defer srv.trustStoreMutex.Unlock()
+
+	version, err = srv.trustStoreVersion()
+	if err != nil {
+		return
+	}
+	version++
+
+	if err = change(version); err != nil {
+		return
+	}
+
+	return srv.storage.Save(&configuration.AttestationTrustStoreVersion{Id: trustStoreVersionID, Version: version},
+		"id = ?", trustStoreVersionID)
+}
+
+// trustStoreVersion returns the current version of the attestation trust store, which is 0 for an empty store.
+func (srv *Server) trustStoreVersion() (version int64, err error) {
+	var v configuration.AttestationTrustStoreVersion
+
+	err = srv.storage.Get(&v, "id = ?", trustStoreVersionID)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+
+	return v.Version, nil
+}
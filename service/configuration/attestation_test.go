@@ -0,0 +1,220 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package configuration
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/persistence"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/eclipse-xfsc/cam/api/configuration"
+	"github.com/eclipse-xfsc/cam/internal/testutil"
+)
+
+func TestServer_StoreAttestationTrustAnchor(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+	}
+	type args struct {
+		req *configuration.StoreAttestationTrustAnchorRequest
+	}
+	tests := []struct {
+		name        string
+		fields      fields
+		args        args
+		wantVersion int64
+		wantErr     assert.ErrorAssertionFunc
+	}{
+		{
+			name:   "Invalid certificate",
+			fields: fields{storage: testutil.NewInMemoryStorage(t)},
+			args: args{req: &configuration.StoreAttestationTrustAnchorRequest{
+				Anchor: &configuration.AttestationTrustAnchor{Name: "CA", Certificate: "not a certificate"},
+			}},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name:   "First anchor",
+			fields: fields{storage: testutil.NewInMemoryStorage(t)},
+			args: args{req: &configuration.StoreAttestationTrustAnchorRequest{
+				Anchor: &configuration.AttestationTrustAnchor{Name: "CA", Certificate: mockCertificate(t)},
+			}},
+			wantVersion: 1,
+			wantErr:     assert.NoError,
+		},
+		{
+			name: "Update existing anchor",
+			fields: fields{storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+				_ = s.Create(&configuration.AttestationTrustAnchor{Id: "anchor1", Version: 3})
+				_ = s.Create(&configuration.AttestationTrustStoreVersion{Id: trustStoreVersionID, Version: 3})
+			})},
+			args: args{req: &configuration.StoreAttestationTrustAnchorRequest{
+				Anchor: &configuration.AttestationTrustAnchor{Id: "anchor1", Name: "CA", Certificate: mockCertificate(t)},
+			}},
+			wantVersion: 4,
+			wantErr:     assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := &Server{storage: tt.fields.storage}
+
+			res, err := srv.StoreAttestationTrustAnchor(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			if err != nil {
+				return
+			}
+
+			assert.NotEmpty(t, res.Id)
+			assert.Equal(t, tt.wantVersion, res.Version)
+
+			version, err := srv.trustStoreVersion()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantVersion, version)
+
+			var count int64
+			count, err = srv.storage.Count(&configuration.AttestationTrustAnchor{})
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1), count)
+		})
+	}
+}
+
+func TestServer_GetAttestationTrustStore(t *testing.T) {
+	var pcr int32 = 8
+
+	srv := &Server{storage: testutil.NewInMemoryStorage(t)}
+
+	anchor, err := srv.StoreAttestationTrustAnchor(context.Background(), &configuration.StoreAttestationTrustAnchorRequest{
+		Anchor: &configuration.AttestationTrustAnchor{Name: "CA", Certificate: mockCertificate(t)},
+	})
+	assert.NoError(t, err)
+
+	_, err = srv.StoreAttestationReferenceManifest(context.Background(),
+		&configuration.StoreAttestationReferenceManifestRequest{
+			Manifest: &configuration.AttestationReferenceManifest{
+				Name: "os",
+				ReferenceValues: []*configuration.AttestationReferenceValue{
+					{Name: "kernel", Pcr: &pcr, Sha256: "aa"},
+				},
+			},
+		})
+	assert.NoError(t, err)
+
+	_, err = srv.RevokeAttestationCertificate(context.Background(), &configuration.RevokeAttestationCertificateRequest{
+		Certificate: &configuration.RevokedCertificate{SubjectKeyId: "AB:CD", Reason: "key compromise"},
+	})
+	assert.NoError(t, err)
+
+	// The complete store is returned for an unknown version
+	store, err := srv.GetAttestationTrustStore(context.Background(), &configuration.GetAttestationTrustStoreRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), store.Version)
+	assert.Equal(t, 1, len(store.TrustAnchors))
+	assert.Equal(t, 1, len(store.ReferenceManifests))
+	assert.Equal(t, "kernel", store.ReferenceManifests[0].ReferenceValues[0].Name)
+	assert.Equal(t, pcr, *store.ReferenceManifests[0].ReferenceValues[0].Pcr)
+	assert.Equal(t, 1, len(store.RevokedCertificates))
+	assert.Equal(t, "abcd", store.RevokedCertificates[0].SubjectKeyId)
+
+	// Only the version is returned, if the store has not changed
+	store, err = srv.GetAttestationTrustStore(context.Background(),
+		&configuration.GetAttestationTrustStoreRequest{KnownVersion: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), store.Version)
+	assert.Empty(t, store.TrustAnchors)
+
+	// Removing an entry changes the version as well
+	_, err = srv.RemoveAttestationReferenceManifest(context.Background(),
+		&configuration.RemoveAttestationReferenceManifestRequest{ManifestId: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = srv.RemoveAttestationTrustAnchor(context.Background(),
+		&configuration.RemoveAttestationTrustAnchorRequest{AnchorId: anchor.Id})
+	assert.NoError(t, err)
+
+	store, err = srv.GetAttestationTrustStore(context.Background(),
+		&configuration.GetAttestationTrustStoreRequest{KnownVersion: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), store.Version)
+	assert.Empty(t, store.TrustAnchors)
+	assert.Equal(t, 1, len(store.ReferenceManifests))
+}
+
+func TestServer_RevokeAttestationCertificate(t *testing.T) {
+	srv := &Server{storage: testutil.NewInMemoryStorage(t)}
+
+	// Key IDs are stored as lower-case hex without separators, as compared by the integrity module
+	revoked, err := srv.RevokeAttestationCertificate(context.Background(),
+		&configuration.RevokeAttestationCertificateRequest{
+			Certificate: &configuration.RevokedCertificate{SubjectKeyId: "AB:CD:EF:01", Reason: "key compromise"},
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, "abcdef01", revoked.SubjectKeyId)
+
+	// Revoking the same certificate with another notation updates the existing revocation
+	_, err = srv.RevokeAttestationCertificate(context.Background(), &configuration.RevokeAttestationCertificateRequest{
+		Certificate: &configuration.RevokedCertificate{SubjectKeyId: "ABCDEF01", Reason: "superseded"},
+	})
+	assert.NoError(t, err)
+
+	res, err := srv.ListRevokedAttestationCertificates(context.Background(),
+		&configuration.ListRevokedAttestationCertificatesRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(res.Certificates))
+	assert.Equal(t, "abcdef01", res.Certificates[0].SubjectKeyId)
+	assert.Equal(t, "superseded", res.Certificates[0].Reason)
+
+	// Key IDs, which are not hex-encoded, are rejected
+	_, err = srv.RevokeAttestationCertificate(context.Background(), &configuration.RevokeAttestationCertificateRequest{
+		Certificate: &configuration.RevokedCertificate{SubjectKeyId: "not-a-key-id"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// mockCertificate creates a self-signed PEM-encoded CA certificate
+func mockCertificate(t *testing.T) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	tmpl := x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &tmpl, &tmpl, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
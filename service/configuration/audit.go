@@ -0,0 +1,408 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package configuration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"clouditor.io/clouditor/api/orchestrator"
+	cl_service "clouditor.io/clouditor/service"
+	"github.com/google/uuid"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eclipse-xfsc/cam/api/collection"
+	"github.com/eclipse-xfsc/cam/api/configuration"
+	"github.com/eclipse-xfsc/cam/internal/authz"
+	"github.com/eclipse-xfsc/cam/service"
+)
+
+// auditOrderFields are the fields, by which audit events can be ordered
+var auditOrderFields = []string{"time", "actor", "action", "service_id"}
+
+// auditedCall describes how a modifying RPC is recorded in the audit log
+type auditedCall struct {
+	// target returns the target of the call within the service or the CAM. The response is nil before the call.
+	target func(req, res interface{}) string
+
+	// snapshot returns the state of the target, which is recorded before and after the call. Calls without snapshot
+	// record their response as state after the call.
+	snapshot func(srv *Server, serviceID, target string) (proto.Message, error)
+}
+
+// auditedCalls contains the modifying RPCs of the Configuration service, which are recorded in the audit log. Calls,
+// which are frequently made by CAM components, e.g., ReportEvidences or RenewCollectionModuleLease, and dry-runs like
+// TestCollection are not recorded.
+var auditedCalls = map[string]auditedCall{
+	"/cam.Configuration/StartMonitoring":           {snapshot: monitoringSnapshot},
+	"/cam.Configuration/UpdateMonitoring":          {snapshot: monitoringSnapshot},
+	"/cam.Configuration/StopMonitoring":            {snapshot: monitoringSnapshot},
+	"/cam.Configuration/UpdateMetricConfiguration": {target: metricTarget, snapshot: metricConfigurationSnapshot},
+	"/cam.Configuration/RegisterCloudService":      {},
+	"/cam.Configuration/UpdateCloudService":        {snapshot: cloudServiceSnapshot},
+	"/cam.Configuration/RemoveCloudService":        {snapshot: cloudServiceSnapshot},
+	"/cam.Configuration/ConfigureCloudService":     {snapshot: configurationsSnapshot},
+	"/cam.Configuration/AddCollectionModule": {target: moduleTarget,
+		snapshot: storedSnapshot(func() proto.Message { return new(collection.CollectionModule) }, "id")},
+	"/cam.Configuration/RegisterCollectionModule": {target: moduleTarget,
+		snapshot: storedSnapshot(func() proto.Message { return new(collection.CollectionModule) }, "id")},
+	"/cam.Configuration/RemoveCollectionModule": {target: moduleTarget,
+		snapshot: storedSnapshot(func() proto.Message { return new(collection.CollectionModule) }, "id")},
+	"/cam.Configuration/StoreAttestationTrustAnchor": {target: anchorTarget,
+		snapshot: storedSnapshot(func() proto.Message { return new(configuration.AttestationTrustAnchor) }, "id")},
+	"/cam.Configuration/RemoveAttestationTrustAnchor": {target: anchorTarget,
+		snapshot: storedSnapshot(func() proto.Message { return new(configuration.AttestationTrustAnchor) }, "id")},
+	"/cam.Configuration/StoreAttestationReferenceManifest": {target: manifestTarget,
+		snapshot: storedSnapshot(func() proto.Message { return new(configuration.AttestationReferenceManifest) }, "id")},
+	"/cam.Configuration/RemoveAttestationReferenceManifest": {target: manifestTarget,
+		snapshot: storedSnapshot(func() proto.Message { return new(configuration.AttestationReferenceManifest) }, "id")},
+	"/cam.Configuration/RevokeAttestationCertificate": {target: certificateTarget,
+		snapshot: storedSnapshot(func() proto.Message { return new(configuration.RevokedCertificate) }, "subject_key_id")},
+}
+
+// AuditUnaryInterceptor records the calls of the modifying RPCs in the audit log. It needs to be installed after the
+// interceptor, which stores the claims of the caller in the context, and before the authorization, so that denied
+// calls are recorded as well.
+func (srv *Server) AuditUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (res interface{}, err error) {
+	call, ok := auditedCalls[info.FullMethod]
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	var (
+		serviceID = auditServiceID(req, nil)
+		target    = call.targetOf(req, nil)
+		before    string
+	)
+
+	event := &configuration.AuditEvent{
+		Id:        uuid.NewString(),
+		Time:      timestamppb.Now(),
+		Action:    path.Base(info.FullMethod),
+		ServiceId: serviceID,
+		Target:    target,
+	}
+	if claims := service.ClaimsFromContext(ctx); claims != nil {
+		event.Actor = claims.Subject
+	}
+
+	// The tenant is determined before the call, since the service may be removed by it
+	if serviceID != "" {
+		event.TenantId, _ = srv.tenantOf(serviceID)
+	} else {
+		event.TenantId, _ = authz.TenantFromContext(ctx)
+	}
+
+	if call.snapshot != nil {
+		before = srv.auditSnapshot(call, serviceID, target)
+	}
+
+	res, err = handler(ctx, req)
+
+	event.Status = status.Code(err).String()
+	if err == nil {
+		if event.ServiceId == "" {
+			event.ServiceId = auditServiceID(req, res)
+		}
+		event.Target = call.targetOf(req, res)
+		event.Before = before
+
+		if call.snapshot != nil {
+			event.After = srv.auditSnapshot(call, event.ServiceId, event.Target)
+		} else if m, ok := res.(proto.Message); ok {
+			event.After = auditJSON(m)
+		}
+
+		event.Changes = auditChanges(event.Before, event.After)
+	}
+
+	if err := srv.storage.Create(event); err != nil {
+		log.Errorf("Could not record %s of %s in audit log: %v", event.Action, event.Actor, err)
+	}
+
+	return
+}
+
+// ListAuditEvents lists the recorded audit events of the tenant of the caller. The events are filtered by the query
+// parameters and, by default, ordered by time with the most recent first.
+func (srv *Server) ListAuditEvents(ctx context.Context, req *configuration.ListAuditEventsRequest) (
+	res *configuration.ListAuditEventsResponse, err error) {
+	var (
+		query []string
+		args  []interface{}
+		conds []interface{}
+	)
+
+	if req.OrderBy == "" {
+		req.OrderBy = "time"
+	} else if !slices.Contains(auditOrderFields, req.OrderBy) {
+		return nil, status.Errorf(codes.InvalidArgument, "events can only be ordered by %s",
+			strings.Join(auditOrderFields, ", "))
+	}
+
+	if req.ServiceId != "" {
+		query, args = append(query, "service_id = ?"), append(args, req.ServiceId)
+	}
+	if req.Actor != "" {
+		query, args = append(query, "actor = ?"), append(args, req.Actor)
+	}
+	if req.Action != "" {
+		query, args = append(query, "action = ?"), append(args, req.Action)
+	}
+	if req.From != nil {
+		query, args = append(query, "time >= ?"), append(args, req.From.AsTime())
+	}
+	if req.To != nil {
+		query, args = append(query, "time <= ?"), append(args, req.To.AsTime())
+	}
+	if len(query) > 0 {
+		conds = append([]interface{}{strings.Join(query, " AND ")}, args...)
+	}
+
+	res = new(configuration.ListAuditEventsResponse)
+	res.Events, res.NextPageToken, err = cl_service.PaginateStorage[*configuration.AuditEvent](req, srv.storage,
+		cl_service.DefaultPaginationOpts, authz.TenantConds(ctx, conds...)...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "DB error: %v", err)
+	}
+
+	return
+}
+
+// targetOf returns the target of the call. Calls without target function have no target, e.g., calls, which apply
+// to the whole service.
+func (call auditedCall) targetOf(req, res interface{}) string {
+	if call.target == nil {
+		return ""
+	}
+
+	return call.target(req, res)
+}
+
+// auditSnapshot returns the JSON representation of the state of the target or an empty string, if it does not exist.
+// The snapshot is taken without the claims of the caller, so that it is not restricted to the tenant of the caller and
+// secrets are redacted.
+func (srv *Server) auditSnapshot(call auditedCall, serviceID, target string) string {
+	m, err := call.snapshot(srv, serviceID, target)
+	if err != nil {
+		return ""
+	}
+
+	return auditJSON(m)
+}
+
+// auditServiceID returns the ID of the service of the request or, e.g., for newly registered services, of the
+// response
+func auditServiceID(req, res interface{}) string {
+	if r, ok := req.(interface{ GetServiceId() string }); ok {
+		return r.GetServiceId()
+	}
+
+	if cs, ok := res.(*orchestrator.CloudService); ok {
+		return cs.GetId()
+	}
+
+	return ""
+}
+
+func monitoringSnapshot(srv *Server, serviceID, _ string) (proto.Message, error) {
+	return srv.GetMonitoringStatus(context.Background(), &configuration.GetMonitoringStatusRequest{ServiceId: serviceID})
+}
+
+func metricConfigurationSnapshot(srv *Server, serviceID, metricID string) (proto.Message, error) {
+	return srv.GetMetricConfiguration(context.Background(),
+		&orchestrator.GetMetricConfigurationRequest{ServiceId: serviceID, MetricId: metricID})
+}
+
+func cloudServiceSnapshot(srv *Server, serviceID, _ string) (proto.Message, error) {
+	return srv.GetCloudService(context.Background(), &orchestrator.GetCloudServiceRequest{ServiceId: serviceID})
+}
+
+func configurationsSnapshot(srv *Server, serviceID, _ string) (proto.Message, error) {
+	return srv.ListCloudServiceConfigurations(context.Background(),
+		&configuration.ListCloudServiceConfigurationsRequest{ServiceId: serviceID})
+}
+
+// storedSnapshot returns a snapshot function, which retrieves the stored entity with the target as key
+func storedSnapshot(newEntity func() proto.Message, key string) func(*Server, string, string) (proto.Message, error) {
+	return func(srv *Server, _, target string) (proto.Message, error) {
+		m := newEntity()
+		if target == "" {
+			return nil, configuration.ErrRequestEmpty
+		}
+
+		if err := srv.storage.Get(m, key+" = ?", target); err != nil {
+			return nil, err
+		}
+
+		return m, nil
+	}
+}
+
+func metricTarget(req, _ interface{}) string {
+	return req.(*orchestrator.UpdateMetricConfigurationRequest).GetMetricId()
+}
+
+func moduleTarget(req, res interface{}) string {
+	if cm, ok := res.(*collection.CollectionModule); ok {
+		return cm.GetId()
+	}
+
+	switch r := req.(type) {
+	case *configuration.AddCollectionModuleRequest:
+		return r.GetModule().GetId()
+	case *configuration.RegisterCollectionModuleRequest:
+		return r.GetModule().GetId()
+	case *configuration.RemoveCollectionModuleRequest:
+		return r.GetModuleId()
+	}
+
+	return ""
+}
+
+func anchorTarget(req, res interface{}) string {
+	if a, ok := res.(*configuration.AttestationTrustAnchor); ok {
+		return a.GetId()
+	}
+
+	switch r := req.(type) {
+	case *configuration.StoreAttestationTrustAnchorRequest:
+		return r.GetAnchor().GetId()
+	case *configuration.RemoveAttestationTrustAnchorRequest:
+		return r.GetAnchorId()
+	}
+
+	return ""
+}
+
+func manifestTarget(req, res interface{}) string {
+	if m, ok := res.(*configuration.AttestationReferenceManifest); ok {
+		return m.GetId()
+	}
+
+	switch r := req.(type) {
+	case *configuration.StoreAttestationReferenceManifestRequest:
+		return r.GetManifest().GetId()
+	case *configuration.RemoveAttestationReferenceManifestRequest:
+		return r.GetManifestId()
+	}
+
+	return ""
+}
+
+func certificateTarget(req, _ interface{}) string {
+	return req.(*configuration.RevokeAttestationCertificateRequest).GetCertificate().GetSubjectKeyId()
+}
+
+// auditJSON returns the compact JSON representation of the message. Empty responses are represented by an empty
+// string.
+func auditJSON(m proto.Message) string {
+	if _, ok := m.(*emptypb.Empty); ok {
+		return ""
+	}
+
+	b, err := protojson.Marshal(m)
+	if err != nil {
+		return ""
+	}
+
+	// protojson does not guarantee a stable output, so we compact it
+	var buf bytes.Buffer
+	if err = json.Compact(&buf, b); err != nil {
+		return ""
+	}
+
+	return buf.String()
+}
+
+// auditChanges returns the changes between the JSON representations before and after a call. Each change refers to a
+// leaf of the JSON documents by its path, e.g., configurations.0.serviceId.
+func auditChanges(before, after string) (changes []*configuration.AuditChange) {
+	var (
+		b     = flattenJSON(before)
+		a     = flattenJSON(after)
+		paths []string
+	)
+
+	for p := range b {
+		paths = append(paths, p)
+	}
+	for p := range a {
+		if _, ok := b[p]; !ok {
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+
+	for _, p := range paths {
+		if b[p] != a[p] {
+			changes = append(changes, &configuration.AuditChange{Path: p, Before: b[p], After: a[p]})
+		}
+	}
+
+	return
+}
+
+// flattenJSON maps the paths of the leaves of the JSON document to their JSON representation
+func flattenJSON(doc string) (leaves map[string]string) {
+	var v interface{}
+
+	leaves = make(map[string]string)
+	if doc == "" || json.Unmarshal([]byte(doc), &v) != nil {
+		return
+	}
+
+	flatten("", v, leaves)
+
+	return
+}
+
+func flatten(prefix string, v interface{}, leaves map[string]string) {
+	join := func(key string) string {
+		if prefix == "" {
+			return key
+		}
+
+		return prefix + "." + key
+	}
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			flatten(join(k), e, leaves)
+		}
+	case []interface{}:
+		for i, e := range v {
+			flatten(join(strconv.Itoa(i)), e, leaves)
+		}
+	default:
+		b, _ := json.Marshal(v)
+		leaves[prefix] = string(b)
+	}
+}
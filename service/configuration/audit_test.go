@@ -0,0 +1,252 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package configuration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/api/orchestrator"
+	"clouditor.io/clouditor/persistence"
+	orchestrator2 "clouditor.io/clouditor/service/orchestrator"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eclipse-xfsc/cam/api/collection"
+	"github.com/eclipse-xfsc/cam/api/configuration"
+	"github.com/eclipse-xfsc/cam/internal/authz"
+	"github.com/eclipse-xfsc/cam/internal/testutil"
+	"github.com/eclipse-xfsc/cam/internal/testutil/testproto"
+	"github.com/eclipse-xfsc/cam/service"
+)
+
+// audited calls the handler with the request through the audit interceptor of the server
+func audited[Req any, Res any](srv *Server, ctx context.Context, method string, req Req,
+	handler func(context.Context, Req) (Res, error)) (res interface{}, err error) {
+	return srv.AuditUnaryInterceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: "/cam.Configuration/" + method},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return handler(ctx, req.(Req))
+		})
+}
+
+// auditEvents returns the recorded audit events in the order of their recording
+func auditEvents(t *testing.T, s persistence.Storage) (events []*configuration.AuditEvent) {
+	assert.NoError(t, s.List(&events, "time", true, 0, -1))
+	return
+}
+
+func TestServer_AuditUnaryInterceptor(t *testing.T) {
+	alice := service.ContextWithClaims(context.Background(), &service.Claims{
+		Roles:    []string{string(authz.RoleServiceOwner)},
+		Services: []string{authz.AllServices},
+		Tenant:   "alice",
+		Scopes:   []string{SecretsScope},
+	})
+	service.ClaimsFromContext(alice).Subject = "alice@example.com"
+
+	s := testutil.NewInMemoryStorage(t)
+	srv := &Server{storage: s, OrchestratorServer: orchestrator2.NewService(orchestrator2.WithStorage(s))}
+
+	// Newly registered services are recorded with their response
+	res, err := audited(srv, alice, "RegisterCloudService",
+		&orchestrator.RegisterCloudServiceRequest{Service: &orchestrator.CloudService{Name: "service"}},
+		srv.RegisterCloudService)
+	assert.NoError(t, err)
+	cs := res.(*orchestrator.CloudService)
+
+	// Changes are recorded as difference between the state before and after the call
+	_, err = audited(srv, alice, "UpdateCloudService", &orchestrator.UpdateCloudServiceRequest{
+		ServiceId: cs.Id,
+		Service:   &orchestrator.CloudService{Id: cs.Id, Name: "renamed service"},
+	}, srv.UpdateCloudService)
+	assert.NoError(t, err)
+
+	// Secrets are redacted, even if the caller may read them
+	_, err = audited(srv, alice, "ConfigureCloudService", &configuration.ConfigureCloudServiceRequest{
+		ServiceId: cs.Id,
+		Configurations: &configuration.Configurations{
+			Configurations: []*collection.ServiceConfiguration{{
+				RawConfiguration: testproto.NewAny(t, &collection.AuthenticationSecurityConfig{
+					Issuer:       "https://issuer.example.com",
+					ClientId:     "client",
+					ClientSecret: "my-secret",
+				}),
+			}},
+		},
+	}, srv.ConfigureCloudService)
+	assert.NoError(t, err)
+
+	// Reads are not recorded
+	_, err = audited(srv, alice, "GetCloudService", &orchestrator.GetCloudServiceRequest{ServiceId: cs.Id},
+		srv.GetCloudService)
+	assert.NoError(t, err)
+
+	// Denied calls are recorded without state
+	_, err = audited(srv, context.Background(), "RemoveCloudService",
+		&orchestrator.RemoveCloudServiceRequest{ServiceId: cs.Id},
+		func(context.Context, *orchestrator.RemoveCloudServiceRequest) (interface{}, error) {
+			return nil, status.Error(codes.PermissionDenied, "not permitted")
+		})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Removals keep the tenant of the removed service
+	_, err = audited(srv, alice, "RemoveCloudService", &orchestrator.RemoveCloudServiceRequest{ServiceId: cs.Id},
+		srv.RemoveCloudService)
+	assert.NoError(t, err)
+
+	events := auditEvents(t, s)
+	assert.Len(t, events, 5)
+
+	for _, e := range events {
+		assert.NotEmpty(t, e.Id)
+		assert.Equal(t, cs.Id, e.ServiceId)
+		assert.Equal(t, "alice", e.TenantId)
+	}
+
+	register := events[0]
+	assert.Equal(t, "RegisterCloudService", register.Action)
+	assert.Equal(t, "alice@example.com", register.Actor)
+	assert.Equal(t, codes.OK.String(), register.Status)
+	assert.Empty(t, register.Before)
+	assert.Contains(t, register.After, `"name":"service"`)
+
+	update := events[1]
+	assert.Equal(t, "UpdateCloudService", update.Action)
+	assert.Equal(t, []*configuration.AuditChange{{Path: "name", Before: `"service"`, After: `"renamed service"`}},
+		update.Changes)
+
+	configure := events[2]
+	assert.Equal(t, "ConfigureCloudService", configure.Action)
+	assert.Contains(t, configure.After, "https://issuer.example.com")
+	assert.Contains(t, configure.After, RedactedSecret)
+	assert.NotContains(t, configure.After, "my-secret")
+
+	denied := events[3]
+	assert.Empty(t, denied.Actor)
+	assert.Equal(t, codes.PermissionDenied.String(), denied.Status)
+	assert.Empty(t, denied.Before)
+	assert.Empty(t, denied.Changes)
+
+	remove := events[4]
+	assert.Equal(t, codes.OK.String(), remove.Status)
+	assert.Contains(t, remove.Before, `"name":"renamed service"`)
+	assert.Empty(t, remove.After)
+	assert.Contains(t, remove.Changes, &configuration.AuditChange{Path: "name", Before: `"renamed service"`})
+}
+
+func TestServer_ListAuditEvents(t *testing.T) {
+	var (
+		now       = time.Now()
+		serviceID = "00000000-0000-0000-0000-000000000001"
+		events    = []*configuration.AuditEvent{
+			{Id: "1", Time: timestamppb.New(now.Add(-2 * time.Hour)), Actor: "alice", Action: "StartMonitoring",
+				ServiceId: serviceID, TenantId: "alice"},
+			{Id: "2", Time: timestamppb.New(now.Add(-time.Hour)), Actor: "alice", Action: "StopMonitoring",
+				ServiceId: serviceID, TenantId: "alice"},
+			{Id: "3", Time: timestamppb.New(now), Actor: "bob", Action: "StartMonitoring", TenantId: "bob"},
+		}
+	)
+
+	srv := &Server{storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+		for _, e := range events {
+			assert.NoError(t, s.Create(e))
+		}
+	})}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		req     *configuration.ListAuditEventsRequest
+		wantIDs []string
+		wantErr codes.Code
+	}{
+		{
+			name:    "all, most recent first",
+			ctx:     context.Background(),
+			req:     &configuration.ListAuditEventsRequest{},
+			wantIDs: []string{"3", "2", "1"},
+		},
+		{
+			name:    "by service",
+			ctx:     context.Background(),
+			req:     &configuration.ListAuditEventsRequest{ServiceId: serviceID, OrderBy: "time", Asc: true},
+			wantIDs: []string{"1", "2"},
+		},
+		{
+			name:    "by actor and action",
+			ctx:     context.Background(),
+			req:     &configuration.ListAuditEventsRequest{Actor: "alice", Action: "StartMonitoring"},
+			wantIDs: []string{"1"},
+		},
+		{
+			name: "by time",
+			ctx:  context.Background(),
+			req: &configuration.ListAuditEventsRequest{
+				From: timestamppb.New(now.Add(-90 * time.Minute)),
+				To:   timestamppb.New(now.Add(-time.Minute)),
+			},
+			wantIDs: []string{"2"},
+		},
+		{
+			name:    "by tenant of caller",
+			ctx:     ownerOf("bob"),
+			req:     &configuration.ListAuditEventsRequest{},
+			wantIDs: []string{"3"},
+		},
+		{
+			name:    "invalid order",
+			ctx:     context.Background(),
+			req:     &configuration.ListAuditEventsRequest{OrderBy: "before; DROP TABLE audit_events"},
+			wantErr: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := srv.ListAuditEvents(tt.ctx, tt.req)
+			assert.Equal(t, tt.wantErr, status.Code(err))
+			if err != nil {
+				return
+			}
+
+			var ids []string
+			for _, e := range res.Events {
+				ids = append(ids, e.Id)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+		})
+	}
+}
+
+func TestAuditEvent_AppendOnly(t *testing.T) {
+	s := testutil.NewInMemoryStorage(t)
+	event := &configuration.AuditEvent{Id: "1", Time: timestamppb.Now(), Action: "StartMonitoring"}
+
+	assert.NoError(t, s.Create(event))
+
+	event.Action = "StopMonitoring"
+	assert.ErrorIs(t, s.Save(event, "id = ?", event.Id), configuration.ErrAuditEventImmutable)
+	assert.ErrorIs(t, s.Update(event, "id = ?", event.Id), configuration.ErrAuditEventImmutable)
+	assert.ErrorIs(t, s.Delete(&configuration.AuditEvent{}, "id = ?", event.Id),
+		configuration.ErrAuditEventImmutable)
+
+	var stored configuration.AuditEvent
+	assert.NoError(t, s.Get(&stored, "id = ?", event.Id))
+	assert.Equal(t, "StartMonitoring", stored.Action)
+}
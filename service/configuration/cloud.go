@@ -17,59 +17,150 @@ package configuration
 
 import (
 	"context"
+	"errors"
 
 	"clouditor.io/clouditor/api/orchestrator"
+	"clouditor.io/clouditor/persistence"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/eclipse-xfsc/cam/api"
 	"github.com/eclipse-xfsc/cam/api/configuration"
+	"github.com/eclipse-xfsc/cam/internal/authz"
 	"github.com/eclipse-xfsc/cam/internal/storage"
 )
 
-// RegisterCloudService is a wrapper around Clouditor orchestrator
-func (s *Server) RegisterCloudService(ctx context.Context, req *orchestrator.RegisterCloudServiceRequest) (*orchestrator.CloudService, error) {
-	return s.OrchestratorServer.RegisterCloudService(ctx, req)
+// RegisterCloudService is a wrapper around Clouditor orchestrator. The service is assigned to the tenant of the caller.
+func (s *Server) RegisterCloudService(ctx context.Context, req *orchestrator.RegisterCloudServiceRequest) (res *orchestrator.CloudService, err error) {
+	res, err = s.OrchestratorServer.RegisterCloudService(ctx, req)
+	if err != nil {
+		return
+	}
+
+	if err = s.assignTenant(ctx, res.Id); err != nil {
+		err = status.Errorf(codes.Internal, "%s: could not assign tenant: %v", api.DatabaseErrorMsg, err)
+		return nil, err
+	}
+
+	return
 }
 
 // UpdateCloudService is a wrapper around Clouditor orchestrator
 func (s *Server) UpdateCloudService(ctx context.Context, req *orchestrator.UpdateCloudServiceRequest) (*orchestrator.CloudService, error) {
+	if err := s.verifyTenant(ctx, req.ServiceId); err != nil {
+		return nil, err
+	}
+
 	return s.OrchestratorServer.UpdateCloudService(ctx, req)
 }
 
 // GetCloudService is a wrapper around Clouditor orchestrator
 func (s *Server) GetCloudService(ctx context.Context, req *orchestrator.GetCloudServiceRequest) (*orchestrator.CloudService, error) {
+	if err := s.verifyTenant(ctx, req.ServiceId); err != nil {
+		return nil, err
+	}
+
 	return s.OrchestratorServer.GetCloudService(ctx, req)
 }
 
-// ListCloudServices is a wrapper around Clouditor orchestrator
-func (s *Server) ListCloudServices(ctx context.Context, req *orchestrator.ListCloudServicesRequest) (*orchestrator.ListCloudServicesResponse, error) {
-	return s.OrchestratorServer.ListCloudServices(ctx, req)
+// ListCloudServices is a wrapper around Clouditor orchestrator. It only returns the services of the tenant of the
+// caller, to which the roles of the caller apply.
+func (s *Server) ListCloudServices(ctx context.Context, req *orchestrator.ListCloudServicesRequest) (res *orchestrator.ListCloudServicesResponse, err error) {
+	var (
+		tenants  []*configuration.CloudServiceTenant
+		tenantOf = make(map[string]string)
+	)
+
+	res, err = s.OrchestratorServer.ListCloudServices(ctx, req)
+	if err != nil {
+		return
+	}
+
+	if _, restricted := authz.TenantFromContext(ctx); restricted {
+		err = s.storage.List(&tenants, "", true, 0, -1)
+		if err != nil {
+			err = status.Errorf(codes.Internal, "%s: %v", api.DatabaseErrorMsg, err)
+			return nil, err
+		}
+
+		for _, t := range tenants {
+			tenantOf[t.ServiceId] = t.TenantId
+		}
+	}
+
+	var services []*orchestrator.CloudService
+	for _, cs := range res.Services {
+		if authz.TenantAllowed(ctx, tenantOf[cs.Id]) && authz.ServiceAllowed(ctx, cs.Id) {
+			services = append(services, cs)
+		}
+	}
+	res.Services = services
+
+	return
 }
 
 // RemoveCloudService is a wrapper around Clouditor orchestrator
-func (s *Server) RemoveCloudService(ctx context.Context, req *orchestrator.RemoveCloudServiceRequest) (*emptypb.Empty, error) {
-	return s.OrchestratorServer.RemoveCloudService(ctx, req)
+func (s *Server) RemoveCloudService(ctx context.Context, req *orchestrator.RemoveCloudServiceRequest) (res *emptypb.Empty, err error) {
+	if err = s.verifyTenant(ctx, req.ServiceId); err != nil {
+		return
+	}
+
+	res, err = s.OrchestratorServer.RemoveCloudService(ctx, req)
+	if err != nil {
+		return
+	}
+
+	err = s.storage.Delete(&configuration.CloudServiceTenant{}, "service_id = ?", req.ServiceId)
+	if err != nil && !errors.Is(err, persistence.ErrRecordNotFound) {
+		log.Errorf("Could not remove tenant of service %s: %v", req.ServiceId, err)
+	}
+
+	return res, nil
 }
 
 // ConfigureCloudService configures the cloud service with the corresponding service configuration
-func (s *Server) ConfigureCloudService(_ context.Context, req *configuration.ConfigureCloudServiceRequest) (res *configuration.ConfigureCloudServiceResponse, err error) {
+func (s *Server) ConfigureCloudService(ctx context.Context, req *configuration.ConfigureCloudServiceRequest) (res *configuration.ConfigureCloudServiceResponse, err error) {
 	res = new(configuration.ConfigureCloudServiceResponse)
-	// TODO(lebogg): Outsource to Validate fct and extend it
-	if req.ServiceId == "" {
+
+	// Keep the message, which clients already rely on, rather than the one of the request validation
+	if req.GetServiceId() == "" {
 		err = status.Error(codes.InvalidArgument, api.ServiceIDIsMissingErrMsg)
 		return
 	}
 
+	if err = s.verifyTenant(ctx, req.GetServiceId()); err != nil {
+		return
+	}
+
+	// Redacted secrets, e.g., of a configuration retrieved by ListCloudServiceConfigurations, keep their stored value
+	for _, c := range req.GetConfigurations().GetConfigurations() {
+		c.ServiceId = req.GetServiceId()
+		if err = s.restoreSecrets(ctx, c); err != nil {
+			err = status.Error(codes.InvalidArgument, err.Error())
+			return
+		}
+	}
+
+	if err = req.Validate(); err != nil {
+		err = status.Error(codes.InvalidArgument, err.Error())
+		return
+	}
+
 	// Verify that service exists already
 	err = storage.VerifyExistence(s.storage, "Cloud Service", &orchestrator.CloudService{}, "id", req.ServiceId)
 	if err != nil {
 		return
 	}
 
+	tenant, err := s.tenantOf(req.ServiceId)
+	if err != nil {
+		err = status.Errorf(codes.Internal, "%s: %v", api.DatabaseErrorMsg, err)
+		return
+	}
+
 	// Store the cloud service configuration
-	for _, c := range req.Configurations.Configurations {
+	for _, c := range req.GetConfigurations().GetConfigurations() {
 		if c.RawConfiguration == nil {
 			continue
 		}
@@ -79,6 +170,14 @@ func (s *Server) ConfigureCloudService(_ context.Context, req *configuration.Con
 		// Set service ID and type module name, both act as a composite primary key
 		c.ServiceId = req.ServiceId
 		c.TypeUrl = c.RawConfiguration.TypeUrl
+		c.TenantId = tenant
+
+		// Secrets are only stored encrypted
+		if _, err = s.encryptSecrets(ctx, c); err != nil {
+			err = status.Errorf(codes.Internal, "could not encrypt secrets of configuration for CM '%s': %v",
+				typeURL, err)
+			return
+		}
 
 		err = s.storage.Save(c, "service_id = ?", req.ServiceId)
 		if err != nil {
@@ -89,10 +188,15 @@ func (s *Server) ConfigureCloudService(_ context.Context, req *configuration.Con
 	return
 }
 
-// ListCloudServiceConfigurations list all configurations for a service
-func (srv *Server) ListCloudServiceConfigurations(_ context.Context, req *configuration.ListCloudServiceConfigurationsRequest) (
+// ListCloudServiceConfigurations list all configurations for a service. Their secrets are redacted, unless the caller
+// has the SecretsScope.
+func (srv *Server) ListCloudServiceConfigurations(ctx context.Context, req *configuration.ListCloudServiceConfigurationsRequest) (
 	res *configuration.ListCloudServiceConfigurationsResponse, err error) {
 	res = new(configuration.ListCloudServiceConfigurationsResponse)
+	if err = srv.verifyTenant(ctx, req.ServiceId); err != nil {
+		return
+	}
+
 	// Verify that the Cloud Service exists
 	err = storage.VerifyExistence(srv.storage, "Cloud Service", &orchestrator.CloudService{}, "id", req.ServiceId)
 	if err != nil {
@@ -107,5 +211,13 @@ func (srv *Server) ListCloudServiceConfigurations(_ context.Context, req *config
 		return
 	}
 
+	for _, c := range res.Configurations {
+		if err = srv.presentSecrets(ctx, c); err != nil {
+			log.Errorf("Could not prepare secrets of configuration %s of service %s: %v", c.TypeUrl, c.ServiceId, err)
+			err = status.Errorf(codes.Internal, "could not prepare secrets of configuration %s", c.TypeUrl)
+			return
+		}
+	}
+
 	return
 }
@@ -25,14 +25,18 @@ import (
 	"clouditor.io/clouditor/persistence"
 	orchestrator2 "clouditor.io/clouditor/service/orchestrator"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
 	"gorm.io/gorm"
 
 	"github.com/eclipse-xfsc/cam/api"
 	"github.com/eclipse-xfsc/cam/api/collection"
 	"github.com/eclipse-xfsc/cam/api/configuration"
+	"github.com/eclipse-xfsc/cam/internal/authz"
 	"github.com/eclipse-xfsc/cam/internal/testutil"
 	"github.com/eclipse-xfsc/cam/internal/testutil/testproto"
+	"github.com/eclipse-xfsc/cam/service"
 )
 
 func Test_server_ListCloudServiceConfigurations(t *testing.T) {
@@ -202,7 +206,7 @@ func TestServer_ConfigureCloudService(t *testing.T) {
 								ServiceId: orchestrator2.DefaultTargetCloudServiceId,
 								RawConfiguration: testproto.NewAny(t,
 									&collection.AuthenticationSecurityConfig{
-										Issuer: "someURL",
+										Issuer: "https://issuer.example.com",
 									}),
 							},
 							{
@@ -233,7 +237,7 @@ func TestServer_ConfigureCloudService(t *testing.T) {
 				authConfig := new(collection.AuthenticationSecurityConfig)
 				assert.Nil(testingT, config.RawConfiguration.UnmarshalTo(authConfig))
 
-				return assert.Equal(testingT, "someURL", authConfig.Issuer)
+				return assert.Equal(testingT, "https://issuer.example.com", authConfig.Issuer)
 			},
 			wantErr: assert.NoError,
 		},
@@ -247,6 +251,40 @@ func TestServer_ConfigureCloudService(t *testing.T) {
 				return assert.ErrorContains(t, err, api.ServiceIDIsMissingErrMsg)
 			},
 		},
+		{
+			name: "Invalid configuration",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(&orchestrator.CloudService{Id: orchestrator2.DefaultTargetCloudServiceId}))
+				}),
+			},
+			args: args{
+				req: &configuration.ConfigureCloudServiceRequest{
+					ServiceId: orchestrator2.DefaultTargetCloudServiceId,
+					Configurations: &configuration.Configurations{
+						Configurations: []*collection.ServiceConfiguration{
+							{
+								RawConfiguration: testproto.NewAny(t,
+									&collection.CommunicationSecurityConfig{Endpoint: "host:443"}),
+							},
+							{
+								RawConfiguration: testproto.NewAny(t,
+									&collection.AuthenticationSecurityConfig{Issuer: "someURL"}),
+							},
+						}}}},
+			wantRes: &configuration.ConfigureCloudServiceResponse{},
+			wantStorage: func(t assert.TestingT, i interface{}, _ ...interface{}) bool {
+				var configs []*collection.ServiceConfiguration
+
+				// No configuration is stored, if one of them is invalid
+				assert.NoError(t, i.(persistence.Storage).List(&configs, "", true, 0, -1))
+				return assert.Empty(t, configs)
+			},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, collection.ErrInvalidURL.Error())
+			},
+		},
 		{
 			name: "Service does not exist yet (Verification)",
 			fields: fields{
@@ -275,7 +313,7 @@ func TestServer_ConfigureCloudService(t *testing.T) {
 								ServiceId: orchestrator2.DefaultTargetCloudServiceId,
 								TypeUrl:   "no.such.type",
 								RawConfiguration: testproto.NewAny(t,
-									&collection.AuthenticationSecurityConfig{Issuer: "someURL"}),
+									&collection.AuthenticationSecurityConfig{Issuer: "https://issuer.example.com"}),
 							},
 						}}}},
 			wantRes:     &configuration.ConfigureCloudServiceResponse{},
@@ -299,3 +337,84 @@ func TestServer_ConfigureCloudService(t *testing.T) {
 		})
 	}
 }
+
+func TestServer_ListCloudServices(t *testing.T) {
+	const otherServiceID = "11111111-1111-1111-1111-111111111111"
+
+	s := testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+		assert.NoError(t, s.Create(&orchestrator.CloudService{Id: orchestrator2.DefaultTargetCloudServiceId}))
+		assert.NoError(t, s.Create(&orchestrator.CloudService{Id: otherServiceID}))
+		assert.NoError(t, s.Create(&configuration.CloudServiceTenant{ServiceId: otherServiceID, TenantId: "tenant"}))
+	})
+	srv := &Server{storage: s, OrchestratorServer: orchestrator2.NewService(orchestrator2.WithStorage(s))}
+
+	tests := []struct {
+		name    string
+		claims  *service.Claims
+		wantIDs []string
+	}{
+		{
+			name:    "Unsecured API",
+			wantIDs: []string{orchestrator2.DefaultTargetCloudServiceId, otherServiceID},
+		},
+		{
+			name:    "Admin without tenant",
+			claims:  &service.Claims{Roles: []string{string(authz.RoleAdmin)}},
+			wantIDs: []string{orchestrator2.DefaultTargetCloudServiceId, otherServiceID},
+		},
+		{
+			name:    "Admin of tenant",
+			claims:  &service.Claims{Roles: []string{string(authz.RoleAdmin)}, Tenant: "tenant"},
+			wantIDs: []string{otherServiceID},
+		},
+		{
+			name: "Auditor of one service",
+			claims: &service.Claims{
+				Roles:    []string{string(authz.RoleAuditor)},
+				Services: []string{otherServiceID},
+				Tenant:   "tenant",
+			},
+			wantIDs: []string{otherServiceID},
+		},
+		{
+			name: "Auditor of other tenant",
+			claims: &service.Claims{
+				Roles:    []string{string(authz.RoleAuditor)},
+				Services: []string{authz.AllServices},
+				Tenant:   "other",
+			},
+		},
+		{
+			name: "Service owner without tenant",
+			claims: &service.Claims{
+				Roles:    []string{string(authz.RoleServiceOwner)},
+				Services: []string{authz.AllServices},
+			},
+			wantIDs: []string{orchestrator2.DefaultTargetCloudServiceId},
+		},
+		{
+			name: "Service owner without services",
+			claims: &service.Claims{
+				Roles:  []string{string(authz.RoleServiceOwner)},
+				Tenant: "tenant",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.claims != nil {
+				ctx = service.ContextWithClaims(ctx, tt.claims)
+			}
+
+			res, err := srv.ListCloudServices(ctx, &orchestrator.ListCloudServicesRequest{})
+			assert.NoError(t, err)
+
+			var ids []string
+			for _, cs := range res.Services {
+				ids = append(ids, cs.Id)
+			}
+			assert.ElementsMatch(t, tt.wantIDs, ids)
+		})
+	}
+}
@@ -18,31 +18,51 @@ package configuration
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
-	"clouditor.io/clouditor/api"
+	"clouditor.io/clouditor/api/orchestrator"
 	"clouditor.io/clouditor/persistence"
 
-	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/eclipse-xfsc/cam/api/collection"
 	"github.com/eclipse-xfsc/cam/api/configuration"
+	"github.com/eclipse-xfsc/cam/internal/storage"
 )
 
-// AddCollectionModule adds a collection module to the server
-func (srv *Server) AddCollectionModule(_ context.Context, req *configuration.AddCollectionModuleRequest) (
+// DefaultTestCollectionTimeout is the time a collection module has to test a service configuration
+const DefaultTestCollectionTimeout = 30 * time.Second
+
+// AddCollectionModule adds a collection module to the server. The capabilities of the collection module are checked
+// first: If it is reachable, but does not support the configuration type or metrics of the request, it is rejected.
+// An unreachable collection module is added nevertheless, since it might just not have been started yet.
+func (srv *Server) AddCollectionModule(ctx context.Context, req *configuration.AddCollectionModuleRequest) (
 	res *collection.CollectionModule, err error) {
+	// Validate request
+	if err = req.Validate(); err != nil {
+		err = status.Error(codes.InvalidArgument, err.Error())
+		return
+	}
+
+	h := srv.checkCollectionModule(ctx, req.Module)
+	if h.incompatible {
+		err = status.Errorf(codes.InvalidArgument, "collection module does not match registration: %s", h.message)
+		return
+	}
 
-	// Just save it, I don't care
 	err = srv.storage.Create(req.Module)
 	if err != nil {
 		err = status.Errorf(codes.Internal, "DB error: %v", err)
 		return
 	}
 
+	srv.setHealth(req.Module, h)
+
 	res = req.Module
+	srv.applyHealth(res)
 
 	return
 }
@@ -54,13 +74,25 @@ func (srv *Server) ListCollectionModules(_ context.Context, _ *configuration.Lis
 	err = srv.storage.List(&res.Modules, "", true, 0, -1)
 	if err != nil {
 		err = status.Errorf(codes.Internal, "DB error: %v", err)
+		return
+	}
+
+	// Add the status of the last health check
+	for _, cm := range res.Modules {
+		srv.applyHealth(cm)
 	}
+
 	return
 }
 
 // RemoveCollectionModule removes the collection module with the ID specified in the request
 func (srv *Server) RemoveCollectionModule(_ context.Context, req *configuration.RemoveCollectionModuleRequest) (
 	res *emptypb.Empty, err error) {
+	var cm collection.CollectionModule
+
+	// Retrieve the addresses of the collection module to close its connections later on
+	_ = srv.storage.Get(&cm, "id = ?", req.ModuleId)
+
 	err = srv.storage.Delete(&collection.CollectionModule{}, "id = ?", req.ModuleId)
 	// Catch error when no CM is found
 	if errors.Is(err, persistence.ErrRecordNotFound) {
@@ -72,47 +104,202 @@ func (srv *Server) RemoveCollectionModule(_ context.Context, req *configuration.
 		err = status.Errorf(codes.Internal, "DB error: %v", err)
 		return
 	}
+
+	srv.removeHealth(req.ModuleId)
+	srv.pool().remove(cm.Endpoints()...)
+
 	return
 }
 
 // GetServiceConfiguration return the service configuration for the corresponding serviceID. It does so by comparing the
 // field ConfigMessageTypeUrl against the type_url field of a service configuration in the database. If there is no
-// config, return empty config (no error)
+// config, return empty config (no error). The secrets of the config are decrypted, since the collection module needs
+// them to connect to the cloud service. An error is returned, if the config could not be retrieved or decrypted.
 func (srv *Server) GetServiceConfiguration(serviceID string, typeURL string) (
-	config *collection.ServiceConfiguration) {
+	config *collection.ServiceConfiguration, err error) {
 	config = new(collection.ServiceConfiguration)
 
 	// Retrieve matching service configuration for the collection module
-	err := srv.storage.Get(&config, "service_id = ? AND type_url = ?", serviceID, typeURL)
+	err = srv.storage.Get(&config, "service_id = ? AND type_url = ?", serviceID, typeURL)
 	if errors.Is(err, persistence.ErrRecordNotFound) {
-		return
+		return config, nil
 	} else if err != nil {
 		log.Errorf("database error: %v", err)
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	if err = srv.decryptSecrets(context.Background(), config); err != nil {
+		log.Errorf("Could not decrypt secrets of configuration %s of service %s: %v", typeURL, serviceID, err)
+		return nil, fmt.Errorf("could not decrypt secrets of the service configuration: %w", err)
 	}
 
 	return
 }
 
-// startCollectionModule triggers the collection of the given collection module
-func (srv *Server) startCollectionModule(cm *collection.CollectionModule, serviceID string) {
-	log.Infof("Triggering collection of evidences using module `%s`", cm.Name)
+// startCollectionModule triggers the collection of the given collection module. The collection module is retrieved
+// again, since a self-registered collection module might have changed its addresses in the meantime. Unavailable
+// collection modules are skipped. If the collection module has several instances, the cloud service is assigned to
+// one of them using consistent hashing. If this instance is unreachable, the next one is used. An error is returned, if
+// the collection could not be started. The run ID is passed on to the collection module, which sets it in the evidences
+// of the run.
+func (srv *Server) startCollectionModule(ctx context.Context, cm *collection.CollectionModule, serviceID string,
+	runID string) (err error) {
+	var current = new(collection.CollectionModule)
 
-	// Create connection to the collection module. This will make use of our authorizer if it is configured in
-	// api.DefaultGrpcDialOptions, because Server is implementing the UsesAuthorizer interface
-	conn, err := grpc.Dial(cm.Address, api.DefaultGrpcDialOptions(cm.Address, srv)...)
+	err = srv.storage.Get(current, "id = ?", cm.Id)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		log.Warnf("Skipping collection of evidences using module `%s`, since it has been removed", cm.Name)
+		return fmt.Errorf("collection module has been removed")
+	} else if err != nil {
+		log.Errorf("database error: %v", err)
+		return fmt.Errorf("database error: %w", err)
+	}
+	cm = current
+
+	if !srv.available(cm) {
+		log.Warnf("Skipping collection of evidences using module `%s`, since it is %s: %s", cm.Name,
+			statusName(cm.Status), cm.StatusMessage)
+		return fmt.Errorf("collection module is %s: %s", statusName(cm.Status), cm.StatusMessage)
+	}
+
+	tenant, err := srv.tenantOf(serviceID)
 	if err != nil {
-		log.Errorf("Could not dial to `%s`: %v", cm.Name, err)
-		return
+		log.Errorf("database error: %v", err)
+		return fmt.Errorf("database error: %w", err)
+	}
+
+	config, err := srv.GetServiceConfiguration(serviceID, cm.ConfigMessageTypeUrl)
+	if err != nil {
+		return err
 	}
 
-	// TODO(oxisto): Re-use collection client or use streaming, instead of creating a new client for each request
-	collectionClient := collection.NewCollectionClient(conn)
-	_, err = collectionClient.StartCollecting(context.TODO(), &collection.StartCollectingRequest{
+	req := &collection.StartCollectingRequest{
 		ServiceId:     serviceID,
 		EvalManager:   srv.evalManagerAddress,
-		Configuration: srv.GetServiceConfiguration(serviceID, cm.ConfigMessageTypeUrl),
-	})
+		Configuration: config,
+		TenantId:      tenant,
+		RunId:         runID,
+	}
+
+	for _, address := range srv.endpointsFor(cm, serviceID) {
+		log.Infof("Triggering collection of evidences using module `%s` (address: %s)", cm.Name, address)
+
+		err = srv.startCollecting(ctx, address, req)
+		if status.Code(err) == codes.Unavailable {
+			log.Warnf("Instance %s of collection module `%s` is unavailable, trying next instance: %v", address,
+				cm.Name, err)
+			continue
+		} else if err != nil {
+			log.Errorf("Could not start collection module `%s`: %v", cm.Name, err)
+			return fmt.Errorf("could not start collection: %v", status.Convert(err).Message())
+		}
+
+		return nil
+	}
+
+	log.Errorf("Could not start collection module `%s`: no instance is available", cm.Name)
+	return fmt.Errorf("no instance of the collection module is available")
+}
+
+// startCollecting calls StartCollecting of the collection module instance with the given address. The connection to
+// the instance is re-used for all monitored services.
+func (srv *Server) startCollecting(ctx context.Context, address string, req *collection.StartCollectingRequest) (
+	err error) {
+	conn, err := srv.pool().get(address, req.ServiceId)
 	if err != nil {
-		log.Errorf("Could not start collection module `%s`: %v", cm.Name, err)
+		return status.Errorf(codes.Unavailable, "could not dial: %v", err)
+	}
+
+	_, err = collection.NewCollectionClient(conn).StartCollecting(ctx, req)
+
+	return
+}
+
+// TestCollection tests a service configuration without storing it. The configuration is validated first and then sent
+// to a collection module, whose configuration type matches the configuration, to check that it can connect to the cloud
+// service. If the collection module has several instances, the cloud service is assigned to one of them in the same way
+// as for collecting evidences.
+func (srv *Server) TestCollection(ctx context.Context, req *collection.TestCollectionRequest) (
+	res *collection.TestCollectionResponse, err error) {
+	var modules []*collection.CollectionModule
+
+	if err = srv.verifyTenant(ctx, req.ServiceId); err != nil {
+		return
 	}
+
+	// Redacted secrets are tested with their stored value
+	if req.GetConfiguration() != nil {
+		req.Configuration.ServiceId = req.ServiceId
+		if err = srv.restoreSecrets(ctx, req.Configuration); err != nil {
+			err = status.Error(codes.InvalidArgument, err.Error())
+			return
+		}
+	}
+
+	// Validate request including the service configuration
+	if err = req.Validate(); err != nil {
+		err = status.Error(codes.InvalidArgument, err.Error())
+		return
+	}
+
+	// Verify that the service exists
+	err = storage.VerifyExistence(srv.storage, "Cloud Service", &orchestrator.CloudService{}, "id", req.ServiceId)
+	if err != nil {
+		return
+	}
+
+	typeURL := req.Configuration.RawConfiguration.TypeUrl
+	req.Configuration.ServiceId = req.ServiceId
+	req.Configuration.TypeUrl = typeURL
+
+	err = srv.storage.List(&modules, "", true, 0, -1, "config_message_type_url = ?", typeURL)
+	if err != nil {
+		err = status.Errorf(codes.Internal, "DB error: %v", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, DefaultTestCollectionTimeout)
+	defer cancel()
+
+	for _, cm := range modules {
+		if !srv.available(cm) {
+			continue
+		}
+
+		for _, address := range srv.endpointsFor(cm, req.ServiceId) {
+			log.Infof("Testing configuration of service %s using module `%s` (address: %s)", req.ServiceId, cm.Name,
+				address)
+
+			res, err = srv.testCollection(ctx, address, req)
+			if status.Code(err) == codes.Unavailable {
+				log.Warnf("Instance %s of collection module `%s` is unavailable, trying next instance: %v", address,
+					cm.Name, err)
+				continue
+			} else if err != nil {
+				err = status.Errorf(status.Code(err), "collection module `%s`: %s", cm.Name,
+					status.Convert(err).Message())
+				return nil, err
+			}
+
+			return res, nil
+		}
+	}
+
+	return nil, status.Errorf(codes.FailedPrecondition, "no collection module for configuration type %s is available",
+		typeURL)
+}
+
+// testCollection calls TestCollection of the collection module instance with the given address. The connection is only
+// kept, if it is used for monitoring as well.
+func (srv *Server) testCollection(ctx context.Context, address string, req *collection.TestCollectionRequest) (
+	res *collection.TestCollectionResponse, err error) {
+	owner := "test/" + req.ServiceId
+	defer srv.pool().release(owner)
+
+	conn, err := srv.pool().get(address, owner)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "could not dial: %v", err)
+	}
+
+	return collection.NewCollectionClient(conn).TestCollection(ctx, req)
 }
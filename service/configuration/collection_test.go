@@ -26,6 +26,9 @@ import (
 	"clouditor.io/clouditor/persistence"
 	service_orchestrator "clouditor.io/clouditor/service/orchestrator"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
 	"github.com/eclipse-xfsc/cam/api/collection"
 	"github.com/eclipse-xfsc/cam/api/configuration"
 	"github.com/eclipse-xfsc/cam/internal/protobuf"
@@ -250,7 +253,9 @@ func TestServer_GetServiceConfiguration(t *testing.T) {
 				authorizer:         tt.fields.authorizer,
 				monitoring:         tt.fields.monitoring,
 			}
-			if gotConfig := srv.GetServiceConfiguration(tt.args.serviceID, tt.args.typeURL); !proto.Equal(gotConfig, tt.wantConfig) {
+			gotConfig, err := srv.GetServiceConfiguration(tt.args.serviceID, tt.args.typeURL)
+			assert.NoError(t, err)
+			if !proto.Equal(gotConfig, tt.wantConfig) {
 				t.Errorf("Server.GetServiceConfiguration() = %v, want %v", gotConfig, tt.wantConfig)
 			}
 		})
@@ -302,3 +307,118 @@ func populateStorage(t *testing.T, storage persistence.Storage) (err error) {
 
 	return
 }
+
+// mockTestCollectionServer is a collection module, which only implements TestCollection. It reports the endpoint of
+// the communication security configuration as reached or returns err.
+type mockTestCollectionServer struct {
+	collection.UnimplementedCollectionServer
+	err error
+}
+
+func (m *mockTestCollectionServer) TestCollection(_ context.Context, req *collection.TestCollectionRequest) (
+	*collection.TestCollectionResponse, error) {
+	var config collection.CommunicationSecurityConfig
+
+	if m.err != nil {
+		return nil, m.err
+	}
+	if err := req.Configuration.RawConfiguration.UnmarshalTo(&config); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &collection.TestCollectionResponse{Checks: []string{"reached " + config.Endpoint}}, nil
+}
+
+func TestServer_TestCollection(t *testing.T) {
+	var (
+		serviceID = service_orchestrator.DefaultTargetCloudServiceId
+		config    = testproto.NewAny(t, &collection.CommunicationSecurityConfig{Endpoint: "host:443"})
+	)
+
+	tests := []struct {
+		name      string
+		moduleErr error
+		noModule  bool
+		req       *collection.TestCollectionRequest
+		wantRes   *collection.TestCollectionResponse
+		wantCode  codes.Code
+		wantMsg   string
+	}{
+		{
+			name: "Configuration is tested by the collection module",
+			req: &collection.TestCollectionRequest{
+				ServiceId:     serviceID,
+				Configuration: &collection.ServiceConfiguration{RawConfiguration: config},
+			},
+			wantRes:  &collection.TestCollectionResponse{Checks: []string{"reached host:443"}},
+			wantCode: codes.OK,
+		},
+		{
+			name: "Invalid configuration",
+			req: &collection.TestCollectionRequest{
+				ServiceId: serviceID,
+				Configuration: &collection.ServiceConfiguration{RawConfiguration: testproto.NewAny(t,
+					&collection.CommunicationSecurityConfig{Endpoint: "host"})},
+			},
+			wantCode: codes.InvalidArgument,
+			wantMsg:  collection.ErrInvalidAddress.Error(),
+		},
+		{
+			name: "Unknown service",
+			req: &collection.TestCollectionRequest{
+				ServiceId:     "00000000-0000-0000-0000-000000000001",
+				Configuration: &collection.ServiceConfiguration{RawConfiguration: config},
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "No collection module for the configuration type",
+			noModule: true,
+			req: &collection.TestCollectionRequest{
+				ServiceId:     serviceID,
+				Configuration: &collection.ServiceConfiguration{RawConfiguration: config},
+			},
+			wantCode: codes.FailedPrecondition,
+			wantMsg:  "no collection module for configuration type",
+		},
+		{
+			name:      "Collection module cannot reach the service",
+			moduleErr: status.Error(codes.FailedPrecondition, "could not reach endpoint"),
+			req: &collection.TestCollectionRequest{
+				ServiceId:     serviceID,
+				Configuration: &collection.ServiceConfiguration{RawConfiguration: config},
+			},
+			wantCode: codes.FailedPrecondition,
+			wantMsg:  "collection module `Mock`: could not reach endpoint",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			address := startTCPServer(t, func(s *grpc.Server) {
+				collection.RegisterCollectionServer(s, &mockTestCollectionServer{err: tt.moduleErr})
+			})
+
+			srv := &Server{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(&orchestrator.CloudService{Id: serviceID}))
+					if !tt.noModule {
+						cm := newMockModule()
+						cm.Address = address
+						assert.NoError(t, s.Create(cm))
+					}
+				}),
+				grpcOpts: []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())},
+			}
+
+			res, err := srv.TestCollection(context.Background(), tt.req)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Contains(t, status.Convert(err).Message(), tt.wantMsg)
+			assert.True(t, proto.Equal(tt.wantRes, res))
+
+			// The configuration is not stored
+			var configs []*collection.ServiceConfiguration
+			assert.NoError(t, srv.storage.List(&configs, "", true, 0, -1))
+			assert.Empty(t, configs)
+		})
+	}
+}
@@ -0,0 +1,194 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package configuration
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/eclipse-xfsc/cam/api/configuration"
+)
+
+const (
+	// DefaultDebounceWindow is the default time, for which assessment results of a metric are coalesced
+	DefaultDebounceWindow = 2 * time.Second
+	// DefaultDebounceThreshold is the default number of assessment results of a metric, which trigger the compliance
+	// calculation before the window has passed
+	DefaultDebounceThreshold = 100
+)
+
+// Debouncer decides when the compliance calculation is triggered for incoming assessment results. Since results
+// usually arrive in batches, a debouncer coalesces the results of a metric instead of triggering a calculation for
+// every single result.
+type Debouncer interface {
+	// Add adds an incoming assessment result of the metric for the service
+	Add(serviceID string, metricID string)
+
+	// Configure sets the configuration for the service. A nil configuration or fields, which are not set, fall back
+	// to the defaults of the debouncer.
+	Configure(serviceID string, config *configuration.DebounceConfig)
+
+	// Config returns the effective configuration for the service
+	Config(serviceID string) *configuration.DebounceConfig
+}
+
+// DebounceFunc is called by a Debouncer, once the compliance calculation should be triggered for the metric of the
+// service. results is the number of assessment results, which have been coalesced into this calculation.
+type DebounceFunc func(serviceID string, metricID string, results int64)
+
+// NewDebouncerFunc creates a Debouncer, which calls trigger
+type NewDebouncerFunc func(trigger DebounceFunc) Debouncer
+
+// windowDebouncer accrues the assessment results of a service and metric in a window. The window is closed and the
+// calculation is triggered, once the window contains threshold results or once it has been open for the duration of
+// the window, whichever comes first.
+type windowDebouncer struct {
+	trigger  DebounceFunc
+	defaults *configuration.DebounceConfig
+
+	// mutex protects configs and windows
+	mutex sync.Mutex
+
+	// configs contains the configuration (value) per service (key), if it differs from the defaults
+	configs map[string]*configuration.DebounceConfig
+
+	// windows contains the open window (value) per service and metric (key)
+	windows map[debounceKey]*complianceCalcWindow
+}
+
+// debounceKey identifies the assessment results of a metric for a service
+type debounceKey struct {
+	serviceID string
+	metricID  string
+}
+
+// complianceCalcWindow accrues the assessment results of a service and metric. A window is open as long as it is
+// contained in the windows of the debouncer.
+type complianceCalcWindow struct {
+	start   time.Time
+	counter int64
+
+	// timer closes the window after the window duration
+	timer *time.Timer
+}
+
+// NewWindowDebouncer returns a function, which creates a debouncer with the given default window and threshold
+func NewWindowDebouncer(window time.Duration, threshold int64) NewDebouncerFunc {
+	return func(trigger DebounceFunc) Debouncer {
+		return &windowDebouncer{
+			trigger: trigger,
+			defaults: &configuration.DebounceConfig{
+				Window:    window.Milliseconds(),
+				Threshold: threshold,
+			},
+			configs: make(map[string]*configuration.DebounceConfig),
+			windows: make(map[debounceKey]*complianceCalcWindow),
+		}
+	}
+}
+
+// Add implements Debouncer
+func (d *windowDebouncer) Add(serviceID string, metricID string) {
+	var (
+		key     = debounceKey{serviceID, metricID}
+		results int64
+	)
+
+	d.mutex.Lock()
+	config := d.config(serviceID)
+
+	w, ok := d.windows[key]
+	if !ok {
+		// We are the first to arrive, so we open a new window, which will be closed after the window duration in any
+		// case
+		log.Debugf("Starting new compliance calculation window for service %s and metric %s", serviceID, metricID)
+		w = &complianceCalcWindow{start: time.Now()}
+		w.timer = time.AfterFunc(time.Duration(config.Window)*time.Millisecond, func() {
+			d.mutex.Lock()
+			results := d.close(key, w)
+			d.mutex.Unlock()
+
+			if results > 0 {
+				d.trigger(serviceID, metricID, results)
+			}
+		})
+		d.windows[key] = w
+	}
+
+	w.counter++
+	if w.counter >= config.Threshold {
+		w.timer.Stop()
+		results = d.close(key, w)
+	}
+	d.mutex.Unlock()
+
+	// The calculation is triggered without holding the lock, so that it does not block incoming results. Results
+	// arriving in the meantime are accrued in a new window.
+	if results > 0 {
+		d.trigger(serviceID, metricID, results)
+	}
+}
+
+// close closes the window w of key, so that the next result opens a new window. It returns the number of results in
+// the window or 0, if w has been closed already, e.g., because it reached the threshold before its timer fired. The
+// caller must hold the mutex.
+func (d *windowDebouncer) close(key debounceKey, w *complianceCalcWindow) int64 {
+	if d.windows[key] != w {
+		return 0
+	}
+
+	delete(d.windows, key)
+	return w.counter
+}
+
+// Configure implements Debouncer
+func (d *windowDebouncer) Configure(serviceID string, config *configuration.DebounceConfig) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if config.GetWindow() == 0 && config.GetThreshold() == 0 {
+		delete(d.configs, serviceID)
+		return
+	}
+
+	d.configs[serviceID] = proto.Clone(config).(*configuration.DebounceConfig)
+}
+
+// Config implements Debouncer
+func (d *windowDebouncer) Config(serviceID string) *configuration.DebounceConfig {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	return d.config(serviceID)
+}
+
+// config returns the effective configuration for the service. The caller must hold the mutex.
+func (d *windowDebouncer) config(serviceID string) (config *configuration.DebounceConfig) {
+	config = proto.Clone(d.defaults).(*configuration.DebounceConfig)
+
+	if c, ok := d.configs[serviceID]; ok {
+		if c.Window > 0 {
+			config.Window = c.Window
+		}
+		if c.Threshold > 0 {
+			config.Threshold = c.Threshold
+		}
+	}
+
+	return
+}
@@ -0,0 +1,147 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package configuration
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/eclipse-xfsc/cam/api/configuration"
+	"github.com/eclipse-xfsc/cam/api/evaluation"
+	"github.com/eclipse-xfsc/cam/internal/testutil"
+)
+
+// triggerRecorder records the triggers of a debouncer
+type triggerRecorder struct {
+	mutex    sync.Mutex
+	triggers []int64
+}
+
+func (r *triggerRecorder) trigger(_ string, _ string, results int64) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.triggers = append(r.triggers, results)
+}
+
+func (r *triggerRecorder) get() []int64 {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return append([]int64{}, r.triggers...)
+}
+
+func Test_windowDebouncer(t *testing.T) {
+	var (
+		r = new(triggerRecorder)
+		d = NewWindowDebouncer(100*time.Millisecond, 5)(r.trigger)
+	)
+
+	// Reaching the threshold triggers right away
+	for i := 0; i < 5; i++ {
+		d.Add("service-1", "metric-1")
+	}
+	assert.Equal(t, []int64{5}, r.get())
+
+	// The remaining results are triggered, once the window has passed
+	d.Add("service-1", "metric-1")
+	d.Add("service-1", "metric-1")
+	assert.Equal(t, []int64{5}, r.get())
+	assert.Eventually(t, func() bool {
+		return len(r.get()) == 2
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []int64{5, 2}, r.get())
+
+	// The configuration of a service overrides the defaults
+	d.Configure("service-2", &configuration.DebounceConfig{Threshold: 2})
+	assert.True(t, proto.Equal(&configuration.DebounceConfig{Window: 100, Threshold: 2}, d.Config("service-2")))
+	assert.True(t, proto.Equal(&configuration.DebounceConfig{Window: 100, Threshold: 5}, d.Config("service-1")))
+	d.Add("service-2", "metric-1")
+	d.Add("service-2", "metric-1")
+	assert.Equal(t, []int64{5, 2, 2}, r.get())
+
+	// An empty configuration resets the service to the defaults
+	d.Configure("service-2", nil)
+	assert.True(t, proto.Equal(&configuration.DebounceConfig{Window: 100, Threshold: 5}, d.Config("service-2")))
+}
+
+func TestServer_recalculateStale(t *testing.T) {
+	var (
+		evalServer = new(mockEvaluationServer)
+		stale      = promtestutil.ToFloat64(calculationsTriggered.WithLabelValues(calculationReasonStale))
+		failed     = promtestutil.ToFloat64(calculationsFailed.WithLabelValues(calculationReasonStale))
+	)
+
+	evalAddress := startTCPServer(t, func(s *grpc.Server) {
+		evaluation.RegisterEvaluationServer(s, evalServer)
+	})
+
+	srv := &Server{
+		storage:               testutil.NewInMemoryStorage(t),
+		evalManagerAddress:    evalAddress,
+		grpcOpts:              []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())},
+		recalculationInterval: time.Hour,
+		monitoring: map[string]*MonitorScheduler{
+			"service-1": {state: monitorRunning, monitoredControls: []string{"C1", "C2"}},
+		},
+		calculations: map[calculationKey]time.Time{
+			{"service-1", "C1"}: time.Now().Add(-time.Minute),
+			{"service-1", "C2"}: time.Now().Add(-2 * time.Hour),
+		},
+	}
+
+	// Only C2 has not been calculated within the interval
+	srv.recalculateStale("service-1")
+	assert.Equal(t, int64(1), evalServer.calculations.Load())
+	assert.Equal(t, stale+1, promtestutil.ToFloat64(calculationsTriggered.WithLabelValues(calculationReasonStale)))
+
+	// Now, C2 is up-to-date as well
+	srv.recalculateStale("service-1")
+	assert.Equal(t, int64(1), evalServer.calculations.Load())
+
+	// Calculations, which cannot be triggered, are counted as failed
+	srv.calculations[calculationKey{"service-1", "C1"}] = time.Time{}
+	srv.evalManagerAddress = "127.0.0.1:1"
+	srv.recalculateStale("service-1")
+	assert.Equal(t, failed+1, promtestutil.ToFloat64(calculationsFailed.WithLabelValues(calculationReasonStale)))
+
+	// Services, which are not monitored, are ignored
+	srv.recalculateStale("service-2")
+}
+
+func TestServer_GetMonitoringStatus_debounce(t *testing.T) {
+	srv := &Server{
+		newDebouncer: NewWindowDebouncer(time.Second, 10),
+		monitoring: map[string]*MonitorScheduler{
+			"service-1": {state: monitorRunning, scheduler: mockStartedScheduler(t)},
+		},
+	}
+	srv.debounce().Configure("service-1", &configuration.DebounceConfig{Window: 500})
+
+	res, err := srv.GetMonitoringStatus(context.Background(),
+		&configuration.GetMonitoringStatusRequest{ServiceId: "service-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(500), res.Debounce.Window)
+	assert.Equal(t, int64(10), res.Debounce.Threshold)
+}
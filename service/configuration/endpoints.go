@@ -0,0 +1,117 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package configuration
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+	"strconv"
+	"time"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/eclipse-xfsc/cam/api/collection"
+)
+
+// virtualNodes is the number of points of each endpoint on the hash ring. More points distribute the cloud services
+// more evenly across the endpoints.
+const virtualNodes = 100
+
+// hashRing distributes keys, i.e., cloud service IDs, across the endpoints of a collection module using consistent
+// hashing. If an endpoint is added or removed, only the keys of this endpoint are moved to other endpoints.
+type hashRing struct {
+	points    []uint32
+	endpoints map[uint32]string
+	size      int
+}
+
+// newHashRing creates a hash ring with the given endpoints
+func newHashRing(endpoints []string) *hashRing {
+	r := &hashRing{endpoints: make(map[uint32]string, len(endpoints)*virtualNodes)}
+
+	for _, e := range endpoints {
+		if r.contains(e) {
+			continue
+		}
+		r.size++
+
+		for i := 0; i < virtualNodes; i++ {
+			p := hash(e + "#" + strconv.Itoa(i))
+			r.points = append(r.points, p)
+			r.endpoints[p] = e
+		}
+	}
+
+	sort.Slice(r.points, func(i, j int) bool { return r.points[i] < r.points[j] })
+
+	return r
+}
+
+// lookup returns all endpoints in the order in which they are responsible for key. The first endpoint is the one the
+// key is assigned to, the following ones are used for failover.
+func (r *hashRing) lookup(key string) (endpoints []string) {
+	if len(r.points) == 0 {
+		return nil
+	}
+
+	h := hash(key)
+	start := sort.Search(len(r.points), func(i int) bool { return r.points[i] >= h })
+
+	for i := 0; i < len(r.points) && len(endpoints) < r.size; i++ {
+		e := r.endpoints[r.points[(start+i)%len(r.points)]]
+		if !slices.Contains(endpoints, e) {
+			endpoints = append(endpoints, e)
+		}
+	}
+
+	return
+}
+
+// contains returns whether the endpoint is already part of the ring
+func (r *hashRing) contains(endpoint string) bool {
+	return r.endpoints[hash(endpoint+"#0")] == endpoint
+}
+
+// hash returns the position of s on the hash ring
+func hash(s string) uint32 {
+	sum := sha256.Sum256([]byte(s))
+	return binary.BigEndian.Uint32(sum[:4])
+}
+
+// endpointsFor returns the endpoints of the collection module that are used to collect evidences for the cloud service
+// in the order in which they should be tried. Endpoints with an expired lease are left out. Endpoints, which were
+// unreachable during the last health check, are only used if all other endpoints fail.
+func (srv *Server) endpointsFor(cm *collection.CollectionModule, serviceID string) (endpoints []string) {
+	var unreachable []string
+
+	srv.healthMutex.RLock()
+	defer srv.healthMutex.RUnlock()
+
+	for _, e := range newHashRing(cm.Endpoints()).lookup(serviceID) {
+		if expiry, ok := srv.leases[cm.Id][e]; ok && time.Now().After(expiry) {
+			continue
+		}
+
+		if h := srv.health[cm.Id]; h != nil && slices.Contains(h.unreachable, e) {
+			unreachable = append(unreachable, e)
+		} else {
+			endpoints = append(endpoints, e)
+		}
+	}
+
+	return append(endpoints, unreachable...)
+}
@@ -0,0 +1,247 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package configuration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/persistence"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/eclipse-xfsc/cam/api/collection"
+	"github.com/eclipse-xfsc/cam/internal/testutil"
+)
+
+// mockInstance is an instance of a collection module, which records the services it collects evidences for
+type mockInstance struct {
+	collection.UnimplementedCollectionServer
+
+	mutex    sync.Mutex
+	services []string
+}
+
+func (m *mockInstance) StartCollecting(_ context.Context, req *collection.StartCollectingRequest) (
+	*collection.StartCollectingResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.services = append(m.services, req.ServiceId)
+
+	return &collection.StartCollectingResponse{}, nil
+}
+
+func (*mockInstance) Describe(_ context.Context, _ *collection.DescribeRequest) (*collection.DescribeResponse,
+	error) {
+	return &collection.DescribeResponse{}, nil
+}
+
+// startMockInstances starts an instance of a collection module on a bufconn listener for each of the running
+// addresses. It returns the instances and the gRPC options to dial them by their address. Dialing any other address
+// fails.
+func startMockInstances(t *testing.T, running ...string) (instances map[string]*mockInstance,
+	opts []grpc.DialOption) {
+	var listeners = make(map[string]*bufconn.Listener)
+
+	instances = make(map[string]*mockInstance)
+	for _, address := range running {
+		lis := bufconn.Listen(1024 * 1024)
+		srv := grpc.NewServer()
+		instances[address] = new(mockInstance)
+		listeners[address] = lis
+		collection.RegisterCollectionServer(srv, instances[address])
+		go func() {
+			_ = srv.Serve(lis)
+		}()
+		t.Cleanup(srv.Stop)
+	}
+
+	opts = []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, address string) (net.Conn, error) {
+			if lis, ok := listeners[address]; ok {
+				return lis.DialContext(ctx)
+			}
+
+			return nil, errors.New("connection refused")
+		}),
+	}
+
+	return
+}
+
+func Test_hashRing_lookup(t *testing.T) {
+	var (
+		endpoints = []string{"cm-0:50054", "cm-1:50054", "cm-2:50054"}
+		ring      = newHashRing(endpoints)
+		assigned  = make(map[string]string)
+		count     = make(map[string]int)
+	)
+
+	for i := 0; i < 300; i++ {
+		serviceID := fmt.Sprintf("service-%d", i)
+		res := ring.lookup(serviceID)
+
+		// All endpoints are returned exactly once for failover
+		assert.ElementsMatch(t, endpoints, res)
+
+		// The assignment is deterministic
+		assert.Equal(t, res, ring.lookup(serviceID))
+
+		assigned[serviceID] = res[0]
+		count[res[0]]++
+	}
+
+	// The services are distributed across all endpoints
+	for _, e := range endpoints {
+		assert.Greater(t, count[e], 50, "endpoint %s has only %d services", e, count[e])
+	}
+
+	// Removing an endpoint only moves the services of this endpoint
+	ring = newHashRing(endpoints[:2])
+	for serviceID, e := range assigned {
+		if e != endpoints[2] {
+			assert.Equal(t, e, ring.lookup(serviceID)[0])
+		}
+	}
+
+	// Duplicate endpoints are ignored
+	assert.Len(t, newHashRing([]string{"cm-0:50054", "cm-0:50054"}).lookup("service"), 1)
+	assert.Empty(t, newHashRing(nil).lookup("service"))
+}
+
+func TestServer_startCollectionModule(t *testing.T) {
+	var (
+		module = &collection.CollectionModule{
+			Id:                  "CM1",
+			Name:                "Mock",
+			Address:             "cm-0:50054",
+			AdditionalAddresses: []string{"cm-1:50054", "cm-2:50054"},
+		}
+		services []string
+	)
+
+	for i := 0; i < 30; i++ {
+		services = append(services, fmt.Sprintf("service-%d", i))
+	}
+
+	tests := []struct {
+		name    string
+		running []string
+		leases  map[string]time.Time
+	}{
+		{
+			name:    "All instances running",
+			running: []string{"cm-0:50054", "cm-1:50054", "cm-2:50054"},
+		},
+		{
+			name:    "Failover to running instances",
+			running: []string{"cm-1:50054", "cm-2:50054"},
+		},
+		{
+			name:    "Instance with expired lease is not used",
+			running: []string{"cm-0:50054", "cm-1:50054", "cm-2:50054"},
+			leases: map[string]time.Time{
+				"cm-0:50054": time.Now().Add(-time.Second),
+				"cm-1:50054": time.Now().Add(time.Minute),
+				"cm-2:50054": time.Now().Add(time.Minute),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instances, opts := startMockInstances(t, tt.running...)
+			srv := &Server{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					_ = s.Create(module)
+				}),
+				grpcOpts: opts,
+				leases:   map[string]map[string]time.Time{"CM1": tt.leases},
+			}
+
+			ring := newHashRing(module.Endpoints())
+			for _, serviceID := range services {
+				srv.startCollectionModule(context.Background(), module, serviceID, "")
+			}
+
+			// Each service is collected exactly once by the first usable instance in the order of the hash ring
+			var total int
+			for address, instance := range instances {
+				for _, serviceID := range instance.services {
+					assert.Equal(t, address, firstUsable(ring.lookup(serviceID), instances, tt.leases))
+				}
+				total += len(instance.services)
+			}
+			assert.Equal(t, len(services), total)
+		})
+	}
+}
+
+// firstUsable returns the first endpoint that is running and does not have an expired lease
+func firstUsable(endpoints []string, instances map[string]*mockInstance, leases map[string]time.Time) string {
+	for _, e := range endpoints {
+		if expiry, ok := leases[e]; ok && time.Now().After(expiry) {
+			continue
+		}
+		if instances[e] != nil {
+			return e
+		}
+	}
+
+	return ""
+}
+
+func TestServer_endpointsFor(t *testing.T) {
+	module := &collection.CollectionModule{
+		Id:                  "CM1",
+		Address:             "cm-0:50054",
+		AdditionalAddresses: []string{"cm-1:50054", "cm-2:50054"},
+	}
+
+	// Instances, which were unreachable during the last health check, are tried last
+	srv := &Server{health: map[string]*moduleHealth{"CM1": {unreachable: []string{"cm-0:50054", "cm-1:50054"}}}}
+	for i := 0; i < 10; i++ {
+		res := srv.endpointsFor(module, fmt.Sprintf("service-%d", i))
+		assert.Equal(t, "cm-2:50054", res[0])
+		assert.ElementsMatch(t, module.Endpoints(), res)
+	}
+}
+
+func TestServer_checkCollectionModule_instances(t *testing.T) {
+	_, opts := startMockInstances(t, "cm-1:50054")
+	srv := &Server{grpcOpts: opts}
+
+	// The status is determined by the first reachable instance
+	h := srv.checkCollectionModule(context.Background(), &collection.CollectionModule{
+		Address:             "cm-0:50054",
+		AdditionalAddresses: []string{"cm-1:50054"},
+	})
+	assert.Equal(t, collection.CollectionModule_STATUS_DEGRADED, h.status)
+	assert.Equal(t, "instances cm-0:50054 are unreachable", h.message)
+	assert.Equal(t, []string{"cm-0:50054"}, h.unreachable)
+
+	h = srv.checkCollectionModule(context.Background(), &collection.CollectionModule{Address: "cm-1:50054"})
+	assert.Equal(t, collection.CollectionModule_STATUS_HEALTHY, h.status)
+	assert.Empty(t, h.unreachable)
+}
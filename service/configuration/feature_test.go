@@ -130,6 +130,11 @@ func InitializeScenario(ctx *godog.ScenarioContext) {
 		return ctx, nil
 	})
 
+	ctx.After(func(ctx context.Context, sc *godog.Scenario, err error) (context.Context, error) {
+		feature.Close()
+		return ctx, err
+	})
+
 	ctx.Step(`^the following services exist:$`, feature.theFollowingServicesExist)
 	ctx.Step(`^the configuration interface$`, feature.theConfigurationInterface)
 	ctx.Step(`^the user accesses "([^"]*)" with "([^"]*)" set to "([^"]*)"$`, feature.theUserAccessesWithSetTo)
@@ -0,0 +1,246 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"clouditor.io/clouditor/api"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eclipse-xfsc/cam/api/collection"
+)
+
+const (
+	// DefaultHealthCheckInterval is the default interval in which the health of all collection modules is checked
+	DefaultHealthCheckInterval = time.Minute
+
+	// DefaultHealthCheckTimeout is the timeout of a single health check of a collection module
+	DefaultHealthCheckTimeout = 5 * time.Second
+)
+
+// moduleHealth contains the result of the last health check of a collection module
+type moduleHealth struct {
+	status  collection.CollectionModule_Status
+	message string
+	version string
+	checked time.Time
+
+	// incompatible is true, if the collection module reports capabilities that do not match its registration
+	incompatible bool
+
+	// unreachable contains the addresses of the instances of the collection module that are unreachable
+	unreachable []string
+}
+
+// checkCollectionModule checks the health of all instances of a collection module. The status of the collection module
+// is determined by the first reachable instance. If some of its instances are unreachable, the collection module is
+// degraded.
+func (srv *Server) checkCollectionModule(ctx context.Context, cm *collection.CollectionModule) (h *moduleHealth) {
+	var unreachable []string
+
+	for _, e := range cm.Endpoints() {
+		eh := srv.checkEndpoint(ctx, cm, e)
+		if eh.status == collection.CollectionModule_STATUS_UNREACHABLE {
+			unreachable = append(unreachable, e)
+		}
+
+		// Use the result of the first reachable instance
+		if h == nil || (h.status == collection.CollectionModule_STATUS_UNREACHABLE &&
+			eh.status != collection.CollectionModule_STATUS_UNREACHABLE) {
+			h = eh
+		}
+	}
+
+	if h == nil {
+		return &moduleHealth{
+			status:  collection.CollectionModule_STATUS_UNREACHABLE,
+			message: "collection module has no address",
+			checked: time.Now(),
+		}
+	}
+
+	h.unreachable = unreachable
+	if h.status == collection.CollectionModule_STATUS_HEALTHY && len(unreachable) > 0 {
+		h.status = collection.CollectionModule_STATUS_DEGRADED
+		h.message = fmt.Sprintf("instances %s are unreachable", strings.Join(unreachable, ", "))
+	}
+
+	return
+}
+
+// checkEndpoint checks the health of a single instance of a collection module by calling its Describe RPC. An
+// instance is healthy, if it supports the configuration type and all metrics of its registration. It is degraded, if
+// its capabilities do not match the registration or cannot be determined, because it does not implement Describe.
+func (srv *Server) checkEndpoint(ctx context.Context, cm *collection.CollectionModule, address string) (
+	h *moduleHealth) {
+	h = &moduleHealth{checked: time.Now()}
+
+	ctx, cancel := context.WithTimeout(ctx, DefaultHealthCheckTimeout)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, address, api.DefaultGrpcDialOptions(address, srv, srv.grpcOpts...)...)
+	if err != nil {
+		h.status = collection.CollectionModule_STATUS_UNREACHABLE
+		h.message = fmt.Sprintf("could not dial collection module: %v", err)
+		return
+	}
+	defer conn.Close()
+
+	res, err := collection.NewCollectionClient(conn).Describe(ctx, &collection.DescribeRequest{})
+	switch {
+	case status.Code(err) == codes.Unimplemented:
+		h.status = collection.CollectionModule_STATUS_DEGRADED
+		h.message = "collection module does not support Describe"
+	case err != nil:
+		h.status = collection.CollectionModule_STATUS_UNREACHABLE
+		h.message = fmt.Sprintf("could not reach collection module: %v", status.Convert(err).Message())
+	default:
+		h.version = res.Version
+		h.message = mismatch(cm, res)
+		h.incompatible = h.message != ""
+		if h.incompatible {
+			h.status = collection.CollectionModule_STATUS_DEGRADED
+		} else {
+			h.status = collection.CollectionModule_STATUS_HEALTHY
+		}
+	}
+
+	return
+}
+
+// mismatch describes the differences between the registration of a collection module and its capabilities. It
+// returns an empty string, if the collection module supports everything it is registered for.
+func mismatch(cm *collection.CollectionModule, res *collection.DescribeResponse) string {
+	var (
+		problems    []string
+		unsupported []string
+	)
+
+	if cm.ConfigMessageTypeUrl != res.ConfigMessageTypeUrl {
+		problems = append(problems, fmt.Sprintf("configuration type %s is not supported (expected %s)",
+			cm.ConfigMessageTypeUrl, res.ConfigMessageTypeUrl))
+	}
+
+	for _, m := range cm.Metrics {
+		if !slices.Contains(res.MetricIds, m.Id) {
+			unsupported = append(unsupported, m.Id)
+		}
+	}
+	if len(unsupported) > 0 {
+		problems = append(problems, fmt.Sprintf("metrics %s are not supported", strings.Join(unsupported, ", ")))
+	}
+
+	return strings.Join(problems, "; ")
+}
+
+// checkCollectionModules checks the health of all registered collection modules concurrently. It is executed
+// periodically.
+func (srv *Server) checkCollectionModules() {
+	var (
+		modules []*collection.CollectionModule
+		wg      sync.WaitGroup
+	)
+
+	err := srv.storage.List(&modules, "id", true, 0, -1)
+	if err != nil {
+		log.Errorf("Could not list collection modules for health check: %v", err)
+		return
+	}
+
+	for _, cm := range modules {
+		wg.Add(1)
+		go func(cm *collection.CollectionModule) {
+			defer wg.Done()
+			srv.setHealth(cm, srv.checkCollectionModule(context.Background(), cm))
+		}(cm)
+	}
+
+	wg.Wait()
+}
+
+// setHealth stores the result of a health check of a collection module and logs changes of its status
+func (srv *Server) setHealth(cm *collection.CollectionModule, h *moduleHealth) {
+	srv.healthMutex.Lock()
+	defer srv.healthMutex.Unlock()
+
+	if srv.health == nil {
+		srv.health = make(map[string]*moduleHealth)
+	}
+
+	if prev := srv.health[cm.Id]; prev == nil || prev.status != h.status {
+		if h.status == collection.CollectionModule_STATUS_HEALTHY {
+			log.Infof("Collection module `%s` is healthy (version: %s)", cm.Name, h.version)
+		} else {
+			log.Warnf("Collection module `%s` is %s: %s", cm.Name, statusName(h.status), h.message)
+		}
+	}
+
+	srv.health[cm.Id] = h
+}
+
+// removeHealth removes the health and lease of a collection module that has been removed
+func (srv *Server) removeHealth(id string) {
+	srv.healthMutex.Lock()
+	defer srv.healthMutex.Unlock()
+
+	delete(srv.health, id)
+	delete(srv.leases, id)
+}
+
+// applyHealth sets the status of the collection module according to its last health check and its leases. A
+// self-registered collection module, whose instances all have an expired lease, is unavailable, regardless of its last
+// health check.
+func (srv *Server) applyHealth(cm *collection.CollectionModule) {
+	srv.healthMutex.RLock()
+	defer srv.healthMutex.RUnlock()
+
+	if h := srv.health[cm.Id]; h != nil {
+		cm.Status = h.status
+		cm.StatusMessage = h.message
+		cm.Version = h.version
+		cm.LastHealthCheck = timestamppb.New(h.checked)
+	}
+
+	// The lease of the collection module expires with the lease of its last instance
+	var expiry time.Time
+	for _, e := range srv.leases[cm.Id] {
+		if e.After(expiry) {
+			expiry = e
+		}
+	}
+
+	if !expiry.IsZero() {
+		cm.LeaseExpiresAt = timestamppb.New(expiry)
+		if time.Now().After(expiry) {
+			cm.Status = collection.CollectionModule_STATUS_UNAVAILABLE
+			cm.StatusMessage = fmt.Sprintf("lease expired at %s", expiry.Format(time.RFC3339))
+		}
+	}
+}
+
+// statusName returns the lower-case name of the status of a collection module, e.g., "unreachable"
+func statusName(s collection.CollectionModule_Status) string {
+	return strings.ToLower(strings.TrimPrefix(s.String(), "STATUS_"))
+}
@@ -0,0 +1,237 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package configuration
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"clouditor.io/clouditor/api/assessment"
+	"clouditor.io/clouditor/persistence"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/eclipse-xfsc/cam/api/collection"
+	"github.com/eclipse-xfsc/cam/api/configuration"
+	"github.com/eclipse-xfsc/cam/internal/protobuf"
+	"github.com/eclipse-xfsc/cam/internal/testutil"
+)
+
+// mockCollectionServer is a collection module, which only implements Describe. If describe is nil, Describe is not
+// implemented.
+type mockCollectionServer struct {
+	collection.UnimplementedCollectionServer
+	describe *collection.DescribeResponse
+}
+
+func (m *mockCollectionServer) Describe(_ context.Context, _ *collection.DescribeRequest) (
+	*collection.DescribeResponse, error) {
+	if m.describe == nil {
+		return nil, status.Error(codes.Unimplemented, "method Describe not implemented")
+	}
+
+	return m.describe, nil
+}
+
+// startMockCollection starts a mock collection module on a bufconn listener and returns the gRPC options to dial it.
+// If neither describe nor unimplemented is set, no collection module is started, i.e., the module is unreachable.
+func startMockCollection(t *testing.T, describe *collection.DescribeResponse, unimplemented bool) []grpc.DialOption {
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if describe == nil && !unimplemented {
+		return opts
+	}
+
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	collection.RegisterCollectionServer(srv, &mockCollectionServer{describe: describe})
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	t.Cleanup(srv.Stop)
+
+	return append(opts, grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	}))
+}
+
+func newMockModule() *collection.CollectionModule {
+	return &collection.CollectionModule{
+		Id:                   "CM1",
+		Name:                 "Mock",
+		Metrics:              []*assessment.Metric{{Id: "TlsVersion"}, {Id: "TlsCipherSuite"}},
+		Address:              "127.0.0.1:1",
+		ConfigMessageTypeUrl: protobuf.TypeURL(&collection.CommunicationSecurityConfig{}),
+	}
+}
+
+func TestServer_checkCollectionModule(t *testing.T) {
+	tests := []struct {
+		name             string
+		describe         *collection.DescribeResponse
+		unimplemented    bool
+		wantStatus       collection.CollectionModule_Status
+		wantMessage      string
+		wantIncompatible bool
+	}{
+		{
+			name: "Healthy",
+			describe: &collection.DescribeResponse{
+				MetricIds:            []string{"TlsVersion", "TlsCipherSuite", "TlsCommonWeaknesses"},
+				ConfigMessageTypeUrl: protobuf.TypeURL(&collection.CommunicationSecurityConfig{}),
+				Version:              "v1.0.0",
+			},
+			wantStatus: collection.CollectionModule_STATUS_HEALTHY,
+		},
+		{
+			name: "Other configuration type and missing metric",
+			describe: &collection.DescribeResponse{
+				MetricIds:            []string{"TlsVersion"},
+				ConfigMessageTypeUrl: protobuf.TypeURL(&collection.AuthenticationSecurityConfig{}),
+			},
+			wantStatus: collection.CollectionModule_STATUS_DEGRADED,
+			wantMessage: "configuration type type.googleapis.com/cam.CommunicationSecurityConfig is not supported " +
+				"(expected type.googleapis.com/cam.AuthenticationSecurityConfig); metrics TlsCipherSuite are not supported",
+			wantIncompatible: true,
+		},
+		{
+			name:          "Describe not implemented",
+			unimplemented: true,
+			wantStatus:    collection.CollectionModule_STATUS_DEGRADED,
+			wantMessage:   "collection module does not support Describe",
+		},
+		{
+			name:       "Unreachable",
+			wantStatus: collection.CollectionModule_STATUS_UNREACHABLE,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := &Server{grpcOpts: startMockCollection(t, tt.describe, tt.unimplemented)}
+
+			h := srv.checkCollectionModule(context.Background(), newMockModule())
+			assert.Equal(t, tt.wantStatus, h.status)
+			assert.Equal(t, tt.wantIncompatible, h.incompatible)
+			if tt.wantMessage != "" {
+				assert.Equal(t, tt.wantMessage, h.message)
+			}
+			if tt.describe != nil {
+				assert.Equal(t, tt.describe.Version, h.version)
+			}
+		})
+	}
+}
+
+func TestServer_AddCollectionModule(t *testing.T) {
+	tests := []struct {
+		name       string
+		req        *configuration.AddCollectionModuleRequest
+		describe   *collection.DescribeResponse
+		wantStatus collection.CollectionModule_Status
+		wantCode   codes.Code
+		wantStored bool
+	}{
+		{
+			name: "Healthy module",
+			req:  &configuration.AddCollectionModuleRequest{Module: newMockModule()},
+			describe: &collection.DescribeResponse{
+				MetricIds:            []string{"TlsVersion", "TlsCipherSuite"},
+				ConfigMessageTypeUrl: protobuf.TypeURL(&collection.CommunicationSecurityConfig{}),
+			},
+			wantStatus: collection.CollectionModule_STATUS_HEALTHY,
+			wantStored: true,
+		},
+		{
+			name: "Incompatible module",
+			req:  &configuration.AddCollectionModuleRequest{Module: newMockModule()},
+			describe: &collection.DescribeResponse{
+				MetricIds:            []string{"TlsVersion", "TlsCipherSuite"},
+				ConfigMessageTypeUrl: protobuf.TypeURL(&collection.WorkloadSecurityConfig{}),
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:       "Unreachable module is added",
+			req:        &configuration.AddCollectionModuleRequest{Module: newMockModule()},
+			wantStatus: collection.CollectionModule_STATUS_UNREACHABLE,
+			wantStored: true,
+		},
+		{
+			name:     "Missing address",
+			req:      &configuration.AddCollectionModuleRequest{Module: &collection.CollectionModule{Id: "CM1"}},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Missing module",
+			req:      &configuration.AddCollectionModuleRequest{},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := &Server{
+				storage:  testutil.NewInMemoryStorage(t),
+				grpcOpts: startMockCollection(t, tt.describe, false),
+			}
+
+			res, err := srv.AddCollectionModule(context.Background(), tt.req)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if err == nil {
+				assert.Equal(t, tt.wantStatus, res.Status)
+				assert.NotNil(t, res.LastHealthCheck)
+			}
+
+			count, err := srv.storage.Count(&collection.CollectionModule{})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStored, count == 1)
+		})
+	}
+}
+
+func TestServer_checkCollectionModules(t *testing.T) {
+	srv := &Server{
+		storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+			_ = s.Create(newMockModule())
+		}),
+		grpcOpts: startMockCollection(t, &collection.DescribeResponse{
+			MetricIds:            []string{"TlsVersion", "TlsCipherSuite"},
+			ConfigMessageTypeUrl: protobuf.TypeURL(&collection.CommunicationSecurityConfig{}),
+			Version:              "v1.0.0",
+		}, false),
+	}
+
+	// Modules that have not been checked yet have no status
+	res, err := srv.ListCollectionModules(context.Background(), &configuration.ListCollectionModulesRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, collection.CollectionModule_STATUS_UNSPECIFIED, res.Modules[0].Status)
+
+	srv.checkCollectionModules()
+
+	res, err = srv.ListCollectionModules(context.Background(), &configuration.ListCollectionModulesRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, collection.CollectionModule_STATUS_HEALTHY, res.Modules[0].Status)
+	assert.Equal(t, "v1.0.0", res.Modules[0].Version)
+
+	// The status is removed together with the module
+	_, err = srv.RemoveCollectionModule(context.Background(),
+		&configuration.RemoveCollectionModuleRequest{ModuleId: "CM1"})
+	assert.NoError(t, err)
+	assert.Empty(t, srv.health)
+}
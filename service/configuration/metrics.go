@@ -36,11 +36,19 @@ func (s *Server) GetMetric(ctx context.Context, req *orchestrator.GetMetricReque
 
 // GetMetricConfiguration is a wrapper around Clouditor orchestrator
 func (s *Server) GetMetricConfiguration(ctx context.Context, req *orchestrator.GetMetricConfigurationRequest) (res *assessment.MetricConfiguration, err error) {
+	if err = s.verifyTenant(ctx, req.ServiceId); err != nil {
+		return
+	}
+
 	return s.OrchestratorServer.GetMetricConfiguration(ctx, req)
 }
 
 // UpdateMetricConfiguration is a wrapper around Clouditor orchestrator
 func (s *Server) UpdateMetricConfiguration(ctx context.Context, req *orchestrator.UpdateMetricConfigurationRequest) (res *assessment.MetricConfiguration, err error) {
+	if err = s.verifyTenant(ctx, req.ServiceId); err != nil {
+		return
+	}
+
 	defer s.retriggerCollection(req.ServiceId, req.MetricId)
 
 	return s.OrchestratorServer.UpdateMetricConfiguration(ctx, req)
@@ -61,8 +69,14 @@ func (s *Server) retriggerCollection(serviceId, metricId string) (err error) {
 	}
 
 	// Quickly check if we are monitoring at all
-	monitor, ok := s.monitoring[serviceId]
-	if !ok {
+	monitor := s.monitor(serviceId)
+	if monitor == nil {
+		return nil
+	}
+
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+	if !monitor.state.running() {
 		return nil
 	}
 
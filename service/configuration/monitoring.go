@@ -17,22 +17,27 @@ package configuration
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"time"
 
-	"clouditor.io/clouditor/api"
 	"clouditor.io/clouditor/api/assessment"
 	"clouditor.io/clouditor/api/orchestrator"
 	"github.com/go-co-op/gocron"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/exp/slices"
-	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/eclipse-xfsc/cam/api/collection"
 	"github.com/eclipse-xfsc/cam/api/configuration"
 	"github.com/eclipse-xfsc/cam/api/evaluation"
+	"github.com/eclipse-xfsc/cam/internal/tracing"
 )
 
 const (
@@ -40,19 +45,57 @@ const (
 	triggerCollectionModuleTag = "TriggerCM"
 	calculateComplianceTag     = "CalculateCompliance"
 
-	windowSize = 2 * time.Second
-	threshold  = 100
+	// DefaultRecalculationInterval is the default interval, in which the compliance of monitored controls is
+	// recalculated, if it has not been calculated in the meantime
+	DefaultRecalculationInterval = time.Hour
+
+	// Reasons for triggering a compliance calculation
+	calculationReasonResults = "results"
+	calculationReasonStale   = "stale"
+)
+
+var (
+	calculationsTriggered = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cam_compliance_calculations_triggered_total",
+		Help: "Number of compliance calculations triggered at the evaluation manager",
+	}, []string{"reason"})
+	calculationsCoalesced = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "cam_compliance_calculations_coalesced_total",
+		Help: "Number of assessment results coalesced into the compliance calculation of another result",
+	})
+	calculationsFailed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cam_compliance_calculations_failed_total",
+		Help: "Number of compliance calculations, which could not be triggered at the evaluation manager",
+	}, []string{"reason"})
+	collectionRuns = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cam_collection_runs_total",
+		Help: "Number of scheduled runs of collection modules",
+	}, []string{"module"})
+	collectionRunsFailed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cam_collection_runs_failed_total",
+		Help: "Number of scheduled runs of collection modules, which could not be started",
+	}, []string{"module"})
+
+	monitoredServicesDesc = prometheus.NewDesc("cam_monitored_services",
+		"Number of services, which are monitored", nil, nil)
+	monitoredControlsDesc = prometheus.NewDesc("cam_monitored_controls",
+		"Number of monitored controls of a service", []string{"service"}, nil)
 )
 
+// calculationKey identifies the compliance calculation of a control for a service
+type calculationKey struct {
+	serviceID string
+	controlID string
+}
+
 // StartMonitoring enables the monitoring of the specified control IDs for the given service.
 func (srv *Server) StartMonitoring(ctx context.Context, req *configuration.StartMonitoringRequest) (
 	res *configuration.StartMonitoringResponse, err error) {
 	res = new(configuration.StartMonitoringResponse)
 	var (
-		collectionModules []*collection.CollectionModule
-		filteredModules   []*collection.CollectionModule
-		controls          []*orchestrator.Requirement
-		metricIDs         []string
+		filteredModules []*collection.CollectionModule
+		metricIDs       []string
+		jobs            []*monitoringJob
 	)
 
 	// Validate request
@@ -60,15 +103,216 @@ func (srv *Server) StartMonitoring(ctx context.Context, req *configuration.Start
 		err = status.Error(codes.InvalidArgument, err.Error())
 		return
 	}
+	if err = srv.verifyTenant(ctx, req.ServiceId); err != nil {
+		return
+	}
 	// Verify that monitoring of this service and controls hasn't started already
-	if m := srv.monitoring[req.ServiceId]; m != nil && m.scheduler != nil && m.scheduler.IsRunning() {
-		err = status.Errorf(codes.AlreadyExists, "Service %s is being monitored already. Stop it first, "+
+	m, from, ok := srv.beginTransition(req.ServiceId, monitorStarting)
+	if !ok && (from == monitorStarting || from.running()) {
+		err = status.Errorf(codes.AlreadyExists, "Service %s is being monitored already. Use UpdateMonitoring, "+
 			"if, e.g., you want to monitor a new set of controls for this service ", req.ServiceId)
 		return
+	} else if !ok {
+		err = errConcurrentOperation(req.ServiceId, from)
+		return
+	}
+
+	filteredModules, metricIDs, err = srv.modulesFor(req.ControlIds)
+	if err != nil {
+		srv.abortStart(req.ServiceId, m)
+		return
 	}
 
+	// Start collection modules. Each collection module is scheduled at the interval of the monitored metrics it
+	// collects evidences for, unless there is a schedule for the service.
+	scheduler := gocron.NewScheduler(time.UTC)
+	for _, cm := range filteredModules {
+		job := srv.newMonitoringJob(cm, req.ServiceId, metricIDs, req.Schedules)
+
+		err = srv.scheduleCollection(scheduler, job)
+		if err != nil {
+			srv.abortStart(req.ServiceId, m)
+			err = status.Errorf(codes.InvalidArgument, "invalid schedule for collection module %s: %v", cm.Name, err)
+			return
+		}
+
+		jobs = append(jobs, job)
+	}
+
+	// ... and one, which recalculates the compliance of controls without recent assessment results
+	if srv.recalculationInterval > 0 {
+		_, err = scheduler.Tag(calculateComplianceTag).Every(srv.recalculationInterval).WaitForSchedule().
+			Do(srv.recalculateStale, req.ServiceId)
+		if err != nil {
+			srv.abortStart(req.ServiceId, m)
+			err = status.Errorf(codes.Internal, "could not schedule recalculation of compliance: %v", err)
+			return
+		}
+	}
+
+	srv.debounce().Configure(req.ServiceId, req.Debounce)
+
+	m.mutex.Lock()
+	m.scheduler = scheduler
+	m.monitoredControls = req.ControlIds
+	m.jobs = jobs
+
+	log.Debugf("Scheduling %d jobs for execution for service %s", len(jobs), req.ServiceId)
+
+	// Start all collection module jobs
+	scheduler.StartAsync()
+	m.transition(monitorRunning)
+	m.mutex.Unlock()
+
+	log.Debugf("Started monitoring service %s controls: %v", req.ServiceId, req.ControlIds)
+
+	// Retrieve the current status after the start
+	res.Status, err = srv.GetMonitoringStatus(ctx, &configuration.GetMonitoringStatusRequest{ServiceId: req.ServiceId})
+	if err != nil {
+		err = status.Errorf(codes.Internal, "could not retrieve monitoring status after start: %v", err)
+		return
+	}
+
+	return
+}
+
+// UpdateMonitoring updates the monitored controls and the schedules of a service, which is monitored already. Only the
+// jobs of collection modules, which are no longer needed or whose schedule changes, are removed and jobs for new
+// collection modules are added. All other jobs keep running and keep their last run.
+func (srv *Server) UpdateMonitoring(ctx context.Context, req *configuration.UpdateMonitoringRequest) (
+	res *configuration.UpdateMonitoringResponse, err error) {
+	var (
+		modules   []*collection.CollectionModule
+		metricIDs []string
+		jobs      []*monitoringJob
+		added     []*monitoringJob
+		kept      = make(map[*monitoringJob]bool)
+	)
+
+	// Validate request
+	if err = req.Validate(); err != nil {
+		err = status.Error(codes.InvalidArgument, err.Error())
+		return
+	}
+	if err = srv.verifyTenant(ctx, req.ServiceId); err != nil {
+		return
+	}
+
+	// The jobs of the monitoring are only changed by us until we finish the update
+	m, from, ok := srv.beginTransition(req.ServiceId, monitorUpdating)
+	if m == nil || (!ok && (from == monitorStopped || from == monitorStopping)) {
+		err = status.Errorf(codes.NotFound, "Service %s is not monitored. Start it via the `StartMonitoring` "+
+			"endpoint", req.ServiceId)
+		return
+	} else if !ok {
+		err = errConcurrentOperation(req.ServiceId, from)
+		return
+	}
+
+	modules, metricIDs, err = srv.modulesFor(req.ControlIds)
+	if err != nil {
+		m.finish(monitorRunning)
+		return
+	}
+
+	// The scheduler keeps running during the update, so we need to hold the lock while changing its jobs. Otherwise,
+	// concurrent readers of the scheduler, e.g., GetMonitoringStatus, would race with adding a job.
+	m.mutex.Lock()
+
+	for _, cm := range modules {
+		job := srv.newMonitoringJob(cm, req.ServiceId, metricIDs, req.Schedules)
+
+		// Keep the existing job of the collection module, if its schedule does not change
+		if i := slices.IndexFunc(m.jobs, func(j *monitoringJob) bool {
+			return j.module.Id == cm.Id && proto.Equal(j.schedule, job.schedule)
+		}); i != -1 {
+			m.jobs[i].setMetricIDs(job.metricIDs)
+			kept[m.jobs[i]] = true
+			jobs = append(jobs, m.jobs[i])
+			continue
+		}
+
+		err = srv.scheduleCollection(m.scheduler, job)
+		if err != nil {
+			// Roll back the jobs we have added so far
+			for _, j := range added {
+				j.stop()
+				m.scheduler.RemoveByReference(j.job)
+			}
+
+			m.transition(monitorRunning)
+			m.mutex.Unlock()
+
+			err = status.Errorf(codes.InvalidArgument, "invalid schedule for collection module %s: %v", cm.Name, err)
+			return
+		}
+
+		added = append(added, job)
+		jobs = append(jobs, job)
+	}
+
+	// Remove the jobs of collection modules that are no longer needed or that have been re-scheduled
+	for _, j := range m.jobs {
+		if !kept[j] {
+			j.stop()
+			m.scheduler.RemoveByReference(j.job)
+		}
+	}
+
+	log.Infof("Updated monitoring of service %s: %d jobs added, %d jobs removed, %d jobs kept", req.ServiceId,
+		len(added), len(m.jobs)-len(kept), len(kept))
+
+	m.monitoredControls = req.ControlIds
+	m.jobs = jobs
+	m.transition(monitorRunning)
+	m.mutex.Unlock()
+
+	srv.debounce().Configure(req.ServiceId, req.Debounce)
+
+	res = new(configuration.UpdateMonitoringResponse)
+	res.Status, err = srv.GetMonitoringStatus(ctx, &configuration.GetMonitoringStatusRequest{ServiceId: req.ServiceId})
+	if err != nil {
+		err = status.Errorf(codes.Internal, "could not retrieve monitoring status after update: %v", err)
+		return
+	}
+
+	return
+}
+
+// ReportEvidences adds the number of evidences, which the evaluation manager received from a collection module, to
+// the job of the collection module. Reports for services or collection modules that are not monitored are ignored.
+func (srv *Server) ReportEvidences(_ context.Context, req *configuration.ReportEvidencesRequest) (
+	res *emptypb.Empty, err error) {
+	if err = req.Validate(); err != nil {
+		err = status.Error(codes.InvalidArgument, err.Error())
+		return
+	}
+
+	if m := srv.monitor(req.ServiceId); m != nil {
+		m.mutex.Lock()
+		defer m.mutex.Unlock()
+
+		for _, j := range m.jobs {
+			if j.module.Id == req.ModuleId {
+				j.addEvidences(req.RunId, req.Count)
+			}
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// modulesFor returns the collection modules, which collect evidences for at least one metric of the controls, and the
+// IDs of these metrics
+func (srv *Server) modulesFor(controlIDs []string) (modules []*collection.CollectionModule, metricIDs []string,
+	err error) {
+	var (
+		collectionModules []*collection.CollectionModule
+		controls          []*orchestrator.Requirement
+	)
+
 	// Retrieve all controls via the respective IDs
-	err = srv.storage.List(&controls, "id", true, 0, -1, "ID IN ?", req.ControlIds)
+	err = srv.storage.List(&controls, "id", true, 0, -1, "ID IN ?", controlIDs)
 	if err != nil {
 		err = status.Errorf(codes.Internal, "database error: %v", err)
 		return
@@ -97,124 +341,108 @@ func (srv *Server) StartMonitoring(ctx context.Context, req *configuration.Start
 		if slices.IndexFunc(cm.Metrics, func(m *assessment.Metric) bool {
 			return slices.Contains(metricIDs, m.Id)
 		}) != -1 {
-			filteredModules = append(filteredModules, cm)
-		}
-	}
-
-	// Get minimum Metric interval (or Default if no interval is specified in any metric)
-	// TODO(lebogg): This is only a workaround due to our inconsistent specification regarding metrics that shouldn't be bound to CMs. and thus, interval as well
-	interval := srv.calculateInterval(metricIDs)
-
-	// Start collection modules and run them every $DefaultInterval minutes
-	scheduler := gocron.NewScheduler(time.UTC)
-	for _, cm := range filteredModules {
-		var responsibleMetricIds []string
-		for _, metric := range cm.Metrics {
-			responsibleMetricIds = append(responsibleMetricIds, metric.Id)
-		}
-
-		// We need to add one job for each the collection module. We also tag the job with the metric IDs this
-		// collection module feels "responsible" for ...
-		_, err = scheduler.Tag(triggerCollectionModuleTag).Tag(responsibleMetricIds...).Every(interval).Seconds().Do(srv.startCollectionModule, cm, req.ServiceId)
-		if err != nil {
-			log.Errorf("Could not start scheduler for `startCollectionModule` for %s: %v",
-				cm.Name, err)
+			modules = append(modules, cm)
 		}
 	}
 
-	//// ... and one, which will trigger the compliance calculation in the evaluation manager.
-	//_, err = scheduler.Tag(calculateComplianceTag).Every(10).Seconds().WaitForSchedule().Do(func() {
-	//	srv.triggerComplianceCalculation(req.ServiceId, req.ControlIds)
-	//})
-	//if err != nil {
-	//	log.Errorf("Could not start scheduler for `triggerComplianceCalculation` for %s: %v",
-	//		req.ServiceId, err)
-	//}
-
-	srv.monitoring[req.ServiceId] = new(MonitorScheduler)
-	srv.monitoring[req.ServiceId].scheduler = scheduler
-	srv.monitoring[req.ServiceId].monitoredControls = req.ControlIds
-
-	jobs := scheduler.Jobs()
-	log.Debugf("Scheduling %d jobs for execution for service %s", len(jobs), req.ServiceId)
-
-	// Start all collection module jobs
-	scheduler.StartAsync()
-
-	log.Debugf("Started monitoring service %s controls: %v", req.ServiceId, req.ControlIds)
-
-	// Retrieve the current status after the start
-	res.Status, err = srv.GetMonitoringStatus(ctx, &configuration.GetMonitoringStatusRequest{ServiceId: req.ServiceId})
-	if err != nil {
-		err = status.Errorf(codes.Internal, "could not retrieve monitoring status after start: %v", err)
-		return
-	}
-
 	return
 }
 
 // GetMonitoringStatus returns the set of controls which are currently monitored for service with specified ID. If
 // service is not set up already, returns `Not Found` error. If it is set up but monitoring is currently stopped, return
 // empty list of controls
-func (srv *Server) GetMonitoringStatus(_ context.Context, req *configuration.GetMonitoringStatusRequest) (
+func (srv *Server) GetMonitoringStatus(ctx context.Context, req *configuration.GetMonitoringStatusRequest) (
 	res *configuration.MonitoringStatus, err error) {
-	// If monitoring does not exist (that is the struct is nil), return not found error
-	m := srv.monitoring[req.ServiceId]
-	if m == nil {
-		err = status.Errorf(codes.NotFound, "Monitoring for service %s has not been started yet. "+
-			"Start it via the `StartMonitoring` endpoint", req.ServiceId)
+	if err = srv.verifyTenant(ctx, req.ServiceId); err != nil {
 		return
 	}
 
-	// Get job for triggering collection modules
-	jobs, err := m.scheduler.FindJobsByTag(triggerCollectionModuleTag)
-	if err != nil {
-		log.Errorf("Couldn't find job '%s' for scheduler: %v", triggerCollectionModuleTag, err)
+	// If monitoring does not exist (that is the struct is nil), return not found error
+	m := srv.monitor(req.ServiceId)
+	if m != nil {
+		m.mutex.Lock()
+		defer m.mutex.Unlock()
+	}
+	if m == nil || m.scheduler == nil {
+		err = status.Errorf(codes.NotFound, "Monitoring for service %s has not been started yet. "+
+			"Start it via the `StartMonitoring` endpoint", req.ServiceId)
 		return
 	}
-	// We only have one job for each service and tag
-	job := jobs[0]
 
-	// Init response w/ serviceID and time of the last run
+	// Init response w/ serviceID
 	res = &configuration.MonitoringStatus{
 		ServiceId: req.ServiceId,
-		// LastRun is empty if there was no run yet
-		LastRun: timestamppb.New(job.LastRun()),
 	}
 
+	// Report the schedule of each job. LastRun is the time of the most recent run of any job (empty if there was no run
+	// yet) and NextRun the time of the next scheduled run of any job.
+	var lastRun, nextRun time.Time
+	for _, j := range m.triggerJobs() {
+		if j.LastRun().After(lastRun) {
+			lastRun = j.LastRun()
+		}
+		if nextRun.IsZero() || j.NextRun().Before(nextRun) {
+			nextRun = j.NextRun()
+		}
+	}
+	res.LastRun = timestamppb.New(lastRun)
+
+	for _, j := range m.jobs {
+		res.Jobs = append(res.Jobs, j.status(m.state.running()))
+	}
+	res.Debounce = srv.debounce().Config(req.ServiceId)
+
 	// If monitoring has been started but is not currently running, an empty list of controls will be returned
-	if !m.scheduler.IsRunning() {
+	if !m.state.running() {
 		return
 	}
 
 	// Add set of currently monitored controls and next scheduled run
 	res.ControlIds = m.monitoredControls
-	res.NextRun = timestamppb.New(job.NextRun())
+	if !nextRun.IsZero() {
+		res.NextRun = timestamppb.New(nextRun)
+	}
 	return
 }
 
 // StopMonitoring stops monitoring of the service with specified service ID. Returns error (not found), when there is no
 // monitoring currently.
-func (srv *Server) StopMonitoring(_ context.Context, req *configuration.StopMonitoringRequest) (
+func (srv *Server) StopMonitoring(ctx context.Context, req *configuration.StopMonitoringRequest) (
 	res *configuration.StopMonitoringResponse, err error) {
-	m := srv.monitoring[req.ServiceId]
+	if err = srv.verifyTenant(ctx, req.ServiceId); err != nil {
+		return
+	}
+
 	// Verify that the service is monitored currently
+	m, from, ok := srv.beginTransition(req.ServiceId, monitorStopping)
 	if m == nil {
 		err = status.Errorf(codes.NotFound, "Monitoring of service %s has not been started yet.", req.ServiceId)
 		return
-	}
-	if !m.scheduler.IsRunning() {
+	} else if !ok && (from == monitorStopped || from == monitorStopping) {
 		err = status.Errorf(codes.NotFound, "Monitoring of service %s has been stopped already", req.ServiceId)
 		return
+	} else if !ok {
+		err = errConcurrentOperation(req.ServiceId, from)
+		return
+	}
+
+	// Stop scheduler. This waits for the running jobs, so we do not hold the lock of the monitoring meanwhile.
+	for _, j := range m.jobs {
+		j.stop()
 	}
-	// Stop scheduler
-	srv.monitoring[req.ServiceId].scheduler.Stop()
+	m.scheduler.Stop()
+
+	// Close the connections, which are not used for monitoring other services anymore
+	srv.pool().release(req.ServiceId)
+	srv.debounce().Configure(req.ServiceId, nil)
+
+	m.finish(monitorStopped)
 
 	res = &configuration.StopMonitoringResponse{}
 	return
 }
 
-// calculateInterval calculates the interval as the smallest interval of all metric intervals
+// calculateInterval calculates the interval in seconds as the smallest interval of all metric intervals
 func (srv *Server) calculateInterval(metricIDs []string) (interval int) {
 	var (
 		err     error
@@ -249,63 +477,113 @@ func (srv *Server) calculateInterval(metricIDs []string) (interval int) {
 	return
 }
 
-// triggerComplianceCalculation triggers the compliance calculation at the evaluation manager. TODO(oxisto): we should
-// re-use connections instead of creating new ones
-func (srv *Server) triggerComplianceCalculation(serviceID string, controlIDs []string) {
+// triggerComplianceCalculation triggers the compliance calculation at the evaluation manager. The connection to the
+// evaluation manager is re-used.
+func (srv *Server) triggerComplianceCalculation(ctx context.Context, serviceID string, controlIDs []string) (
+	err error) {
 	log.Infof("Triggering compliance calculation for `%s` and controls: [%v]", serviceID, controlIDs)
 
-	// Create connection to the evaluation manager
-	conn, err := grpc.Dial(srv.evalManagerAddress, api.DefaultGrpcDialOptions(srv.evalManagerAddress, srv)...)
-	if err != nil {
+	conn, err := srv.acquire(srv.evalManagerAddress, serviceID)
+	if errors.Is(err, errNotMonitored) {
+		log.Debugf("Not triggering compliance calculation for `%s`, since it is not monitored anymore", serviceID)
+		return
+	} else if err != nil {
 		log.Errorf("Could not dial to `%s`: %v", srv.evalManagerAddress, err)
 		return
 	}
 
+	tenant, err := srv.tenantOf(serviceID)
+	if err != nil {
+		log.Errorf("Could not retrieve tenant of `%s`: %v", serviceID, err)
+		return
+	}
+
 	client := evaluation.NewEvaluationClient(conn)
-	_, err = client.CalculateCompliance(context.TODO(), &evaluation.CalculateComplianceRequest{
+	_, err = client.CalculateCompliance(ctx, &evaluation.CalculateComplianceRequest{
 		ServiceId:  serviceID,
 		ControlIds: controlIDs,
+		TenantId:   tenant,
 	})
 	if err != nil {
 		log.Errorf("Could not trigger compliance calculation for `%s`: %v", serviceID, err)
 	}
+
+	return
 }
 
-func (srv *Server) handleIncomingAssessmentResults(result *assessment.AssessmentResult, err error) {
-	var key = fmt.Sprintf("%s-%s", result.ServiceId, result.MetricId)
+// calculate triggers the compliance calculation for the reason, records its outcome in the metrics and remembers the
+// time of the calculation for each control. The call to the Evaluation Manager is traced.
+func (srv *Server) calculate(reason string, serviceID string, controlIDs []string) {
+	ctx, span := tracing.Start(context.Background(), "configuration.TriggerComplianceCalculation",
+		trace.WithAttributes(attribute.String("service.id", serviceID), attribute.String("reason", reason)))
+	err := srv.triggerComplianceCalculation(ctx, serviceID, controlIDs)
+	tracing.End(span, err)
+
+	if errors.Is(err, errNotMonitored) {
+		return
+	} else if err != nil {
+		calculationsFailed.WithLabelValues(reason).Inc()
+		return
+	}
+
+	calculationsTriggered.WithLabelValues(reason).Inc()
+
+	srv.calculationsMutex.Lock()
+	defer srv.calculationsMutex.Unlock()
+
+	if srv.calculations == nil {
+		srv.calculations = make(map[calculationKey]time.Time)
+	}
+	for _, id := range controlIDs {
+		srv.calculations[calculationKey{serviceID, id}] = time.Now()
+	}
+}
+
+// recalculateStale triggers the compliance calculation for the monitored controls of the service, whose compliance
+// has not been calculated within the recalculation interval, e.g., because no assessment results arrived for their
+// metrics
+func (srv *Server) recalculateStale(serviceID string) {
+	var stale []string
 
-	// We not want to trigger the compliance calculation for every result. We need to have some kind of algorithm here.
-	// Basically, we want to have two things:
-	//
-	// - First, we accrue assessment results for a particular service and metric and only increment a counter. Once the
-	// counter reaches a certain threshold, we actually trigger, otherwise we just increment the counter
-	//
-	// - Second, if we do not reach the desired counter within a time threshold, we also trigger, to not leave results hanging
-	var w *complianceCalcWindow
-	var ok bool
-	w, ok = srv.ccw[key]
-	if !ok {
-		w = &complianceCalcWindow{start: time.Now()}
-		srv.ccw[key] = w
+	m := srv.monitor(serviceID)
+	if m == nil {
+		return
 	}
 
-	if w.counter.Load() == 0 {
-		// We are the first to arrive, so we start a go-routine that will finish the job in any case in delay time
-		go func() {
-			log.Debugf("Starting new compliance calculation window for service %s and metric %s", result.ServiceId, result.MetricId)
-			time.Sleep(windowSize)
+	m.mutex.Lock()
+	controlIDs := m.monitoredControls
+	m.mutex.Unlock()
 
-			srv.actuallyTrigger(result.ServiceId, result.MetricId)
-			w.counter.Store(0)
-		}()
+	srv.calculationsMutex.Lock()
+	for _, id := range controlIDs {
+		if time.Since(srv.calculations[calculationKey{serviceID, id}]) >= srv.recalculationInterval {
+			stale = append(stale, id)
+		}
 	}
+	srv.calculationsMutex.Unlock()
 
-	w.counter.Add(1)
+	if len(stale) == 0 {
+		return
+	}
+
+	log.Debugf("Recalculating compliance of stale controls %v of service %s", stale, serviceID)
+	srv.calculate(calculationReasonStale, serviceID, stale)
+}
 
-	if w.counter.Load() >= threshold {
-		srv.actuallyTrigger(result.ServiceId, result.MetricId)
-		w.counter.Store(0)
+func (srv *Server) handleIncomingAssessmentResults(result *assessment.AssessmentResult, err error) {
+	// We not want to trigger the compliance calculation for every result. Instead, the debouncer coalesces the results
+	// of a service and metric, e.g., by accruing them in a window, and tells us when to actually trigger.
+	srv.debounce().Add(result.ServiceId, result.MetricId)
+}
+
+// debounced is called by the debouncer, once the compliance calculation should be triggered for the metric of the
+// service
+func (srv *Server) debounced(serviceID string, metricID string, results int64) {
+	if results > 1 {
+		calculationsCoalesced.Add(float64(results - 1))
 	}
+
+	srv.actuallyTrigger(serviceID, metricID)
 }
 
 func (srv *Server) actuallyTrigger(serviceID, metricID string) {
@@ -313,10 +591,8 @@ func (srv *Server) actuallyTrigger(serviceID, metricID string) {
 
 	var service *orchestrator.CloudService
 	var control *orchestrator.Requirement
-	// Every time, we get an incoming assessment result, let's check if the control is monitoring and if yes, then
-	// trigger the compliance calculation TODO(oxisto): We probably want to have some kind of intelligent algorithm here
-	// that checks for X amount of results in a particular time or something like that and only then does a trigger,
-	// because we often have a batch of assessment results coming in at more or less the same time.
+	// Every time the debouncer tells us, let's check if the control is monitored and if yes, then trigger the
+	// compliance calculation
 
 	// Find control for metric
 	control, err = srv.controlFor(metricID)
@@ -331,7 +607,44 @@ func (srv *Server) actuallyTrigger(serviceID, metricID string) {
 		return
 	}
 
-	if slices.Contains(service.Requirements.RequirementIds, control.Id) {
-		srv.triggerComplianceCalculation(service.Id, []string{control.Id})
+	if slices.Contains(service.GetRequirements().GetRequirementIds(), control.Id) {
+		srv.calculate(calculationReasonResults, service.Id, []string{control.Id})
+	}
+}
+
+// monitoringCollector exposes the services, whose monitoring is running, and their number of monitored controls in
+// the metrics. It is registered by NewServer, if the server has a metrics registerer.
+type monitoringCollector struct {
+	srv *Server
+}
+
+func (c monitoringCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- monitoredServicesDesc
+	ch <- monitoredControlsDesc
+}
+
+func (c monitoringCollector) Collect(ch chan<- prometheus.Metric) {
+	var count float64
+
+	c.srv.forEachRunningMonitor(func(serviceID string, m *MonitorScheduler) {
+		count++
+		ch <- prometheus.MustNewConstMetric(monitoredControlsDesc, prometheus.GaugeValue,
+			float64(len(m.monitoredControls)), serviceID)
+	})
+
+	ch <- prometheus.MustNewConstMetric(monitoredServicesDesc, prometheus.GaugeValue, count)
+}
+
+// forEachRunningMonitor calls f for each monitoring, which is running, while holding its mutex
+func (srv *Server) forEachRunningMonitor(f func(serviceID string, m *MonitorScheduler)) {
+	srv.monitoringMutex.Lock()
+	defer srv.monitoringMutex.Unlock()
+
+	for serviceID, m := range srv.monitoring {
+		m.mutex.Lock()
+		if m.state.running() {
+			f(serviceID, m)
+		}
+		m.mutex.Unlock()
 	}
 }
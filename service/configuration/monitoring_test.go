@@ -18,18 +18,22 @@ package configuration
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
+	"clouditor.io/clouditor/api/assessment"
 	"clouditor.io/clouditor/api/orchestrator"
 	"clouditor.io/clouditor/persistence"
 	service_orchestrator "clouditor.io/clouditor/service/orchestrator"
 	"github.com/go-co-op/gocron"
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
 
+	"github.com/eclipse-xfsc/cam/api/collection"
 	"github.com/eclipse-xfsc/cam/api/configuration"
 	"github.com/eclipse-xfsc/cam/internal/testutil"
 )
@@ -63,6 +67,7 @@ func Test_server_StartMonitoring(t *testing.T) {
 				storage:             storage,
 				monitoring: map[string]*MonitorScheduler{
 					"0000": {
+						state:             monitorRunning,
 						scheduler:         mockStartedScheduler(t),
 						monitoredControls: []string{"C1"},
 					},
@@ -104,6 +109,7 @@ func Test_server_StartMonitoring(t *testing.T) {
 				storage:             storage,
 				monitoring: map[string]*MonitorScheduler{
 					"0000": {
+						state:             monitorRunning,
 						scheduler:         mockStartedScheduler(t),
 						monitoredControls: []string{"C1"},
 					},
@@ -171,6 +177,78 @@ func Test_server_StartMonitoring(t *testing.T) {
 	}
 }
 
+func TestServer_UpdateMonitoring(t *testing.T) {
+	srv := &Server{
+		storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+			assert.NoError(t, populateStorage(t, s))
+			assert.NoError(t, s.Create(&orchestrator.Requirement{Id: "Req-2", Metrics: []*assessment.Metric{
+				{Id: "Metric-3", Interval: 120},
+			}}))
+			assert.NoError(t, s.Create(&collection.CollectionModule{
+				Id:      "Module-3",
+				Metrics: []*assessment.Metric{{Id: "Metric-3"}},
+			}))
+		}),
+		interval:   300,
+		monitoring: make(map[string]*MonitorScheduler),
+	}
+
+	// Updating requires a running monitoring
+	_, err := srv.UpdateMonitoring(context.Background(),
+		&configuration.UpdateMonitoringRequest{ServiceId: "myService", ControlIds: []string{"Req-1"}})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = srv.StartMonitoring(context.Background(),
+		&configuration.StartMonitoringRequest{ServiceId: "myService", ControlIds: []string{"Req-1"}})
+	assert.NoError(t, err)
+
+	m := srv.monitoring["myService"]
+	defer m.scheduler.Stop()
+
+	// Wait for the first run of the jobs, which are started immediately
+	assert.Eventually(t, func() bool {
+		return m.jobs[0].job.LastRun().After(time.Time{}) && m.jobs[1].job.LastRun().After(time.Time{})
+	}, 5*time.Second, 10*time.Millisecond)
+	module1, module2 := m.jobs[0], m.jobs[1]
+	lastRun := module1.job.LastRun()
+
+	// Adding a control only adds the job of its collection module
+	res, err := srv.UpdateMonitoring(context.Background(),
+		&configuration.UpdateMonitoringRequest{ServiceId: "myService", ControlIds: []string{"Req-1", "Req-2"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Req-1", "Req-2"}, res.Status.ControlIds)
+	assert.Len(t, res.Status.Jobs, 3)
+	assert.Len(t, m.scheduler.Jobs(), 3)
+	assert.Same(t, module1, m.jobs[0])
+	assert.Same(t, module2, m.jobs[1])
+	assert.Equal(t, lastRun, m.jobs[0].job.LastRun())
+	assert.Equal(t, int64(120), m.jobs[2].schedule.Interval)
+
+	// An invalid schedule does not change anything
+	_, err = srv.UpdateMonitoring(context.Background(), &configuration.UpdateMonitoringRequest{
+		ServiceId:  "myService",
+		ControlIds: []string{"Req-2"},
+		Schedules:  []*configuration.CollectionSchedule{{ModuleId: "Module-3", Cron: "invalid"}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Len(t, m.scheduler.Jobs(), 3)
+	assert.Len(t, m.jobs, 3)
+
+	// Removing a control removes the jobs of its collection modules, a changed schedule replaces the job
+	module3 := m.jobs[2]
+	res, err = srv.UpdateMonitoring(context.Background(), &configuration.UpdateMonitoringRequest{
+		ServiceId:  "myService",
+		ControlIds: []string{"Req-2"},
+		Schedules:  []*configuration.CollectionSchedule{{ModuleId: "Module-3", Interval: 60}},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, res.Status.Jobs, 1)
+	assert.Equal(t, "Module-3", res.Status.Jobs[0].ModuleId)
+	assert.Equal(t, int64(60), res.Status.Jobs[0].Schedule.Interval)
+	assert.Len(t, m.scheduler.Jobs(), 1)
+	assert.NotSame(t, module3, m.jobs[0])
+}
+
 func Test_server_GetMonitoringStatus(t *testing.T) {
 	type fields struct {
 		storage    persistence.Storage
@@ -193,6 +271,7 @@ func Test_server_GetMonitoringStatus(t *testing.T) {
 				storage: nil,
 				monitoring: map[string]*MonitorScheduler{
 					"0000": {
+						state:             monitorRunning,
 						scheduler:         mockStartedScheduler(t),
 						monitoredControls: []string{"C1"},
 					},
@@ -295,6 +374,7 @@ func Test_server_StopMonitoring(t *testing.T) {
 				storage: nil,
 				monitoring: map[string]*MonitorScheduler{
 					"0000": {
+						state:             monitorRunning,
 						scheduler:         mockStartedScheduler(t),
 						monitoredControls: []string{"C1"},
 					},
@@ -380,6 +460,25 @@ func mockStartedScheduler(t *testing.T) (s *gocron.Scheduler) {
 	return
 }
 
+func Test_monitoringCollector(t *testing.T) {
+	srv := &Server{monitoring: map[string]*MonitorScheduler{
+		"service-1": {state: monitorRunning, monitoredControls: []string{"Control1", "Control2"}},
+		"service-2": {state: monitorUpdating, monitoredControls: []string{"Control1"}},
+		"service-3": {state: monitorStopped, monitoredControls: []string{"Control1"}},
+	}}
+
+	err := promtestutil.CollectAndCompare(monitoringCollector{srv}, strings.NewReader(`
+# HELP cam_monitored_controls Number of monitored controls of a service
+# TYPE cam_monitored_controls gauge
+cam_monitored_controls{service="service-1"} 2
+cam_monitored_controls{service="service-2"} 1
+# HELP cam_monitored_services Number of services, which are monitored
+# TYPE cam_monitored_services gauge
+cam_monitored_services 2
+`))
+	assert.NoError(t, err)
+}
+
 // mockStoppedScheduler returns a mocked scheduler (w/ noop job) which is stopped (== not running)
 func mockStoppedScheduler() (s *gocron.Scheduler) {
 	s = gocron.NewScheduler(time.UTC).Tag(triggerCollectionModuleTag)
@@ -0,0 +1,138 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package configuration
+
+import (
+	"errors"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+// errNotMonitored is returned by acquire, if the service is not monitored (anymore)
+var errNotMonitored = errors.New("service is not monitored")
+
+// connPool re-uses gRPC connections to the collection modules and the evaluation manager. There is one connection per
+// address, which is shared by all monitored services (owners). A connection is closed, once it has no owners anymore
+// or once its address is removed, e.g., because the collection module has been removed.
+type connPool struct {
+	mutex sync.Mutex
+
+	// conns contains the connection (value) per address (key)
+	conns map[string]*grpc.ClientConn
+
+	// owners contains the owners (value) of the connection per address (key)
+	owners map[string]map[string]bool
+
+	// dial creates a new connection to the address
+	dial func(address string) (*grpc.ClientConn, error)
+}
+
+// newConnPool creates a new connection pool, which uses dial to create new connections
+func newConnPool(dial func(address string) (*grpc.ClientConn, error)) *connPool {
+	return &connPool{
+		conns:  make(map[string]*grpc.ClientConn),
+		owners: make(map[string]map[string]bool),
+		dial:   dial,
+	}
+}
+
+// get returns the connection to the address and adds owner to its owners. A new connection is created, if there is no
+// connection yet or if the existing one has been shut down. If the existing connection is in a transient failure,
+// e.g., because the collection module has been restarted, it reconnects right away instead of waiting for its backoff.
+func (p *connPool) get(address string, owner string) (conn *grpc.ClientConn, err error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	conn = p.conns[address]
+
+	switch {
+	case conn == nil || conn.GetState() == connectivity.Shutdown:
+		conn, err = p.dial(address)
+		if err != nil {
+			return nil, err
+		}
+
+		p.conns[address] = conn
+	case conn.GetState() == connectivity.TransientFailure:
+		log.Debugf("Connection to %s failed, reconnecting", address)
+		conn.ResetConnectBackoff()
+	}
+
+	if p.owners[address] == nil {
+		p.owners[address] = make(map[string]bool)
+	}
+	p.owners[address][owner] = true
+
+	return
+}
+
+// release removes owner from the owners of all connections and closes the connections without any owners
+func (p *connPool) release(owner string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	for address, owners := range p.owners {
+		delete(owners, owner)
+		if len(owners) == 0 {
+			p.close(address)
+		}
+	}
+}
+
+// remove closes the connections to the addresses regardless of their owners
+func (p *connPool) remove(addresses ...string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	for _, address := range addresses {
+		p.close(address)
+	}
+}
+
+// close closes the connection to the address. The caller must hold the mutex.
+func (p *connPool) close(address string) {
+	if conn := p.conns[address]; conn != nil {
+		if err := conn.Close(); err != nil {
+			log.Debugf("Could not close connection to %s: %v", address, err)
+		}
+	}
+
+	delete(p.conns, address)
+	delete(p.owners, address)
+}
+
+// acquire returns the connection to the address for the monitoring of the service. Connections are only acquired while
+// the monitoring is running, since StopMonitoring releases the connections of the service only once. Otherwise, e.g., a
+// compliance calculation, which is triggered by the debouncer after the monitoring has been stopped, would leak its
+// connection.
+func (srv *Server) acquire(address string, serviceID string) (conn *grpc.ClientConn, err error) {
+	m := srv.monitor(serviceID)
+	if m == nil {
+		return nil, errNotMonitored
+	}
+
+	// Holding the lock of the monitoring ensures that StopMonitoring cannot release the connections in between
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if !m.state.running() {
+		return nil, errNotMonitored
+	}
+
+	return srv.pool().get(address, serviceID)
+}
@@ -0,0 +1,210 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package configuration
+
+import (
+	"context"
+	"net"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/persistence"
+	"github.com/go-co-op/gocron"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/eclipse-xfsc/cam/api/collection"
+	"github.com/eclipse-xfsc/cam/api/configuration"
+	"github.com/eclipse-xfsc/cam/api/evaluation"
+	"github.com/eclipse-xfsc/cam/internal/testutil"
+)
+
+// mockEvaluationServer counts the triggered compliance calculations
+type mockEvaluationServer struct {
+	evaluation.UnimplementedEvaluationServer
+	calculations atomic.Int64
+}
+
+func (m *mockEvaluationServer) CalculateCompliance(_ context.Context, _ *evaluation.CalculateComplianceRequest) (
+	*emptypb.Empty, error) {
+	m.calculations.Add(1)
+	return &emptypb.Empty{}, nil
+}
+
+// startTCPServer starts a gRPC server on a local TCP port, so that its connections use file descriptors
+func startTCPServer(t *testing.T, register func(srv *grpc.Server)) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+
+	srv := grpc.NewServer()
+	register(srv)
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	t.Cleanup(srv.Stop)
+
+	return lis.Addr().String()
+}
+
+// openFDs returns the number of open file descriptors of the test process
+func openFDs(t *testing.T) int {
+	entries, err := os.ReadDir("/proc/self/fd")
+	if err != nil {
+		t.Skipf("Cannot count file descriptors: %v", err)
+	}
+
+	return len(entries)
+}
+
+func Test_connPool(t *testing.T) {
+	var dials int
+
+	_, opts := startMockInstances(t, "cm-0:50054", "cm-1:50054")
+	p := newConnPool(func(address string) (*grpc.ClientConn, error) {
+		dials++
+		return grpc.Dial(address, opts...)
+	})
+
+	// Connections are re-used per address
+	c1, err := p.get("cm-0:50054", "service-1")
+	assert.NoError(t, err)
+	c2, err := p.get("cm-0:50054", "service-2")
+	assert.NoError(t, err)
+	assert.Same(t, c1, c2)
+	c3, err := p.get("cm-1:50054", "service-1")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, dials)
+
+	// Connections are closed, once they have no owner anymore
+	p.release("service-1")
+	assert.Len(t, p.conns, 1)
+	assert.NotEqual(t, connectivity.Shutdown, c1.GetState())
+	assert.Equal(t, connectivity.Shutdown, c3.GetState())
+
+	// A connection that has been shut down is replaced
+	assert.NoError(t, c1.Close())
+	c4, err := p.get("cm-0:50054", "service-1")
+	assert.NoError(t, err)
+	assert.NotSame(t, c1, c4)
+	assert.Equal(t, 3, dials)
+
+	// Removed addresses are closed regardless of their owners
+	p.remove("cm-0:50054", "cm-1:50054")
+	assert.Empty(t, p.conns)
+	assert.Empty(t, p.owners)
+	assert.Equal(t, connectivity.Shutdown, c4.GetState())
+}
+
+func TestServer_RemoveCollectionModule_closesConnections(t *testing.T) {
+	_, opts := startMockInstances(t, "cm-0:50054", "cm-1:50054")
+	srv := &Server{
+		storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+			_ = s.Create(&collection.CollectionModule{
+				Id:                  "CM1",
+				Address:             "cm-0:50054",
+				AdditionalAddresses: []string{"cm-1:50054"},
+			})
+		}),
+		grpcOpts: opts,
+	}
+
+	_, err := srv.pool().get("cm-0:50054", "service-1")
+	assert.NoError(t, err)
+	_, err = srv.pool().get("cm-1:50054", "service-2")
+	assert.NoError(t, err)
+	_, err = srv.pool().get("eval:50101", "service-1")
+	assert.NoError(t, err)
+
+	_, err = srv.RemoveCollectionModule(context.Background(),
+		&configuration.RemoveCollectionModuleRequest{ModuleId: "CM1"})
+	assert.NoError(t, err)
+
+	// Only the connection to the evaluation manager is left
+	assert.Len(t, srv.pool().conns, 1)
+	assert.Contains(t, srv.pool().conns, "eval:50101")
+}
+
+// TestServer_connectionSoak runs many scheduled collections and compliance calculations and checks that the number of
+// open file descriptors stays flat, i.e., that connections are re-used instead of being leaked.
+func TestServer_connectionSoak(t *testing.T) {
+	var (
+		instance   = new(mockInstance)
+		evalServer = new(mockEvaluationServer)
+		runs       = 200
+	)
+
+	if testing.Short() {
+		runs = 20
+	}
+
+	cmAddress := startTCPServer(t, func(srv *grpc.Server) {
+		collection.RegisterCollectionServer(srv, instance)
+	})
+	evalAddress := startTCPServer(t, func(srv *grpc.Server) {
+		evaluation.RegisterEvaluationServer(srv, evalServer)
+	})
+
+	module := &collection.CollectionModule{Id: "CM1", Name: "Mock", Address: cmAddress}
+	scheduler := gocron.NewScheduler(time.UTC)
+	scheduler.StartAsync()
+
+	srv := &Server{
+		storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+			_ = s.Create(module)
+		}),
+		evalManagerAddress: evalAddress,
+		grpcOpts:           []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())},
+		monitoring:         map[string]*MonitorScheduler{"service-1": {state: monitorRunning, scheduler: scheduler}},
+	}
+
+	before := openFDs(t)
+
+	// The first run establishes the connections
+	srv.startCollectionModule(context.Background(), module, "service-1", "")
+	srv.triggerComplianceCalculation(context.Background(), "service-1", []string{"C1"})
+	warm := openFDs(t)
+
+	for i := 0; i < runs; i++ {
+		srv.startCollectionModule(context.Background(), module, "service-1", "")
+		srv.triggerComplianceCalculation(context.Background(), "service-1", []string{"C1"})
+	}
+
+	assert.Len(t, instance.services, runs+1)
+	assert.Equal(t, int64(runs+1), evalServer.calculations.Load())
+	assert.InDelta(t, warm, openFDs(t), 2, "file descriptors are leaking")
+
+	// Stopping the monitoring closes all connections of the service
+	_, err := srv.StopMonitoring(context.Background(), &configuration.StopMonitoringRequest{ServiceId: "service-1"})
+	assert.NoError(t, err)
+	assert.Empty(t, srv.pool().conns)
+
+	// A compliance calculation, which is triggered after stopping, e.g., by a pending debounce window, does not open a
+	// connection again
+	err = srv.triggerComplianceCalculation(context.Background(), "service-1", []string{"C1"})
+	assert.ErrorIs(t, err, errNotMonitored)
+	srv.debounced("service-1", "M1", 1)
+	assert.Empty(t, srv.pool().conns)
+	assert.Equal(t, int64(runs+1), evalServer.calculations.Load())
+
+	assert.Eventually(t, func() bool {
+		return openFDs(t) <= before+1
+	}, 5*time.Second, 50*time.Millisecond)
+}
@@ -0,0 +1,196 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package configuration
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"clouditor.io/clouditor/persistence"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eclipse-xfsc/cam/api/collection"
+	"github.com/eclipse-xfsc/cam/api/configuration"
+)
+
+// DefaultLeaseTTL is the default duration of the lease of a self-registered collection module
+const DefaultLeaseTTL = 30 * time.Second
+
+// RegisterCollectionModule registers an instance of a collection module, which announces itself. The collection module
+// is added or updated, e.g., if an instance has been restarted with another address. Instances of the collection
+// module with other addresses are kept, unless their lease has expired. Like in AddCollectionModule, a collection
+// module that does not support its registration is rejected.
+func (srv *Server) RegisterCollectionModule(ctx context.Context, req *configuration.RegisterCollectionModuleRequest) (
+	res *configuration.CollectionModuleLease, err error) {
+	var existing collection.CollectionModule
+
+	// Validate request
+	if err = req.Validate(); err != nil {
+		err = status.Error(codes.InvalidArgument, err.Error())
+		return
+	}
+
+	module := req.Module
+
+	h := srv.checkCollectionModule(ctx, module)
+	if h.incompatible {
+		err = status.Errorf(codes.InvalidArgument, "collection module does not match registration: %s", h.message)
+		return
+	}
+
+	err = srv.storage.Get(&existing, "id = ?", module.Id)
+	if err == nil {
+		// Keep the other instances of the collection module and check their health as well
+		others := srv.otherInstances(&existing, module)
+		if len(others) > 0 {
+			module.AdditionalAddresses = append(module.AdditionalAddresses, others...)
+			h = srv.checkCollectionModule(ctx, module)
+		}
+	} else if !errors.Is(err, persistence.ErrRecordNotFound) {
+		err = status.Errorf(codes.Internal, "DB error: %v", err)
+		return
+	}
+
+	// Replace an existing registration. We do not use Save here, since it would also overwrite the associated metrics.
+	err = srv.storage.Delete(&collection.CollectionModule{}, "id = ?", module.Id)
+	if err != nil && !errors.Is(err, persistence.ErrRecordNotFound) {
+		err = status.Errorf(codes.Internal, "DB error: %v", err)
+		return
+	}
+
+	err = srv.storage.Create(module)
+	if err != nil {
+		err = status.Errorf(codes.Internal, "DB error: %v", err)
+		return
+	}
+
+	srv.setHealth(module, h)
+	res = srv.renewLease(module.Id, module.Address, true)
+
+	log.Infof("Registered collection module `%s` (address: %s, lease expires at %s)", module.Name,
+		module.Address, res.ExpiresAt.AsTime().Format(time.RFC3339))
+
+	return
+}
+
+// RenewCollectionModuleLease renews the lease of an instance of a self-registered collection module. If there is no
+// lease for the instance, e.g., because the Requirements Manager has been restarted, it needs to register again.
+func (srv *Server) RenewCollectionModuleLease(_ context.Context, req *configuration.RenewCollectionModuleLeaseRequest) (
+	res *configuration.CollectionModuleLease, err error) {
+	if req.GetModuleId() == "" {
+		err = status.Error(codes.InvalidArgument, configuration.ErrCollectionModuleIDMissing.Error())
+		return
+	}
+
+	res = srv.renewLease(req.ModuleId, req.Address, false)
+	if res == nil {
+		err = status.Errorf(codes.NotFound, "no lease for collection module %s, it needs to register again",
+			req.ModuleId)
+	}
+
+	return
+}
+
+// renewLease extends the lease of an instance of a collection module by the lease TTL. If address is empty, the
+// leases of all instances are renewed. If create is false, only existing leases are renewed and nil is returned for
+// instances without a lease.
+func (srv *Server) renewLease(id string, address string, create bool) *configuration.CollectionModuleLease {
+	srv.healthMutex.Lock()
+	defer srv.healthMutex.Unlock()
+
+	if srv.leases == nil {
+		srv.leases = make(map[string]map[string]time.Time)
+	}
+	if srv.leases[id] == nil {
+		srv.leases[id] = make(map[string]time.Time)
+	}
+
+	var addresses []string
+	if address != "" {
+		if _, ok := srv.leases[id][address]; ok || create {
+			addresses = append(addresses, address)
+		}
+	} else {
+		for a := range srv.leases[id] {
+			addresses = append(addresses, a)
+		}
+	}
+
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	ttl := srv.leaseTTL
+	if ttl <= 0 {
+		ttl = DefaultLeaseTTL
+	}
+
+	expiry := time.Now().Add(ttl)
+	for _, a := range addresses {
+		// An expired lease becomes available again
+		if prev, ok := srv.leases[id][a]; ok && time.Now().After(prev) {
+			log.Infof("Instance %s of collection module %s renewed its expired lease", a, id)
+		}
+
+		srv.leases[id][a] = expiry
+	}
+
+	return &configuration.CollectionModuleLease{
+		ModuleId:  id,
+		ExpiresAt: timestamppb.New(expiry),
+		Ttl:       int64(ttl.Seconds()),
+	}
+}
+
+// otherInstances returns the addresses of the instances of the existing collection module, which are not part of the
+// registered module. Instances with an expired lease are left out and their lease is removed.
+func (srv *Server) otherInstances(existing *collection.CollectionModule, module *collection.CollectionModule) (
+	addresses []string) {
+	srv.healthMutex.Lock()
+	defer srv.healthMutex.Unlock()
+
+	for _, a := range existing.Endpoints() {
+		if slices.Contains(module.Endpoints(), a) || slices.Contains(addresses, a) {
+			continue
+		}
+
+		if expiry, ok := srv.leases[existing.Id][a]; ok && time.Now().After(expiry) {
+			log.Infof("Removing instance %s of collection module `%s`, since its lease has expired", a,
+				existing.Name)
+			delete(srv.leases[existing.Id], a)
+			srv.pool().remove(a)
+			continue
+		}
+
+		addresses = append(addresses, a)
+	}
+
+	return
+}
+
+// available returns whether a collection module can be used for collecting evidences. A collection module is not
+// available, if its lease has expired or if it was unreachable during the last health check. Collection modules, which
+// have not been checked yet, are considered to be available.
+func (srv *Server) available(cm *collection.CollectionModule) bool {
+	srv.applyHealth(cm)
+
+	return cm.Status != collection.CollectionModule_STATUS_UNREACHABLE &&
+		cm.Status != collection.CollectionModule_STATUS_UNAVAILABLE
+}
@@ -0,0 +1,147 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package configuration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/eclipse-xfsc/cam/api/collection"
+	"github.com/eclipse-xfsc/cam/api/configuration"
+	"github.com/eclipse-xfsc/cam/internal/protobuf"
+	"github.com/eclipse-xfsc/cam/internal/testutil"
+)
+
+func TestServer_RegisterCollectionModule(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      *configuration.RegisterCollectionModuleRequest
+		describe *collection.DescribeResponse
+		wantCode codes.Code
+	}{
+		{
+			name: "Compatible module",
+			req:  &configuration.RegisterCollectionModuleRequest{Module: newMockModule()},
+			describe: &collection.DescribeResponse{
+				MetricIds:            []string{"TlsVersion", "TlsCipherSuite"},
+				ConfigMessageTypeUrl: protobuf.TypeURL(&collection.CommunicationSecurityConfig{}),
+			},
+		},
+		{
+			name: "Incompatible module",
+			req:  &configuration.RegisterCollectionModuleRequest{Module: newMockModule()},
+			describe: &collection.DescribeResponse{
+				ConfigMessageTypeUrl: protobuf.TypeURL(&collection.CommunicationSecurityConfig{}),
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Missing ID",
+			req:      &configuration.RegisterCollectionModuleRequest{Module: &collection.CollectionModule{Address: "cm:1"}},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := &Server{
+				storage:  testutil.NewInMemoryStorage(t),
+				grpcOpts: startMockCollection(t, tt.describe, false),
+				leaseTTL: time.Minute,
+			}
+
+			res, err := srv.RegisterCollectionModule(context.Background(), tt.req)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if err != nil {
+				assert.Empty(t, srv.leases)
+				return
+			}
+
+			assert.Equal(t, "CM1", res.ModuleId)
+			assert.Equal(t, int64(60), res.Ttl)
+			assert.WithinDuration(t, time.Now().Add(time.Minute), res.ExpiresAt.AsTime(), 5*time.Second)
+
+			// Another instance of the module is added to the existing module
+			module := newMockModule()
+			module.Address = "127.0.0.1:2"
+			_, err = srv.RegisterCollectionModule(context.Background(),
+				&configuration.RegisterCollectionModuleRequest{Module: module})
+			assert.NoError(t, err)
+
+			list, err := srv.ListCollectionModules(context.Background(), &configuration.ListCollectionModulesRequest{})
+			assert.NoError(t, err)
+			assert.Len(t, list.Modules, 1)
+			assert.Equal(t, []string{"127.0.0.1:2", "127.0.0.1:1"}, list.Modules[0].Endpoints())
+			assert.Len(t, list.Modules[0].Metrics, 2)
+			assert.NotNil(t, list.Modules[0].LeaseExpiresAt)
+
+			// An instance with an expired lease is replaced, e.g., if it has been restarted with another address
+			srv.leases["CM1"]["127.0.0.1:1"] = time.Now().Add(-time.Second)
+			module = newMockModule()
+			module.Address = "127.0.0.1:3"
+			_, err = srv.RegisterCollectionModule(context.Background(),
+				&configuration.RegisterCollectionModuleRequest{Module: module})
+			assert.NoError(t, err)
+
+			list, err = srv.ListCollectionModules(context.Background(), &configuration.ListCollectionModulesRequest{})
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"127.0.0.1:3", "127.0.0.1:2"}, list.Modules[0].Endpoints())
+			assert.NotContains(t, srv.leases["CM1"], "127.0.0.1:1")
+		})
+	}
+}
+
+func TestServer_RenewCollectionModuleLease(t *testing.T) {
+	srv := &Server{
+		storage:  testutil.NewInMemoryStorage(t),
+		grpcOpts: startMockCollection(t, nil, false),
+		leaseTTL: time.Minute,
+	}
+
+	// Unknown modules need to register
+	_, err := srv.RenewCollectionModuleLease(context.Background(),
+		&configuration.RenewCollectionModuleLeaseRequest{ModuleId: "CM1"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = srv.RenewCollectionModuleLease(context.Background(), &configuration.RenewCollectionModuleLeaseRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// An unreachable module can register, since it might not be ready to serve yet
+	_, err = srv.RegisterCollectionModule(context.Background(),
+		&configuration.RegisterCollectionModuleRequest{Module: newMockModule()})
+	assert.NoError(t, err)
+
+	// Let the lease expire
+	srv.leases["CM1"]["127.0.0.1:1"] = time.Now().Add(-time.Second)
+	srv.setHealth(newMockModule(), &moduleHealth{status: collection.CollectionModule_STATUS_HEALTHY, checked: time.Now()})
+
+	list, err := srv.ListCollectionModules(context.Background(), &configuration.ListCollectionModulesRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, collection.CollectionModule_STATUS_UNAVAILABLE, list.Modules[0].Status)
+	assert.Contains(t, list.Modules[0].StatusMessage, "lease expired")
+	assert.False(t, srv.available(newMockModule()))
+
+	// Renewing the lease makes the module available again
+	res, err := srv.RenewCollectionModuleLease(context.Background(),
+		&configuration.RenewCollectionModuleLeaseRequest{ModuleId: "CM1", Address: "127.0.0.1:1"})
+	assert.NoError(t, err)
+	assert.True(t, res.ExpiresAt.AsTime().After(time.Now()))
+	assert.True(t, srv.available(newMockModule()))
+}
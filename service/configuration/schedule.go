@@ -0,0 +1,238 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package configuration
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/go-co-op/gocron"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eclipse-xfsc/cam/api/collection"
+	"github.com/eclipse-xfsc/cam/api/configuration"
+	"github.com/eclipse-xfsc/cam/internal/tracing"
+)
+
+// DefaultMaxJitter is the default maximum random delay of a collection run. The delay of a job is further limited to
+// a tenth of its interval.
+const DefaultMaxJitter = 10 * time.Second
+
+// monitoringJob is a scheduled job, which triggers a collection module for a service
+type monitoringJob struct {
+	module    *collection.CollectionModule
+	serviceID string
+
+	// metricIDs contains the monitored metrics the collection module collects evidences for
+	metricIDs []string
+
+	schedule *configuration.CollectionSchedule
+	jitter   time.Duration
+
+	job *gocron.Job
+
+	// stopped is closed, once the job is removed or the monitoring is stopped
+	stopped  chan struct{}
+	stopOnce sync.Once
+
+	// mutex protects the metric IDs, the result of the last run and the evidence count
+	mutex               sync.Mutex
+	lastError           error
+	consecutiveFailures int64
+
+	// runID identifies the current run, for which the evidences are counted
+	runID     string
+	evidences int64
+}
+
+// newMonitoringJob creates a job, which triggers the collection module for the service. If there is a schedule for the
+// collection module (or for all collection modules) in schedules, it is used. Otherwise, the collection module is
+// scheduled at the smallest interval of the monitored metrics it collects evidences for.
+func (srv *Server) newMonitoringJob(cm *collection.CollectionModule, serviceID string, monitoredMetricIDs []string,
+	schedules []*configuration.CollectionSchedule) (j *monitoringJob) {
+	j = &monitoringJob{module: cm, serviceID: serviceID, stopped: make(chan struct{})}
+
+	for _, metric := range cm.Metrics {
+		if slices.Contains(monitoredMetricIDs, metric.Id) {
+			j.metricIDs = append(j.metricIDs, metric.Id)
+		}
+	}
+
+	j.schedule = scheduleFor(cm.Id, schedules)
+	if j.schedule == nil {
+		j.schedule = &configuration.CollectionSchedule{
+			ModuleId: cm.Id,
+			Interval: int64(srv.calculateInterval(j.metricIDs)),
+		}
+	}
+	if j.schedule.Cron == "" && j.schedule.Interval <= 0 {
+		j.schedule.Interval = DefaultInterval
+	}
+
+	return
+}
+
+// scheduleCollection adds the job to the scheduler
+func (srv *Server) scheduleCollection(scheduler *gocron.Scheduler, j *monitoringJob) (err error) {
+	var responsibleMetricIDs []string
+
+	for _, metric := range j.module.Metrics {
+		responsibleMetricIDs = append(responsibleMetricIDs, metric.Id)
+	}
+
+	// We tag the job with the metric IDs this collection module feels "responsible" for, so that it can be re-triggered
+	// if the configuration of one of these metrics changes
+	scheduler = scheduler.Tag(triggerCollectionModuleTag).Tag(responsibleMetricIDs...)
+	if j.schedule.Cron != "" {
+		j.jitter = srv.maxJitter
+		scheduler = scheduler.Cron(j.schedule.Cron)
+	} else {
+		interval := time.Duration(j.schedule.Interval) * time.Second
+		j.jitter = srv.maxJitter
+		if j.jitter > interval/10 {
+			j.jitter = interval / 10
+		}
+		scheduler = scheduler.Every(interval)
+	}
+
+	j.job, err = scheduler.Do(srv.runCollection, j)
+
+	return
+}
+
+// scheduleFor returns the schedule for the collection module. A schedule for the specific collection module takes
+// precedence over a schedule for all collection modules.
+func scheduleFor(moduleID string, schedules []*configuration.CollectionSchedule) (schedule *configuration.CollectionSchedule) {
+	for _, s := range schedules {
+		if s.ModuleId == moduleID {
+			return &configuration.CollectionSchedule{ModuleId: moduleID, Interval: s.Interval, Cron: s.Cron}
+		} else if s.ModuleId == "" {
+			schedule = &configuration.CollectionSchedule{ModuleId: moduleID, Interval: s.Interval, Cron: s.Cron}
+		}
+	}
+
+	return
+}
+
+// runCollection triggers the collection module of the job after a random delay of at most the jitter of the job, so
+// that the collection modules are not triggered for all services at the same time. Each run starts a new trace, which
+// the collection module and the Evaluation Manager continue for the evidences of the run.
+func (srv *Server) runCollection(j *monitoringJob) {
+	if j.jitter > 0 {
+		select {
+		case <-time.After(time.Duration(rand.Int63n(int64(j.jitter)))):
+		case <-j.stopped:
+			return
+		}
+	}
+
+	// Evidences are counted per run. The collection module sets the ID of the run in its evidences, so that evidences
+	// of the previous run, which are reported late, are not counted for this run.
+	runID := uuid.NewString()
+	j.mutex.Lock()
+	j.runID = runID
+	j.evidences = 0
+	j.mutex.Unlock()
+
+	ctx, span := tracing.Start(context.Background(), "configuration.RunCollection", trace.WithAttributes(
+		attribute.String("service.id", j.serviceID), attribute.String("collection_module.id", j.module.Id)))
+	err := srv.startCollectionModule(ctx, j.module, j.serviceID, runID)
+	tracing.End(span, err)
+
+	collectionRuns.WithLabelValues(j.module.Id).Inc()
+	if err != nil {
+		collectionRunsFailed.WithLabelValues(j.module.Id).Inc()
+	}
+
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	j.lastError = err
+	if err != nil {
+		j.consecutiveFailures++
+	} else {
+		j.consecutiveFailures = 0
+	}
+}
+
+// setMetricIDs sets the monitored metrics the collection module collects evidences for
+func (j *monitoringJob) setMetricIDs(metricIDs []string) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	j.metricIDs = metricIDs
+}
+
+// addEvidences adds the number of evidences received from the collection module in the run to the evidence count.
+// Evidences of other runs are ignored, evidences without a run are counted for the current run.
+func (j *monitoringJob) addEvidences(runID string, count int64) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	if runID != "" && runID != j.runID {
+		return
+	}
+
+	j.evidences += count
+}
+
+// stop cancels a delayed run of the job, so that stopping the scheduler does not need to wait for it
+func (j *monitoringJob) stop() {
+	j.stopOnce.Do(func() {
+		close(j.stopped)
+	})
+}
+
+// status returns the status of the job. The next run is only set, if the monitoring is running.
+func (j *monitoringJob) status(running bool) (s *configuration.MonitoringJob) {
+	s = &configuration.MonitoringJob{
+		ModuleId:   j.module.Id,
+		ModuleName: j.module.Name,
+		Schedule:   j.schedule,
+		Jitter:     int64(j.jitter.Seconds()),
+	}
+
+	j.mutex.Lock()
+	s.MetricIds = j.metricIDs
+	if j.lastError != nil {
+		s.LastError = j.lastError.Error()
+	}
+	s.ConsecutiveFailures = j.consecutiveFailures
+	s.EvidenceCount = j.evidences
+	j.mutex.Unlock()
+
+	if !j.job.LastRun().IsZero() {
+		s.LastRun = timestamppb.New(j.job.LastRun())
+	}
+	if running {
+		s.NextRun = timestamppb.New(j.job.NextRun())
+	}
+
+	return
+}
+
+// triggerJobs returns the jobs of the scheduler, which trigger collection modules
+func (m *MonitorScheduler) triggerJobs() (jobs []*gocron.Job) {
+	// An error only indicates that there are no jobs
+	jobs, _ = m.scheduler.FindJobsByTag(triggerCollectionModuleTag)
+	return
+}
@@ -0,0 +1,219 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package configuration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/persistence"
+	"github.com/go-co-op/gocron"
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/eclipse-xfsc/cam/api/collection"
+	"github.com/eclipse-xfsc/cam/api/configuration"
+	"github.com/eclipse-xfsc/cam/internal/testutil"
+)
+
+func Test_scheduleFor(t *testing.T) {
+	schedules := []*configuration.CollectionSchedule{
+		{Interval: 600},
+		{ModuleId: "Module-1", Cron: "0 * * * *"},
+	}
+
+	assert.Equal(t, "0 * * * *", scheduleFor("Module-1", schedules).Cron)
+	assert.Equal(t, int64(600), scheduleFor("Module-2", schedules).Interval)
+	assert.Equal(t, "Module-2", scheduleFor("Module-2", schedules).ModuleId)
+	assert.Nil(t, scheduleFor("Module-1", nil))
+}
+
+func TestServer_StartMonitoring_schedules(t *testing.T) {
+	type want struct {
+		interval int64
+		cron     string
+		jitter   int64
+	}
+	tests := []struct {
+		name      string
+		schedules []*configuration.CollectionSchedule
+		maxJitter time.Duration
+		want      map[string]want
+		wantCode  codes.Code
+	}{
+		{
+			name:      "Intervals of the metrics",
+			maxJitter: 10 * time.Second,
+			want: map[string]want{
+				// Metric-1 has an interval of 60 seconds, which limits the jitter to 6 seconds
+				"Module-1": {interval: 60, jitter: 6},
+				// Metric-2 has no interval, so the interval of the server is used
+				"Module-2": {interval: 300, jitter: 10},
+			},
+		},
+		{
+			name: "Overrides for the service",
+			schedules: []*configuration.CollectionSchedule{
+				{Interval: 3600},
+				{ModuleId: "Module-1", Cron: "*/5 * * * *"},
+			},
+			want: map[string]want{
+				"Module-1": {cron: "*/5 * * * *"},
+				"Module-2": {interval: 3600},
+			},
+		},
+		{
+			name:      "Invalid cron expression",
+			schedules: []*configuration.CollectionSchedule{{ModuleId: "Module-1", Cron: "every day"}},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "Negative interval",
+			schedules: []*configuration.CollectionSchedule{{Interval: -1}},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "Duplicate schedule",
+			schedules: []*configuration.CollectionSchedule{{Interval: 60}, {Interval: 120}},
+			wantCode:  codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := &Server{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, populateStorage(t, s))
+				}),
+				interval:   300,
+				maxJitter:  tt.maxJitter,
+				monitoring: make(map[string]*MonitorScheduler),
+			}
+
+			res, err := srv.StartMonitoring(context.Background(), &configuration.StartMonitoringRequest{
+				ServiceId:  "myService",
+				ControlIds: []string{"Req-1"},
+				Schedules:  tt.schedules,
+			})
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if err != nil {
+				assert.Nil(t, srv.monitoring["myService"])
+				return
+			}
+			defer func() {
+				_, err = srv.StopMonitoring(context.Background(),
+					&configuration.StopMonitoringRequest{ServiceId: "myService"})
+				assert.NoError(t, err)
+			}()
+
+			assert.Len(t, res.Status.Jobs, len(tt.want))
+			for _, job := range res.Status.Jobs {
+				w := tt.want[job.ModuleId]
+				assert.Equal(t, w.interval, job.Schedule.Interval, job.ModuleId)
+				assert.Equal(t, w.cron, job.Schedule.Cron, job.ModuleId)
+				assert.Equal(t, w.jitter, job.Jitter, job.ModuleId)
+				assert.NotNil(t, job.NextRun)
+				assert.Len(t, job.MetricIds, 1)
+			}
+		})
+	}
+}
+
+func TestServer_runCollection_status(t *testing.T) {
+	var (
+		module = &collection.CollectionModule{Id: "CM1", Name: "Mock", Address: "cm-0:50054"}
+		ctx    = context.Background()
+	)
+
+	_, opts := startMockInstances(t, "cm-0:50054")
+	scheduler := gocron.NewScheduler(time.UTC)
+	srv := &Server{
+		storage:  testutil.NewInMemoryStorage(t),
+		grpcOpts: opts,
+	}
+
+	recorder := testutil.RecordSpans(t)
+
+	j := srv.newMonitoringJob(module, "service-1", nil, nil)
+	assert.NoError(t, srv.scheduleCollection(scheduler, j))
+	srv.monitoring = map[string]*MonitorScheduler{"service-1": {scheduler: scheduler, jobs: []*monitoringJob{j}}}
+
+	runs, failed := promtestutil.ToFloat64(collectionRuns.WithLabelValues("CM1")), promtestutil.ToFloat64(collectionRunsFailed.WithLabelValues("CM1"))
+
+	// The collection module does not exist (anymore), so each run fails
+	srv.runCollection(j)
+	srv.runCollection(j)
+	s := j.status(false)
+	assert.Equal(t, "collection module has been removed", s.LastError)
+	assert.Equal(t, int64(2), s.ConsecutiveFailures)
+	assert.Equal(t, runs+2, promtestutil.ToFloat64(collectionRuns.WithLabelValues("CM1")))
+	assert.Equal(t, failed+2, promtestutil.ToFloat64(collectionRunsFailed.WithLabelValues("CM1")))
+
+	// Evidences received from the collection module are added to its job
+	_, err := srv.ReportEvidences(ctx, &configuration.ReportEvidencesRequest{
+		ServiceId: "service-1", ModuleId: "CM1", Count: 3})
+	assert.NoError(t, err)
+	_, err = srv.ReportEvidences(ctx, &configuration.ReportEvidencesRequest{
+		ServiceId: "service-1", ModuleId: "CM1", Count: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), j.status(false).EvidenceCount)
+
+	// Reports for other services or collection modules are ignored
+	_, err = srv.ReportEvidences(ctx, &configuration.ReportEvidencesRequest{
+		ServiceId: "service-2", ModuleId: "CM1", Count: 1})
+	assert.NoError(t, err)
+	_, err = srv.ReportEvidences(ctx, &configuration.ReportEvidencesRequest{
+		ServiceId: "service-1", ModuleId: "CM2", Count: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), j.status(false).EvidenceCount)
+
+	_, err = srv.ReportEvidences(ctx, &configuration.ReportEvidencesRequest{ServiceId: "service-1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// A successful run resets the failures and the evidence count
+	previousRun := j.runID
+	assert.NoError(t, srv.storage.Create(module))
+	srv.runCollection(j)
+	s = j.status(false)
+	assert.Empty(t, s.LastError)
+	assert.Zero(t, s.ConsecutiveFailures)
+	assert.Zero(t, s.EvidenceCount)
+	assert.NotEmpty(t, j.runID)
+	assert.NotEqual(t, previousRun, j.runID)
+
+	// Late reports of a previous run are not counted for the current run
+	_, err = srv.ReportEvidences(ctx, &configuration.ReportEvidencesRequest{
+		ServiceId: "service-1", ModuleId: "CM1", Count: 4, RunId: previousRun})
+	assert.NoError(t, err)
+	assert.Zero(t, j.status(false).EvidenceCount)
+	_, err = srv.ReportEvidences(ctx, &configuration.ReportEvidencesRequest{
+		ServiceId: "service-1", ModuleId: "CM1", Count: 1, RunId: j.runID})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), j.status(false).EvidenceCount)
+	assert.Equal(t, runs+3, promtestutil.ToFloat64(collectionRuns.WithLabelValues("CM1")))
+	assert.Equal(t, failed+2, promtestutil.ToFloat64(collectionRunsFailed.WithLabelValues("CM1")))
+
+	// Each run is traced
+	spans := testutil.FindSpans(recorder, "configuration.RunCollection")
+	assert.Len(t, spans, 3)
+	assert.Equal(t, "collection module has been removed", spans[0].Status().Description)
+	assert.Equal(t, otelcodes.Unset, spans[2].Status().Code)
+	assert.Equal(t, "service-1", testutil.SpanAttribute(spans[2], "service.id").AsString())
+	assert.Equal(t, "CM1", testutil.SpanAttribute(spans[2], "collection_module.id").AsString())
+}
@@ -0,0 +1,258 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package configuration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"clouditor.io/clouditor/persistence"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/eclipse-xfsc/cam/api/collection"
+	"github.com/eclipse-xfsc/cam/internal/secrets"
+	"github.com/eclipse-xfsc/cam/service"
+)
+
+const (
+	// SecretsScope is the scope, which allows the caller to retrieve the secrets of service configurations in
+	// plaintext. Without it, the secrets are redacted.
+	SecretsScope = "cam:configuration:secrets"
+
+	// RedactedSecret replaces the secrets in API responses. If a service configuration containing it is configured
+	// again, the stored secret is kept.
+	RedactedSecret = "[REDACTED]"
+)
+
+// WithKeyProvider is an option to encrypt the secrets of service configurations at rest using the key provider. If it
+// is not set, secrets are stored in plaintext.
+func WithKeyProvider(kp secrets.KeyProvider) service.ServiceOption[Server] {
+	return func(s *Server) {
+		s.keyProvider = kp
+	}
+}
+
+// secretFieldsFunc is called with the secret fields of a configuration message. The values can be replaced in place.
+type secretFieldsFunc func(fields map[string]*string) error
+
+// transformSecrets unmarshals the raw configuration, calls f with its secret fields and replaces the raw
+// configuration, if f changed them. Configurations without secrets or of unknown types are left as they are.
+func transformSecrets(c *collection.ServiceConfiguration, f secretFieldsFunc) (changed bool, err error) {
+	if c.GetRawConfiguration() == nil {
+		return false, nil
+	}
+
+	msg, err := c.RawConfiguration.UnmarshalNew()
+	if errors.Is(err, protoregistry.NotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("%s: %w", collection.ErrInvalidServiceConfiguration, err)
+	}
+
+	holder, ok := msg.(collection.SecretHolder)
+	if !ok {
+		return false, nil
+	}
+
+	fields := holder.SecretFields()
+	if len(fields) == 0 {
+		return false, nil
+	}
+
+	before := proto.Clone(msg)
+	if err = f(fields); err != nil {
+		return false, err
+	}
+	if proto.Equal(before, msg) {
+		return false, nil
+	}
+
+	raw, err := anypb.New(msg)
+	if err != nil {
+		return false, fmt.Errorf("could not marshal configuration: %w", err)
+	}
+	c.RawConfiguration = raw
+
+	return true, nil
+}
+
+// encryptSecrets encrypts the secrets of the service configuration, which are not yet encrypted with the current key.
+// Secrets of previous keys are re-encrypted, which completes a key rotation.
+func (srv *Server) encryptSecrets(ctx context.Context, c *collection.ServiceConfiguration) (bool, error) {
+	if srv.keyProvider == nil {
+		return false, nil
+	}
+
+	return transformSecrets(c, func(fields map[string]*string) (err error) {
+		for name, value := range fields {
+			// References to external secrets are resolved by the collection modules and need no encryption
+			if secrets.KeyID(*value) == srv.keyProvider.CurrentKeyID() || collection.IsSecretRef(*value) {
+				continue
+			}
+
+			plaintext, err := secrets.Decrypt(ctx, srv.keyProvider, *value)
+			if err != nil {
+				return fmt.Errorf("could not decrypt %s: %w", name, err)
+			}
+
+			if *value, err = secrets.Encrypt(ctx, srv.keyProvider, plaintext); err != nil {
+				return fmt.Errorf("could not encrypt %s: %w", name, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// decryptSecrets decrypts the secrets of the service configuration
+func (srv *Server) decryptSecrets(ctx context.Context, c *collection.ServiceConfiguration) error {
+	_, err := transformSecrets(c, func(fields map[string]*string) (err error) {
+		for name, value := range fields {
+			if *value, err = srv.decryptSecret(ctx, *value); err != nil {
+				return fmt.Errorf("could not decrypt %s: %w", name, err)
+			}
+		}
+
+		return nil
+	})
+
+	return err
+}
+
+// restoreSecrets replaces redacted secrets of the service configuration with the stored ones, so that a service
+// configuration retrieved via the API can be configured again without knowing its secrets
+func (srv *Server) restoreSecrets(ctx context.Context, c *collection.ServiceConfiguration) (err error) {
+	var (
+		stored       = new(collection.ServiceConfiguration)
+		storedFields map[string]*string
+	)
+
+	_, err = transformSecrets(c, func(fields map[string]*string) error {
+		for name, value := range fields {
+			if *value != RedactedSecret {
+				continue
+			}
+
+			// Load the stored secrets only, if there are redacted ones
+			if storedFields == nil {
+				err := srv.storage.Get(stored, "service_id = ? AND type_url = ?", c.ServiceId,
+					c.RawConfiguration.TypeUrl)
+				if errors.Is(err, persistence.ErrRecordNotFound) {
+					return fmt.Errorf("%w: %s is redacted, but no secret is stored",
+						collection.ErrMissingConfigParameter, name)
+				} else if err != nil {
+					return fmt.Errorf("could not retrieve stored configuration: %w", err)
+				}
+
+				storedFields = map[string]*string{}
+				_, _ = transformSecrets(stored, func(fields map[string]*string) error {
+					storedFields = fields
+					return nil
+				})
+			}
+
+			secret, ok := storedFields[name]
+			if !ok {
+				return fmt.Errorf("%w: %s is redacted, but no secret is stored",
+					collection.ErrMissingConfigParameter, name)
+			}
+
+			// The stored secret might be encrypted, so it needs to be decrypted to validate the configuration
+			plaintext, err := srv.decryptSecret(ctx, *secret)
+			if err != nil {
+				return fmt.Errorf("could not decrypt %s: %w", name, err)
+			}
+			*value = plaintext
+		}
+
+		return nil
+	})
+
+	return
+}
+
+// decryptSecret decrypts a single secret. Secrets, which are not encrypted, are returned as they are.
+func (srv *Server) decryptSecret(ctx context.Context, value string) (string, error) {
+	if !secrets.IsEncrypted(value) {
+		return value, nil
+	}
+	if srv.keyProvider == nil {
+		return "", errors.New("no key provider configured")
+	}
+
+	return secrets.Decrypt(ctx, srv.keyProvider, value)
+}
+
+// presentSecrets prepares the secrets of a stored service configuration for an API response. They are decrypted, if
+// the caller has the SecretsScope, and redacted otherwise.
+func (srv *Server) presentSecrets(ctx context.Context, c *collection.ServiceConfiguration) error {
+	_, err := transformSecrets(c, func(fields map[string]*string) (err error) {
+		privileged := service.ClaimsFromContext(ctx).HasScope(SecretsScope)
+
+		for name, value := range fields {
+			// References to external secrets are no secrets themselves
+			if collection.IsSecretRef(*value) {
+				continue
+			}
+
+			if !privileged {
+				*value = RedactedSecret
+			} else if *value, err = srv.decryptSecret(ctx, *value); err != nil {
+				return fmt.Errorf("could not decrypt %s: %w", name, err)
+			}
+		}
+
+		return nil
+	})
+
+	return err
+}
+
+// migrateSecrets encrypts the secrets of all stored service configurations, which are stored in plaintext or
+// encrypted with a previous key
+func (srv *Server) migrateSecrets() {
+	var configs []*collection.ServiceConfiguration
+
+	if srv.keyProvider == nil || srv.storage == nil {
+		return
+	}
+
+	if err := srv.storage.List(&configs, "", true, 0, -1); err != nil {
+		log.Errorf("Could not list service configurations to encrypt their secrets: %v", err)
+		return
+	}
+
+	for _, c := range configs {
+		changed, err := srv.encryptSecrets(context.Background(), c)
+		if err != nil {
+			log.Errorf("Could not encrypt secrets of configuration %s of service %s: %v", c.TypeUrl, c.ServiceId, err)
+			continue
+		} else if !changed {
+			continue
+		}
+
+		if err = srv.storage.Save(c, "service_id = ? AND type_url = ?", c.ServiceId, c.TypeUrl); err != nil {
+			log.Errorf("Could not store configuration %s of service %s: %v", c.TypeUrl, c.ServiceId, err)
+			continue
+		}
+
+		log.Infof("Encrypted secrets of configuration %s of service %s with key %s", c.TypeUrl, c.ServiceId,
+			srv.keyProvider.CurrentKeyID())
+	}
+}
@@ -0,0 +1,200 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package configuration
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"clouditor.io/clouditor/api/orchestrator"
+	"clouditor.io/clouditor/persistence"
+	orchestrator2 "clouditor.io/clouditor/service/orchestrator"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/eclipse-xfsc/cam/api/collection"
+	"github.com/eclipse-xfsc/cam/api/configuration"
+	"github.com/eclipse-xfsc/cam/internal/secrets"
+	"github.com/eclipse-xfsc/cam/internal/testutil"
+	"github.com/eclipse-xfsc/cam/internal/testutil/testproto"
+	"github.com/eclipse-xfsc/cam/service"
+)
+
+// storedSecret returns the client secret of the stored authentication security configuration
+func storedSecret(t *testing.T, s persistence.Storage) string {
+	var c collection.ServiceConfiguration
+
+	assert.NoError(t, s.Get(&c, "service_id = ?", orchestrator2.DefaultTargetCloudServiceId))
+
+	msg, err := c.RawConfiguration.UnmarshalNew()
+	assert.NoError(t, err)
+
+	return msg.(*collection.AuthenticationSecurityConfig).ClientSecret
+}
+
+// listedSecret returns the client secret of the authentication security configuration returned by
+// ListCloudServiceConfigurations
+func listedSecret(t *testing.T, ctx context.Context, srv *Server) string {
+	res, err := srv.ListCloudServiceConfigurations(ctx, &configuration.ListCloudServiceConfigurationsRequest{
+		ServiceId: orchestrator2.DefaultTargetCloudServiceId,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, res.Configurations, 1)
+
+	msg, err := res.Configurations[0].RawConfiguration.UnmarshalNew()
+	assert.NoError(t, err)
+
+	return msg.(*collection.AuthenticationSecurityConfig).ClientSecret
+}
+
+func configureSecret(t *testing.T, srv *Server, secret string) error {
+	_, err := srv.ConfigureCloudService(context.Background(), &configuration.ConfigureCloudServiceRequest{
+		ServiceId: orchestrator2.DefaultTargetCloudServiceId,
+		Configurations: &configuration.Configurations{
+			Configurations: []*collection.ServiceConfiguration{{
+				RawConfiguration: testproto.NewAny(t, &collection.AuthenticationSecurityConfig{
+					Issuer:       "https://issuer.example.com",
+					ClientId:     "client",
+					ClientSecret: secret,
+				}),
+			}},
+		},
+	})
+
+	return err
+}
+
+func TestServer_Secrets(t *testing.T) {
+	var (
+		privileged = service.ContextWithClaims(context.Background(), &service.Claims{Scopes: []string{SecretsScope}})
+		typeURL    = testproto.NewAny(t, &collection.AuthenticationSecurityConfig{}).TypeUrl
+	)
+
+	kp, err := secrets.NewLocalKeyProvider(filepath.Join(t.TempDir(), "keys.json"))
+	assert.NoError(t, err)
+
+	s := testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+		assert.NoError(t, s.Create(&orchestrator.CloudService{Id: orchestrator2.DefaultTargetCloudServiceId}))
+	})
+	srv := &Server{storage: s, keyProvider: kp}
+
+	// Secrets are stored encrypted
+	assert.NoError(t, configureSecret(t, srv, "secret"))
+	assert.True(t, secrets.IsEncrypted(storedSecret(t, s)))
+	assert.Equal(t, kp.CurrentKeyID(), secrets.KeyID(storedSecret(t, s)))
+
+	// Secrets are redacted, unless the caller has the scope
+	assert.Equal(t, RedactedSecret, listedSecret(t, context.Background(), srv))
+	assert.Equal(t, RedactedSecret, listedSecret(t,
+		service.ContextWithClaims(context.Background(), &service.Claims{Scopes: []string{"other"}}), srv))
+	assert.Equal(t, "secret", listedSecret(t, privileged, srv))
+
+	// Collection modules get the decrypted secrets
+	config, err := srv.GetServiceConfiguration(orchestrator2.DefaultTargetCloudServiceId, typeURL)
+	assert.NoError(t, err)
+	msg, err := config.RawConfiguration.UnmarshalNew()
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", msg.(*collection.AuthenticationSecurityConfig).ClientSecret)
+
+	// Configuring the redacted secret keeps the stored one
+	assert.NoError(t, configureSecret(t, srv, RedactedSecret))
+	assert.Equal(t, "secret", listedSecret(t, privileged, srv))
+
+	// Stored secrets are re-encrypted with the new key after a rotation
+	old := storedSecret(t, s)
+	assert.NoError(t, kp.Rotate())
+	srv.migrateSecrets()
+	assert.NotEqual(t, old, storedSecret(t, s))
+	assert.Equal(t, kp.CurrentKeyID(), secrets.KeyID(storedSecret(t, s)))
+	assert.Equal(t, "secret", listedSecret(t, privileged, srv))
+
+	// Without key provider, encrypted secrets cannot be retrieved
+	srv.keyProvider = nil
+	_, err = srv.ListCloudServiceConfigurations(privileged, &configuration.ListCloudServiceConfigurationsRequest{
+		ServiceId: orchestrator2.DefaultTargetCloudServiceId,
+	})
+	assert.Equal(t, codes.Internal, status.Code(err))
+	_, err = srv.GetServiceConfiguration(orchestrator2.DefaultTargetCloudServiceId, typeURL)
+	assert.ErrorContains(t, err, "could not decrypt secrets")
+
+	// The collection is not started without the secrets, so that the error is shown in the status of the job
+	module := &collection.CollectionModule{Id: "CM1", Name: "Mock", ConfigMessageTypeUrl: typeURL}
+	assert.NoError(t, s.Create(module))
+	err = srv.startCollectionModule(context.Background(), module, orchestrator2.DefaultTargetCloudServiceId, "run")
+	assert.ErrorContains(t, err, "could not decrypt secrets")
+	assert.NoError(t, s.Delete(module, "id = ?", module.Id))
+
+	// Without key provider, secrets are stored in plaintext, but still redacted
+	assert.NoError(t, configureSecret(t, srv, "plaintext"))
+	assert.Equal(t, "plaintext", storedSecret(t, s))
+	assert.Equal(t, RedactedSecret, listedSecret(t, context.Background(), srv))
+
+	// Plaintext secrets are encrypted, once a key provider is configured
+	srv.keyProvider = kp
+	srv.migrateSecrets()
+	assert.True(t, secrets.IsEncrypted(storedSecret(t, s)))
+	assert.Equal(t, "plaintext", listedSecret(t, privileged, srv))
+
+	// References to external secrets are neither encrypted nor redacted
+	assert.NoError(t, configureSecret(t, srv, "secretref:env:CLIENT_SECRET"))
+	assert.Equal(t, "secretref:env:CLIENT_SECRET", storedSecret(t, s))
+	assert.Equal(t, "secretref:env:CLIENT_SECRET", listedSecret(t, context.Background(), srv))
+}
+
+func TestServer_restoreSecrets(t *testing.T) {
+	s := testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+		assert.NoError(t, s.Create(&orchestrator.CloudService{Id: orchestrator2.DefaultTargetCloudServiceId}))
+	})
+	srv := &Server{storage: s}
+
+	config := &collection.ServiceConfiguration{
+		ServiceId: orchestrator2.DefaultTargetCloudServiceId,
+		RawConfiguration: testproto.NewAny(t, &collection.WorkloadSecurityConfig{
+			Aws: structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+				"region":          structpb.NewStringValue("eu-central-1"),
+				"accessKeyId":     structpb.NewStringValue("key"),
+				"secretAccessKey": structpb.NewStringValue(RedactedSecret),
+			}}),
+		}),
+	}
+
+	// There is no stored secret to restore
+	err := srv.restoreSecrets(context.Background(), proto.Clone(config).(*collection.ServiceConfiguration))
+	assert.ErrorIs(t, err, collection.ErrMissingConfigParameter)
+	assert.ErrorContains(t, err, "aws.secretAccessKey")
+
+	stored := proto.Clone(config).(*collection.ServiceConfiguration)
+	stored.RawConfiguration = testproto.NewAny(t, &collection.WorkloadSecurityConfig{
+		Aws: structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+			"secretAccessKey": structpb.NewStringValue("secret"),
+		}}),
+	})
+	stored.TypeUrl = stored.RawConfiguration.TypeUrl
+	assert.NoError(t, s.Create(stored))
+
+	assert.NoError(t, srv.restoreSecrets(context.Background(), config))
+
+	msg, err := config.RawConfiguration.UnmarshalNew()
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", msg.(*collection.WorkloadSecurityConfig).Aws.GetStructValue().
+		Fields["secretAccessKey"].GetStringValue())
+	assert.Equal(t, "key", msg.(*collection.WorkloadSecurityConfig).Aws.GetStructValue().
+		Fields["accessKeyId"].GetStringValue())
+}
@@ -16,7 +16,7 @@
 package configuration
 
 import (
-	"sync/atomic"
+	"sync"
 	"time"
 
 	"clouditor.io/clouditor/api"
@@ -26,10 +26,13 @@ import (
 	orchestratorservice "clouditor.io/clouditor/service/orchestrator"
 
 	"github.com/go-co-op/gocron"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/oauth2/clientcredentials"
+	"google.golang.org/grpc"
 
 	"github.com/eclipse-xfsc/cam/api/configuration"
+	"github.com/eclipse-xfsc/cam/internal/secrets"
 	"github.com/eclipse-xfsc/cam/oscal"
 	"github.com/eclipse-xfsc/cam/service"
 )
@@ -68,17 +71,73 @@ type Server struct {
 	// monitoring contains lists of controls (value) per service (key) that are currently monitored
 	monitoring map[string]*MonitorScheduler
 
-	ccw map[string]*complianceCalcWindow
-}
+	// monitoringMutex protects the monitoring map. The fields of each monitoring are protected by its own mutex.
+	monitoringMutex sync.Mutex
+
+	// newDebouncer creates the debouncer of the compliance calculation. Use debounce to access the debouncer.
+	newDebouncer  NewDebouncerFunc
+	debouncer     Debouncer
+	debouncerOnce sync.Once
+
+	// recalculationInterval is the interval, in which the compliance of the monitored controls is recalculated, if it
+	// has not been calculated in the meantime. If it is zero, the compliance is not recalculated.
+	recalculationInterval time.Duration
+
+	// calculations contains the time of the last compliance calculation (value) per service and control (key)
+	calculations      map[calculationKey]time.Time
+	calculationsMutex sync.Mutex
+
+	// grpcOpts contains additional options for dialing the collection modules and the evaluation manager
+	grpcOpts []grpc.DialOption
+
+	// conns contains the connections to the collection modules and the evaluation manager. Use pool to access it.
+	conns     *connPool
+	connsOnce sync.Once
+
+	// maxJitter is the maximum random delay of each collection run
+	maxJitter time.Duration
+
+	// healthCheckInterval is the interval in which the health of all collection modules is checked. If it is zero, the
+	// health is only checked on registration.
+	healthCheckInterval time.Duration
 
-type complianceCalcWindow struct {
-	start   time.Time
-	counter atomic.Int64
+	// healthScheduler periodically checks the health of the collection modules. It is stopped by Close.
+	healthScheduler *gocron.Scheduler
+
+	// registerer is the registry, in which the metrics of the server are registered, if any
+	registerer prometheus.Registerer
+
+	// leaseTTL is the duration of the lease of a self-registered collection module
+	leaseTTL time.Duration
+
+	// health contains the result of the last health check (value) per collection module ID (key)
+	health map[string]*moduleHealth
+
+	// leases contains the expiry of the leases of a self-registered collection module per ID (key). The expiry is
+	// stored per address of each instance of the collection module.
+	leases map[string]map[string]time.Time
+
+	// healthMutex protects health and leases
+	healthMutex sync.RWMutex
+
+	// keyProvider provides the keys to encrypt the secrets of service configurations at rest. If it is nil, the
+	// secrets are stored in plaintext.
+	keyProvider secrets.KeyProvider
+
+	// trustStoreMutex serializes changes to the attestation trust store, so that each change gets its own version
+	trustStoreMutex sync.Mutex
 }
 
 type MonitorScheduler struct {
+	// mutex protects the fields of the monitoring. See monitorState on how concurrent RPCs are handled.
+	mutex sync.Mutex
+	state monitorState
+
 	scheduler         *gocron.Scheduler
 	monitoredControls []string
+
+	// jobs contains the jobs, which trigger the collection modules
+	jobs []*monitoringJob
 }
 
 // WithEvalManagerAddress is a Server option setting the address for the evaluation manager
@@ -95,6 +154,60 @@ func WithInterval(interval int) service.ServiceOption[Server] {
 	}
 }
 
+// WithMaxJitter is a Server option setting the maximum random delay of each collection run. The delay spreads the
+// collection runs of many services, which are monitored at the same interval. Zero disables the delay.
+func WithMaxJitter(jitter time.Duration) service.ServiceOption[Server] {
+	return func(srv *Server) {
+		srv.maxJitter = jitter
+	}
+}
+
+// WithDebouncer is a Server option setting how incoming assessment results are coalesced into compliance
+// calculations. By default, a window debouncer with DefaultDebounceWindow and DefaultDebounceThreshold is used.
+func WithDebouncer(newDebouncer NewDebouncerFunc) service.ServiceOption[Server] {
+	return func(srv *Server) {
+		srv.newDebouncer = newDebouncer
+	}
+}
+
+// WithRecalculationInterval is a Server option setting the interval, in which the compliance of monitored controls is
+// recalculated, if it has not been calculated in the meantime. Zero disables the recalculation.
+func WithRecalculationInterval(interval time.Duration) service.ServiceOption[Server] {
+	return func(srv *Server) {
+		srv.recalculationInterval = interval
+	}
+}
+
+// WithHealthCheckInterval is a Server option setting the interval in which the health of all collection modules is
+// checked. An interval of zero disables the periodic health checks.
+func WithHealthCheckInterval(interval time.Duration) service.ServiceOption[Server] {
+	return func(srv *Server) {
+		srv.healthCheckInterval = interval
+	}
+}
+
+// WithLeaseTTL is a Server option setting the duration of the lease of a self-registered collection module
+func WithLeaseTTL(ttl time.Duration) service.ServiceOption[Server] {
+	return func(srv *Server) {
+		srv.leaseTTL = ttl
+	}
+}
+
+// WithMetricsRegisterer is a Server option to expose the monitored services and controls in the metrics of the
+// registerer, e.g., prometheus.DefaultRegisterer. Without registerer, they are not exposed.
+func WithMetricsRegisterer(registerer prometheus.Registerer) service.ServiceOption[Server] {
+	return func(srv *Server) {
+		srv.registerer = registerer
+	}
+}
+
+// WithAdditionalGRPCOpts is a Server option to configure additional gRPC options for dialing the collection modules
+func WithAdditionalGRPCOpts(opts ...grpc.DialOption) service.ServiceOption[Server] {
+	return func(srv *Server) {
+		srv.grpcOpts = opts
+	}
+}
+
 // WithStorage is an option to set the storage. If not set, NewServer will use inmemory storage.
 func WithStorage(storage persistence.Storage) service.ServiceOption[Server] {
 	return func(srv *Server) {
@@ -119,6 +232,34 @@ func (srv *Server) Authorizer() api.Authorizer {
 	return srv.authorizer
 }
 
+// pool returns the connection pool of the server
+func (srv *Server) pool() *connPool {
+	srv.connsOnce.Do(func() {
+		srv.conns = newConnPool(srv.dial)
+	})
+
+	return srv.conns
+}
+
+// debounce returns the debouncer of the compliance calculation
+func (srv *Server) debounce() Debouncer {
+	srv.debouncerOnce.Do(func() {
+		if srv.newDebouncer == nil {
+			srv.newDebouncer = NewWindowDebouncer(DefaultDebounceWindow, DefaultDebounceThreshold)
+		}
+
+		srv.debouncer = srv.newDebouncer(srv.debounced)
+	})
+
+	return srv.debouncer
+}
+
+// dial creates a connection to a collection module or the evaluation manager. This will make use of our authorizer if
+// it is configured in api.DefaultGrpcDialOptions, because Server is implementing the UsesAuthorizer interface.
+func (srv *Server) dial(address string) (*grpc.ClientConn, error) {
+	return grpc.Dial(address, api.DefaultGrpcDialOptions(address, srv, srv.grpcOpts...)...)
+}
+
 // NewServer creates a new Server that implements the configuration interface.
 func NewServer(opts ...service.ServiceOption[Server]) (srv *Server) {
 	var (
@@ -126,9 +267,14 @@ func NewServer(opts ...service.ServiceOption[Server]) (srv *Server) {
 		err      error
 	)
 	srv = &Server{
-		interval:   DefaultInterval,
-		monitoring: make(map[string]*MonitorScheduler),
-		ccw:        make(map[string]*complianceCalcWindow),
+		interval:              DefaultInterval,
+		monitoring:            make(map[string]*MonitorScheduler),
+		recalculationInterval: DefaultRecalculationInterval,
+		maxJitter:             DefaultMaxJitter,
+		healthCheckInterval:   DefaultHealthCheckInterval,
+		health:                make(map[string]*moduleHealth),
+		leases:                make(map[string]map[string]time.Time),
+		leaseTTL:              DefaultLeaseTTL,
 	}
 
 	// Apply any options
@@ -142,6 +288,10 @@ func NewServer(opts ...service.ServiceOption[Server]) (srv *Server) {
 
 	}
 
+	// Encrypt secrets of service configurations, which have been stored before encryption was enabled or with a
+	// previous key
+	srv.migrateSecrets()
+
 	// Load controls (requirements in Clouditor terminology)
 	log.Info("Loading controls")
 	controls, err = loadRequirements()
@@ -161,6 +311,26 @@ func NewServer(opts ...service.ServiceOption[Server]) (srv *Server) {
 	// Create a hook function for incoming assessment results, so that we can trigger the compliance calculation
 	srv.OrchestratorServer.(*orchestratorservice.Service).RegisterAssessmentResultHook(srv.handleIncomingAssessmentResults)
 
+	// Expose the monitored services and controls in the metrics
+	if srv.registerer != nil {
+		if err = srv.registerer.Register(monitoringCollector{srv}); err != nil {
+			log.Errorf("Could not register metrics: %v", err)
+			srv.registerer = nil
+		}
+	}
+
+	// Periodically check the health of the collection modules
+	if srv.healthCheckInterval > 0 {
+		scheduler := gocron.NewScheduler(time.UTC)
+		_, err = scheduler.Every(srv.healthCheckInterval).WaitForSchedule().Do(srv.checkCollectionModules)
+		if err != nil {
+			log.Errorf("Could not schedule health checks of collection modules: %v", err)
+		} else {
+			scheduler.StartAsync()
+			srv.healthScheduler = scheduler
+		}
+	}
+
 	if srv.evalManagerAddress == "" {
 		log.Error("Address for Eval Manager not set: CMs will probably not work properly (It can be set via " +
 			"`WithEvalManagerAddress` option)")
@@ -168,3 +338,15 @@ func NewServer(opts ...service.ServiceOption[Server]) (srv *Server) {
 
 	return
 }
+
+// Close stops the periodic health checks of the collection modules and removes the metrics of the server from the
+// registry. It is called once the server is not used anymore, e.g., on shutdown.
+func (srv *Server) Close() {
+	if srv.healthScheduler != nil {
+		srv.healthScheduler.Stop()
+	}
+
+	if srv.registerer != nil {
+		srv.registerer.Unregister(monitoringCollector{srv})
+	}
+}
@@ -17,9 +17,11 @@ package configuration
 
 import (
 	"testing"
+	"time"
 
 	"clouditor.io/clouditor/api/assessment"
 	"clouditor.io/clouditor/persistence"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/gorm"
 
@@ -82,11 +84,57 @@ func TestNewServer(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			got := NewServer(tt.args.opts...)
+			defer got.Close()
 			tt.want(t, got, nil)
 		})
 	}
 }
 
+func TestServer_Close(t *testing.T) {
+	srv := NewServer(WithStorage(testutil.NewInMemoryStorage(t)), WithHealthCheckInterval(time.Hour))
+	assert.True(t, srv.healthScheduler.IsRunning())
+
+	srv.Close()
+	assert.False(t, srv.healthScheduler.IsRunning())
+
+	// Closing again and closing a server without health checks is fine
+	srv.Close()
+	NewServer(WithStorage(testutil.NewInMemoryStorage(t)), WithHealthCheckInterval(0)).Close()
+}
+
+func TestServer_metricsRegisterer(t *testing.T) {
+	reg := prometheus.NewRegistry()
+
+	newServer := func() *Server {
+		return NewServer(WithStorage(testutil.NewInMemoryStorage(t)), WithMetricsRegisterer(reg))
+	}
+	families := func() int {
+		families, err := reg.Gather()
+		assert.NoError(t, err)
+		return len(families)
+	}
+
+	// Servers without registerer do not register their metrics anywhere
+	NewServer(WithStorage(testutil.NewInMemoryStorage(t))).Close()
+
+	srv := newServer()
+	assert.Equal(t, 1, families())
+
+	// A second server cannot replace the metrics of the first one, nor remove them
+	other := newServer()
+	assert.Nil(t, other.registerer)
+	other.Close()
+	assert.Equal(t, 1, families())
+
+	// Once the server is closed, its metrics are removed and another server can register its metrics
+	srv.Close()
+	assert.Zero(t, families())
+
+	srv = newServer()
+	assert.Equal(t, 1, families())
+	srv.Close()
+}
+
 func Test_server_getInterval(t *testing.T) {
 	type fields struct {
 		interval int
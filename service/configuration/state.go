@@ -0,0 +1,155 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package configuration
+
+import (
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// monitorState is the state of the monitoring of a service. Starting, updating and stopping the monitoring are
+// transitional states: The RPC, which moved the monitoring into such a state, is the only one allowed to change the
+// monitoring until it moves it into the next state. This way, the long-running parts of an RPC, e.g., waiting for the
+// running jobs when stopping the scheduler, do not need to hold a lock, while concurrent RPCs for the same service
+// are rejected instead of interfering with each other.
+type monitorState int
+
+const (
+	// monitorStopped is the state of a monitoring, which has not been started yet or which has been stopped
+	monitorStopped monitorState = iota
+	// monitorStarting is the state of a monitoring, whose jobs are being scheduled
+	monitorStarting
+	// monitorRunning is the state of a monitoring, whose scheduler is running
+	monitorRunning
+	// monitorUpdating is the state of a running monitoring, whose jobs are being changed
+	monitorUpdating
+	// monitorStopping is the state of a monitoring, whose scheduler is being stopped
+	monitorStopping
+)
+
+// monitorTransitions contains the states (value), into which a monitoring in a state (key) can move
+var monitorTransitions = map[monitorState][]monitorState{
+	monitorStopped:  {monitorStarting},
+	monitorStarting: {monitorRunning, monitorStopped},
+	monitorRunning:  {monitorUpdating, monitorStopping},
+	monitorUpdating: {monitorRunning},
+	monitorStopping: {monitorStopped},
+}
+
+// String returns the name of the state
+func (s monitorState) String() string {
+	switch s {
+	case monitorStopped:
+		return "stopped"
+	case monitorStarting:
+		return "starting"
+	case monitorRunning:
+		return "running"
+	case monitorUpdating:
+		return "updating"
+	case monitorStopping:
+		return "stopping"
+	default:
+		return "unknown"
+	}
+}
+
+// running returns true, if the scheduler of the monitoring is running
+func (s monitorState) running() bool {
+	return s == monitorRunning || s == monitorUpdating
+}
+
+// transition moves the monitoring into the state to. It returns false, if the monitoring cannot move from its current
+// state into to. The caller must hold the mutex of the monitoring.
+func (m *MonitorScheduler) transition(to monitorState) bool {
+	if !slices.Contains(monitorTransitions[m.state], to) {
+		return false
+	}
+
+	m.state = to
+	return true
+}
+
+// finish moves the monitoring from a transitional state into the state to
+func (m *MonitorScheduler) finish(to monitorState) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if !m.transition(to) {
+		log.Errorf("Invalid transition of monitoring from %s to %s", m.state, to)
+	}
+}
+
+// monitor returns the monitoring of the service or nil, if the service has never been monitored
+func (srv *Server) monitor(serviceID string) *MonitorScheduler {
+	srv.monitoringMutex.Lock()
+	defer srv.monitoringMutex.Unlock()
+
+	return srv.monitoring[serviceID]
+}
+
+// beginTransition moves the monitoring of the service into the transitional state to. If the service has never been
+// monitored and to is monitorStarting, a new monitoring is created. It returns the monitoring (nil, if there is none),
+// its state before the transition and whether the transition was possible.
+func (srv *Server) beginTransition(serviceID string, to monitorState) (m *MonitorScheduler, from monitorState,
+	ok bool) {
+	srv.monitoringMutex.Lock()
+	defer srv.monitoringMutex.Unlock()
+
+	m = srv.monitoring[serviceID]
+	if m == nil {
+		if to != monitorStarting {
+			return
+		}
+
+		if srv.monitoring == nil {
+			srv.monitoring = make(map[string]*MonitorScheduler)
+		}
+		m = new(MonitorScheduler)
+		srv.monitoring[serviceID] = m
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	from = m.state
+	ok = m.transition(to)
+
+	return
+}
+
+// abortStart moves the monitoring of the service back into the stopped state, if it could not be started. A
+// monitoring, which has never run, is removed again.
+func (srv *Server) abortStart(serviceID string, m *MonitorScheduler) {
+	srv.monitoringMutex.Lock()
+	defer srv.monitoringMutex.Unlock()
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.transition(monitorStopped)
+	if m.scheduler == nil && srv.monitoring[serviceID] == m {
+		delete(srv.monitoring, serviceID)
+	}
+}
+
+// errConcurrentOperation returns the error for an RPC, which conflicts with an operation on the monitoring of the
+// service that is in progress
+func errConcurrentOperation(serviceID string, state monitorState) error {
+	return status.Errorf(codes.Aborted, "Monitoring of service %s is %s at the moment. Try again later",
+		serviceID, state)
+}
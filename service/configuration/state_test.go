@@ -0,0 +1,280 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/api/assessment"
+	"clouditor.io/clouditor/api/orchestrator"
+	"clouditor.io/clouditor/persistence"
+	service_orchestrator "clouditor.io/clouditor/service/orchestrator"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/eclipse-xfsc/cam/api/configuration"
+	"github.com/eclipse-xfsc/cam/api/evaluation"
+	"github.com/eclipse-xfsc/cam/internal/testutil"
+)
+
+func TestMonitorScheduler_transition(t *testing.T) {
+	tests := []struct {
+		from monitorState
+		to   monitorState
+		want bool
+	}{
+		{from: monitorStopped, to: monitorStarting, want: true},
+		{from: monitorStopped, to: monitorStopping, want: false},
+		{from: monitorStopped, to: monitorUpdating, want: false},
+		{from: monitorStarting, to: monitorRunning, want: true},
+		{from: monitorStarting, to: monitorStopped, want: true},
+		{from: monitorStarting, to: monitorStopping, want: false},
+		{from: monitorRunning, to: monitorStarting, want: false},
+		{from: monitorRunning, to: monitorUpdating, want: true},
+		{from: monitorRunning, to: monitorStopping, want: true},
+		{from: monitorUpdating, to: monitorRunning, want: true},
+		{from: monitorUpdating, to: monitorStopping, want: false},
+		{from: monitorStopping, to: monitorStarting, want: false},
+		{from: monitorStopping, to: monitorStopped, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s to %s", tt.from, tt.to), func(t *testing.T) {
+			m := &MonitorScheduler{state: tt.from}
+			assert.Equal(t, tt.want, m.transition(tt.to))
+			if tt.want {
+				assert.Equal(t, tt.to, m.state)
+			} else {
+				assert.Equal(t, tt.from, m.state)
+			}
+		})
+	}
+}
+
+func TestServer_StopMonitoring_concurrentOperation(t *testing.T) {
+	srv := &Server{monitoring: map[string]*MonitorScheduler{
+		"0000": {state: monitorStarting},
+		"0001": {state: monitorUpdating, scheduler: mockStartedScheduler(t)},
+	}}
+
+	_, err := srv.StopMonitoring(context.Background(), &configuration.StopMonitoringRequest{ServiceId: "0000"})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	_, err = srv.StopMonitoring(context.Background(), &configuration.StopMonitoringRequest{ServiceId: "0001"})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	_, err = srv.StartMonitoring(context.Background(),
+		&configuration.StartMonitoringRequest{ServiceId: "0001", ControlIds: []string{"Req-1"}})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+// TestServer_monitoring_stress runs StartMonitoring, UpdateMonitoring, StopMonitoring, GetMonitoringStatus and
+// incoming assessment results concurrently for a few services. It is meant to be run with -race.
+func TestServer_monitoring_stress(t *testing.T) {
+	var (
+		services   = []string{"service-0", "service-1", "service-2"}
+		workers    = 6
+		iterations = 30
+		evalServer = new(mockEvaluationServer)
+		wg         sync.WaitGroup
+		starts     = make(map[string]*atomic.Int64)
+		stops      = make(map[string]*atomic.Int64)
+	)
+
+	if testing.Short() {
+		iterations = 10
+	}
+
+	evalAddress := startTCPServer(t, func(s *grpc.Server) {
+		evaluation.RegisterEvaluationServer(s, evalServer)
+	})
+
+	storage := testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+		assert.NoError(t, populateStorage(t, s))
+		for _, serviceID := range services {
+			assert.NoError(t, s.Create(&orchestrator.CloudService{
+				Id:           serviceID,
+				Requirements: &orchestrator.CloudService_Requirements{RequirementIds: []string{"Req-1"}},
+			}))
+		}
+	})
+	srv := &Server{
+		OrchestratorServer: service_orchestrator.NewService(service_orchestrator.WithStorage(storage)),
+		storage:            storage,
+		interval:           300,
+		evalManagerAddress: evalAddress,
+		grpcOpts:           []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())},
+		monitoring:         make(map[string]*MonitorScheduler),
+		// Recalculate frequently, so that the recalculation runs concurrently to the RPCs
+		recalculationInterval: 20 * time.Millisecond,
+	}
+
+	for _, serviceID := range services {
+		starts[serviceID] = new(atomic.Int64)
+		stops[serviceID] = new(atomic.Int64)
+	}
+
+	// Only errors caused by the current state of the monitoring are expected
+	expected := func(err error) bool {
+		switch status.Code(err) {
+		case codes.OK, codes.AlreadyExists, codes.NotFound, codes.Aborted:
+			return true
+		default:
+			return false
+		}
+	}
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+
+			ctx := context.Background()
+			for i := 0; i < iterations; i++ {
+				serviceID := services[(w+i)%len(services)]
+
+				switch (w + i) % 5 {
+				case 0:
+					_, err := srv.StartMonitoring(ctx, &configuration.StartMonitoringRequest{
+						ServiceId: serviceID, ControlIds: []string{"Req-1"}})
+					assert.True(t, expected(err), "unexpected error: %v", err)
+					if err == nil {
+						starts[serviceID].Add(1)
+					}
+				case 1:
+					_, err := srv.StopMonitoring(ctx, &configuration.StopMonitoringRequest{ServiceId: serviceID})
+					assert.True(t, expected(err), "unexpected error: %v", err)
+					if err == nil {
+						stops[serviceID].Add(1)
+					}
+				case 2:
+					_, err := srv.UpdateMonitoring(ctx, &configuration.UpdateMonitoringRequest{
+						ServiceId: serviceID, ControlIds: []string{"Req-1"},
+						Schedules: []*configuration.CollectionSchedule{{Interval: int64(60 + i)}},
+						Debounce:  &configuration.DebounceConfig{Threshold: int64(10 + i)}})
+					assert.True(t, expected(err), "unexpected error: %v", err)
+				case 3:
+					_, err := srv.GetMonitoringStatus(ctx,
+						&configuration.GetMonitoringStatusRequest{ServiceId: serviceID})
+					assert.True(t, expected(err), "unexpected error: %v", err)
+					_, err = srv.ReportEvidences(ctx, &configuration.ReportEvidencesRequest{
+						ServiceId: serviceID, ModuleId: "Module-1", Count: 1})
+					assert.NoError(t, err)
+				case 4:
+					for j := 0; j < 50; j++ {
+						srv.handleIncomingAssessmentResults(&assessment.AssessmentResult{
+							ServiceId: serviceID, MetricId: "Metric-1"}, nil)
+					}
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	// Each monitoring has settled in a stable state, which matches its scheduler and the successful calls
+	for _, serviceID := range services {
+		m := srv.monitor(serviceID)
+		if m == nil {
+			assert.Zero(t, starts[serviceID].Load())
+			continue
+		}
+
+		m.mutex.Lock()
+		state, scheduler := m.state, m.scheduler
+		m.mutex.Unlock()
+
+		switch state {
+		case monitorRunning:
+			assert.True(t, scheduler.IsRunning())
+			assert.Equal(t, stops[serviceID].Load()+1, starts[serviceID].Load())
+
+			_, err := srv.StopMonitoring(context.Background(),
+				&configuration.StopMonitoringRequest{ServiceId: serviceID})
+			assert.NoError(t, err)
+		case monitorStopped:
+			assert.False(t, scheduler.IsRunning())
+			assert.Equal(t, stops[serviceID].Load(), starts[serviceID].Load())
+		default:
+			assert.Fail(t, "monitoring is in transitional state", "%s is %s", serviceID, state)
+		}
+	}
+
+	// All open windows are closed eventually. Since all monitorings are stopped, they do not trigger a calculation,
+	// which would open a connection again.
+	d := srv.debounce().(*windowDebouncer)
+	assert.Eventually(t, func() bool {
+		d.mutex.Lock()
+		defer d.mutex.Unlock()
+
+		return len(d.windows) == 0
+	}, 2*DefaultDebounceWindow, 50*time.Millisecond)
+	srv.pool().mutex.Lock()
+	assert.Empty(t, srv.pool().conns)
+	srv.pool().mutex.Unlock()
+}
+
+// TestServer_handleIncomingAssessmentResults_concurrent sends assessment results of one service and metric
+// concurrently and checks that each window triggers exactly one calculation.
+func TestServer_handleIncomingAssessmentResults_concurrent(t *testing.T) {
+	var (
+		evalServer = new(mockEvaluationServer)
+		wg         sync.WaitGroup
+		// Two windows are closed by reaching the threshold, the remaining results are closed by the timer
+		results = 2*DefaultDebounceThreshold + DefaultDebounceThreshold/2
+	)
+
+	evalAddress := startTCPServer(t, func(s *grpc.Server) {
+		evaluation.RegisterEvaluationServer(s, evalServer)
+	})
+
+	storage := testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+		assert.NoError(t, populateStorage(t, s))
+		assert.NoError(t, s.Create(&orchestrator.CloudService{
+			Id:           "service-1",
+			Requirements: &orchestrator.CloudService_Requirements{RequirementIds: []string{"Req-1"}},
+		}))
+	})
+	srv := &Server{
+		OrchestratorServer: service_orchestrator.NewService(service_orchestrator.WithStorage(storage)),
+		storage:            storage,
+		evalManagerAddress: evalAddress,
+		grpcOpts:           []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())},
+		monitoring:         map[string]*MonitorScheduler{"service-1": {state: monitorRunning}},
+	}
+
+	for i := 0; i < results; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			srv.handleIncomingAssessmentResults(&assessment.AssessmentResult{
+				ServiceId: "service-1", MetricId: "Metric-1"}, nil)
+		}()
+	}
+	wg.Wait()
+
+	assert.Eventually(t, func() bool {
+		return evalServer.calculations.Load() == 3
+	}, 2*DefaultDebounceWindow, 10*time.Millisecond)
+
+	// The timer of a window closed by the threshold does not trigger another calculation
+	time.Sleep(DefaultDebounceWindow / 2)
+	assert.Equal(t, int64(3), evalServer.calculations.Load())
+}
@@ -0,0 +1,73 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package configuration
+
+import (
+	"context"
+	"errors"
+
+	"clouditor.io/clouditor/persistence"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/eclipse-xfsc/cam/api/configuration"
+	"github.com/eclipse-xfsc/cam/internal/authz"
+)
+
+// tenantOf returns the tenant of the cloud service. Services without tenant, e.g., registered by an admin without
+// tenant, return an empty tenant.
+func (srv *Server) tenantOf(serviceID string) (tenant string, err error) {
+	var t configuration.CloudServiceTenant
+
+	err = srv.storage.Get(&t, "service_id = ?", serviceID)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	return t.TenantId, nil
+}
+
+// verifyTenant verifies that the caller may access the cloud service. Services of other tenants are reported as not
+// found, so that their existence is not disclosed.
+func (srv *Server) verifyTenant(ctx context.Context, serviceID string) (err error) {
+	if _, restricted := authz.TenantFromContext(ctx); !restricted {
+		return nil
+	}
+
+	tenant, err := srv.tenantOf(serviceID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "DB error: %v", err)
+	}
+
+	if !authz.TenantAllowed(ctx, tenant) {
+		return status.Errorf(codes.NotFound, "No Cloud Service found for '%s' (id)", serviceID)
+	}
+
+	return nil
+}
+
+// assignTenant assigns the cloud service to the tenant of the caller
+func (srv *Server) assignTenant(ctx context.Context, serviceID string) (err error) {
+	tenant, _ := authz.TenantFromContext(ctx)
+	if tenant == "" {
+		return nil
+	}
+
+	return srv.storage.Save(&configuration.CloudServiceTenant{ServiceId: serviceID, TenantId: tenant},
+		"service_id = ?", serviceID)
+}
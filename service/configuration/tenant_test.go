@@ -0,0 +1,154 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package configuration
+
+import (
+	"context"
+	"testing"
+
+	"clouditor.io/clouditor/api/orchestrator"
+	"clouditor.io/clouditor/persistence"
+	orchestrator2 "clouditor.io/clouditor/service/orchestrator"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/eclipse-xfsc/cam/api/collection"
+	"github.com/eclipse-xfsc/cam/api/configuration"
+	"github.com/eclipse-xfsc/cam/internal/authz"
+	"github.com/eclipse-xfsc/cam/internal/testutil"
+	"github.com/eclipse-xfsc/cam/internal/testutil/testproto"
+	"github.com/eclipse-xfsc/cam/service"
+)
+
+// ownerOf returns the context of a service owner of all services of the tenant
+func ownerOf(tenant string) context.Context {
+	return service.ContextWithClaims(context.Background(), &service.Claims{
+		Roles:    []string{string(authz.RoleServiceOwner)},
+		Services: []string{authz.AllServices},
+		Tenant:   tenant,
+	})
+}
+
+func TestServer_TenantIsolation(t *testing.T) {
+	var (
+		alice = ownerOf("alice")
+		bob   = ownerOf("bob")
+	)
+
+	s := testutil.NewInMemoryStorage(t)
+	srv := &Server{storage: s, OrchestratorServer: orchestrator2.NewService(orchestrator2.WithStorage(s))}
+
+	// Registered services belong to the tenant of the caller
+	cs, err := srv.RegisterCloudService(alice, &orchestrator.RegisterCloudServiceRequest{
+		Service: &orchestrator.CloudService{Name: "alice's service"},
+	})
+	assert.NoError(t, err)
+
+	tenant, err := srv.tenantOf(cs.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", tenant)
+
+	// Configurations are assigned to the tenant of the service
+	_, err = srv.ConfigureCloudService(alice, &configuration.ConfigureCloudServiceRequest{
+		ServiceId: cs.Id,
+		Configurations: &configuration.Configurations{
+			Configurations: []*collection.ServiceConfiguration{{
+				RawConfiguration: testproto.NewAny(t,
+					&collection.AuthenticationSecurityConfig{Issuer: "https://issuer.example.com"}),
+			}},
+		},
+	})
+	assert.NoError(t, err)
+
+	res, err := srv.ListCloudServiceConfigurations(alice,
+		&configuration.ListCloudServiceConfigurationsRequest{ServiceId: cs.Id})
+	assert.NoError(t, err)
+	assert.Len(t, res.Configurations, 1)
+	assert.Equal(t, "alice", res.Configurations[0].TenantId)
+
+	// The service does not exist for other tenants
+	_, err = srv.GetCloudService(bob, &orchestrator.GetCloudServiceRequest{ServiceId: cs.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = srv.ListCloudServiceConfigurations(bob,
+		&configuration.ListCloudServiceConfigurationsRequest{ServiceId: cs.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = srv.ConfigureCloudService(bob, &configuration.ConfigureCloudServiceRequest{ServiceId: cs.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = srv.UpdateCloudService(bob, &orchestrator.UpdateCloudServiceRequest{
+		ServiceId: cs.Id,
+		Service:   &orchestrator.CloudService{Name: "bob's service"},
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = srv.GetMonitoringStatus(bob, &configuration.GetMonitoringStatusRequest{ServiceId: cs.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = srv.StopMonitoring(bob, &configuration.StopMonitoringRequest{ServiceId: cs.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = srv.RemoveCloudService(bob, &orchestrator.RemoveCloudServiceRequest{ServiceId: cs.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	list, err := srv.ListCloudServices(bob, &orchestrator.ListCloudServicesRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, list.Services)
+
+	// The owner and admins without tenant can still access it
+	_, err = srv.GetCloudService(alice, &orchestrator.GetCloudServiceRequest{ServiceId: cs.Id})
+	assert.NoError(t, err)
+
+	_, err = srv.GetCloudService(service.ContextWithClaims(context.Background(),
+		&service.Claims{Roles: []string{string(authz.RoleAdmin)}}), &orchestrator.GetCloudServiceRequest{ServiceId: cs.Id})
+	assert.NoError(t, err)
+
+	// Removing the service removes its tenant as well
+	_, err = srv.RemoveCloudService(alice, &orchestrator.RemoveCloudServiceRequest{ServiceId: cs.Id})
+	assert.NoError(t, err)
+
+	count, err := s.Count(&configuration.CloudServiceTenant{}, "service_id = ?", cs.Id)
+	assert.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestServer_tenantOf(t *testing.T) {
+	const otherServiceID = "11111111-1111-1111-1111-111111111111"
+
+	s := testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+		assert.NoError(t, s.Create(&configuration.CloudServiceTenant{
+			ServiceId: orchestrator2.DefaultTargetCloudServiceId,
+			TenantId:  "alice",
+		}))
+	})
+	srv := &Server{storage: s}
+
+	tenant, err := srv.tenantOf(orchestrator2.DefaultTargetCloudServiceId)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", tenant)
+
+	// Services without tenant
+	tenant, err = srv.tenantOf(otherServiceID)
+	assert.NoError(t, err)
+	assert.Empty(t, tenant)
+
+	// Callers without tenant may only access services without tenant, unless they are admins
+	assert.Equal(t, codes.NotFound, status.Code(srv.verifyTenant(ownerOf(""), orchestrator2.DefaultTargetCloudServiceId)))
+	assert.NoError(t, srv.verifyTenant(ownerOf(""), otherServiceID))
+	assert.NoError(t, srv.verifyTenant(context.Background(), orchestrator2.DefaultTargetCloudServiceId))
+}
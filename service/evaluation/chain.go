@@ -0,0 +1,270 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package evaluation
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"strings"
+
+	"clouditor.io/clouditor/persistence"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/square/go-jose.v2"
+
+	"github.com/eclipse-xfsc/cam/api/common"
+	"github.com/eclipse-xfsc/cam/api/evaluation"
+	"github.com/eclipse-xfsc/cam/internal/authz"
+	"github.com/eclipse-xfsc/cam/internal/signing"
+	"github.com/eclipse-xfsc/cam/service"
+)
+
+var (
+	// ErrEvidenceNotSigned indicates that an evidence is not signed, although its signature is required
+	ErrEvidenceNotSigned = errors.New("evidence is not signed")
+	// ErrUnknownEvidenceKey indicates that the key of the collection module, which signed an evidence, is not known
+	ErrUnknownEvidenceKey = errors.New("unknown evidence signing key")
+	// ErrInvalidEvidenceSignature indicates that the signature of an evidence does not match the evidence or the key
+	ErrInvalidEvidenceSignature = errors.New("invalid evidence signature")
+)
+
+// WithEvidenceVerificationKeys is an option to set the public keys of the collection modules, with which the
+// signatures of their evidences are verified. The keys are indexed by the tool ID of the collection modules. Evidences
+// of these collection modules are rejected, if they are not signed with the key.
+func WithEvidenceVerificationKeys(keys map[string]crypto.PublicKey) service.ServiceOption[Server] {
+	return func(srv *Server) {
+		srv.evidenceKeys = keys
+	}
+}
+
+// WithRequiredEvidenceSignatures is an option to reject all evidences, which are not signed by a collection module
+// with a known key
+func WithRequiredEvidenceSignatures() service.ServiceOption[Server] {
+	return func(srv *Server) {
+		srv.requireSignatures = true
+	}
+}
+
+// verifySignature verifies the signature of the evidence with the key of its collection module. Signatures of
+// collection modules without a known key cannot be verified, which is only an error if signatures are required.
+func (srv *Server) verifySignature(evidence *common.Evidence) (verified bool, err error) {
+	key, ok := srv.evidenceKeys[evidence.ToolId]
+	if !ok {
+		if srv.requireSignatures {
+			return false, fmt.Errorf("%w of collection module %s", ErrUnknownEvidenceKey, evidence.ToolId)
+		}
+
+		return false, nil
+	}
+
+	if evidence.Signature == "" {
+		return false, ErrEvidenceNotSigned
+	}
+
+	payload, err := evidence.SigningPayload()
+	if err != nil {
+		return false, err
+	}
+
+	if err = verifyDetached(evidence.Signature, payload, key); err != nil {
+		return false, fmt.Errorf("%w: %v", ErrInvalidEvidenceSignature, err)
+	}
+
+	return true, nil
+}
+
+// verifyDetached verifies the JWS with detached payload, which has been created by a collection module, with its key.
+// The algorithm of the JWS must match the key, so that the signature cannot be verified with another algorithm.
+func verifyDetached(signature string, payload []byte, key crypto.PublicKey) (err error) {
+	alg, err := signing.Algorithm(key)
+	if err != nil {
+		return err
+	}
+
+	jws, err := jose.ParseDetached(signature, payload)
+	if err != nil {
+		return err
+	}
+
+	if len(jws.Signatures) != 1 || jws.Signatures[0].Header.Algorithm != string(alg) {
+		return errors.New("algorithm does not match key")
+	}
+
+	_, err = jws.Verify(key)
+
+	return err
+}
+
+// storeInChain appends the evidence to the hash chain of its target service and stores it. The chain is extended
+// under a lock, so that concurrent streams of collection modules cannot fork it. The lock only serializes the streams
+// of this process, so that several replicas of the Evaluation Manager sharing a database could fork the chain.
+func (srv *Server) storeInChain(evidence *common.Evidence) (err error) {
+	var last []*common.Evidence
+
+	srv.chainMutex.Lock()
+	defer srv.chainMutex.Unlock()
+
+	// Evidences stored before the introduction of the chain have no sequence number
+	err = srv.storage.List(&last, "sequence", false, 0, 1, "target_service = ? AND sequence > 0",
+		evidence.TargetService)
+	if err != nil {
+		return fmt.Errorf("could not retrieve last evidence of the chain: %w", err)
+	}
+
+	evidence.Sequence = 1
+	evidence.PreviousHash = ""
+	if len(last) > 0 {
+		evidence.Sequence = last[0].Sequence + 1
+		evidence.PreviousHash = last[0].Hash
+	}
+	evidence.ReceivedAt = timestamppb.Now()
+
+	evidence.Hash, err = evidence.ChainHash()
+	if err != nil {
+		return fmt.Errorf("could not hash evidence: %w", err)
+	}
+
+	return srv.storage.Create(evidence)
+}
+
+// VerifyEvidenceChain verifies the hash chain of the stored evidences of a service, which have been received in the
+// given time range. The chain is broken, if an evidence has been modified, deleted or inserted. Additionally, the
+// signatures of the evidences are verified, which detects modifications even if the chain has been recomputed.
+func (srv *Server) VerifyEvidenceChain(ctx context.Context, req *evaluation.VerifyEvidenceChainRequest) (
+	res *evaluation.VerifyEvidenceChainResponse, err error) {
+	var (
+		evidences []*common.Evidence
+		prev      *common.Evidence
+		query     = []string{"target_service = ?", "sequence > 0"}
+		args      = []interface{}{req.ServiceId}
+	)
+
+	if req.ServiceId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Service ID is missing")
+	}
+
+	// The chain of a service of another tenant is not revealed, not even whether it is intact
+	if err = srv.verifyChainTenant(ctx, req.ServiceId); err != nil {
+		return nil, err
+	}
+
+	if req.From != nil {
+		query = append(query, "received_at >= ?")
+		args = append(args, req.From.AsTime())
+	}
+	if req.To != nil {
+		query = append(query, "received_at <= ?")
+		args = append(args, req.To.AsTime())
+	}
+
+	err = srv.storage.List(&evidences, "sequence", true, 0, -1,
+		authz.TenantConds(ctx, append([]interface{}{strings.Join(query, " AND ")}, args...)...)...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: %v", DatabaseErrorMsg, err)
+	}
+
+	res = &evaluation.VerifyEvidenceChainResponse{Valid: true}
+
+	// The first evidence of the time range is anchored to its predecessor, which must be intact as well
+	if len(evidences) > 0 && evidences[0].Sequence > 1 {
+		var list []*common.Evidence
+
+		err = srv.storage.List(&list, "", true, 0, 1, authz.TenantConds(ctx, "target_service = ? AND sequence = ?",
+			req.ServiceId, evidences[0].Sequence-1)...)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "%s: %v", DatabaseErrorMsg, err)
+		}
+
+		if len(list) == 0 {
+			return invalid(res, evidences[0], "preceding evidence %d is missing", evidences[0].Sequence-1), nil
+		}
+
+		prev = list[0]
+		if hash, err := prev.ChainHash(); err != nil || hash != prev.Hash {
+			return invalid(res, prev, "hash does not match the evidence"), nil
+		}
+	}
+
+	for _, e := range evidences {
+		var (
+			sequence int64 = 1
+			prevHash string
+		)
+
+		if prev != nil {
+			sequence = prev.Sequence + 1
+			prevHash = prev.Hash
+		}
+
+		if e.Sequence != sequence {
+			return invalid(res, e, "evidence %d is missing", sequence), nil
+		}
+
+		if e.PreviousHash != prevHash {
+			return invalid(res, e, "previous hash does not match the preceding evidence"), nil
+		}
+
+		if hash, err := e.ChainHash(); err != nil || hash != e.Hash {
+			return invalid(res, e, "hash does not match the evidence"), nil
+		}
+
+		verified, err := srv.verifySignature(e)
+		if err != nil {
+			return invalid(res, e, "invalid signature: %v", err), nil
+		}
+		if verified {
+			res.SignedEvidences++
+		}
+
+		res.Evidences++
+		res.LastHash = e.Hash
+		prev = e
+	}
+
+	return res, nil
+}
+
+// verifyChainTenant checks that the chain of the service belongs to the tenant of the caller, using its last evidence.
+// Otherwise, NotFound is returned, as for the other data of services of other tenants.
+func (srv *Server) verifyChainTenant(ctx context.Context, serviceID string) (err error) {
+	var last []*common.Evidence
+
+	err = srv.storage.List(&last, "sequence", false, 0, 1, "target_service = ? AND sequence > 0", serviceID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "%s: %v", DatabaseErrorMsg, err)
+	}
+
+	if len(last) > 0 && !authz.TenantAllowed(ctx, last[0].TenantId) {
+		return status.Errorf(codes.NotFound, "%v: %v", EvidenceNotFoundErrorMsg, persistence.ErrRecordNotFound)
+	}
+
+	return nil
+}
+
+// invalid marks the verification result as invalid at the evidence
+func invalid(res *evaluation.VerifyEvidenceChainResponse, e *common.Evidence, format string,
+	args ...interface{}) *evaluation.VerifyEvidenceChainResponse {
+	res.Valid = false
+	res.InvalidEvidenceId = e.Id
+	res.Reason = fmt.Sprintf(format, args...)
+
+	log.Warnf("Evidence chain of service %s is broken at evidence %s: %s", e.TargetService, e.Id, res.Reason)
+
+	return res
+}
@@ -0,0 +1,294 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package evaluation
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eclipse-xfsc/cam/api/common"
+	"github.com/eclipse-xfsc/cam/api/evaluation"
+	"github.com/eclipse-xfsc/cam/internal/testutil"
+	"github.com/eclipse-xfsc/cam/internal/testutil/testproto"
+	servicecollection "github.com/eclipse-xfsc/cam/service/collection"
+)
+
+const testToolID = "my-module"
+
+// newSigner returns a new signer of the test tool with the key
+func newSigner(t *testing.T, key crypto.PrivateKey) *servicecollection.Signer {
+	signer, err := servicecollection.NewSigner(key, "")
+	assert.NoError(t, err)
+
+	return signer
+}
+
+// newSignedEvidence returns a new evidence of the test tool, which is signed by the signer
+func newSignedEvidence(t *testing.T, signer *servicecollection.Signer) *common.Evidence {
+	e := &common.Evidence{
+		Id:            uuid.NewString(),
+		TargetService: testutil.DefaultServiceID,
+		ToolId:        testToolID,
+		GatheredAt:    timestamppb.Now(),
+		Value:         testproto.ToValue(t, struct{ Test string }{Test: "test"}),
+	}
+	assert.NoError(t, signer.Sign(e))
+
+	return e
+}
+
+func TestServer_verifySignature(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	_, ed, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	var (
+		keys   = map[string]crypto.PublicKey{testToolID: &key.PublicKey}
+		signer = newSigner(t, key)
+	)
+
+	tampered := newSignedEvidence(t, signer)
+	tampered.TargetResource = "other"
+
+	unknown := newSignedEvidence(t, signer)
+	unknown.ToolId = "other-module"
+	assert.NoError(t, signer.Sign(unknown))
+
+	malformed := newSignedEvidence(t, signer)
+	malformed.Signature = "not a JWS"
+
+	tests := []struct {
+		name         string
+		srv          *Server
+		evidence     *common.Evidence
+		wantVerified bool
+		wantErr      assert.ErrorAssertionFunc
+	}{
+		{
+			name:         "valid signature",
+			srv:          &Server{evidenceKeys: keys},
+			evidence:     newSignedEvidence(t, signer),
+			wantVerified: true,
+			wantErr:      assert.NoError,
+		},
+		{
+			name:     "modified evidence",
+			srv:      &Server{evidenceKeys: keys},
+			evidence: tampered,
+			wantErr:  assert.Error,
+		},
+		{
+			name:     "wrong key",
+			srv:      &Server{evidenceKeys: keys},
+			evidence: newSignedEvidence(t, newSigner(t, other)),
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrInvalidEvidenceSignature)
+			},
+		},
+		{
+			name:     "other algorithm",
+			srv:      &Server{evidenceKeys: keys},
+			evidence: newSignedEvidence(t, newSigner(t, ed)),
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrInvalidEvidenceSignature)
+			},
+		},
+		{
+			name:     "malformed signature",
+			srv:      &Server{evidenceKeys: keys},
+			evidence: malformed,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrInvalidEvidenceSignature)
+			},
+		},
+		{
+			name:     "unsigned evidence of module with key",
+			srv:      &Server{evidenceKeys: keys},
+			evidence: newSignedEvidence(t, nil),
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrEvidenceNotSigned)
+			},
+		},
+		{
+			name:     "module without key",
+			srv:      &Server{evidenceKeys: keys},
+			evidence: unknown,
+			wantErr:  assert.NoError,
+		},
+		{
+			name:     "module without key, signatures required",
+			srv:      &Server{evidenceKeys: keys, requireSignatures: true},
+			evidence: unknown,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrUnknownEvidenceKey)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verified, err := tt.srv.verifySignature(tt.evidence)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantVerified, verified)
+		})
+	}
+}
+
+func TestServer_VerifyEvidenceChain(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	signer := newSigner(t, key)
+
+	// newServer returns a server with a chain of 4 evidences
+	newServer := func(t *testing.T) (srv *Server, chain []*common.Evidence) {
+		srv = &Server{
+			storage:      testutil.NewInMemoryStorage(t),
+			evidenceKeys: map[string]crypto.PublicKey{testToolID: &key.PublicKey},
+		}
+
+		for i := 0; i < 4; i++ {
+			e := newSignedEvidence(t, signer)
+			assert.NoError(t, srv.storeInChain(e))
+			chain = append(chain, e)
+		}
+
+		return
+	}
+
+	t.Run("intact chain", func(t *testing.T) {
+		srv, chain := newServer(t)
+
+		assert.Equal(t, int64(4), chain[3].Sequence)
+		assert.Equal(t, chain[2].Hash, chain[3].PreviousHash)
+		assert.Empty(t, chain[0].PreviousHash)
+
+		res, err := srv.VerifyEvidenceChain(context.Background(),
+			&evaluation.VerifyEvidenceChainRequest{ServiceId: testutil.DefaultServiceID})
+		assert.NoError(t, err)
+		assert.True(t, res.Valid)
+		assert.Equal(t, int64(4), res.Evidences)
+		assert.Equal(t, int64(4), res.SignedEvidences)
+		assert.Equal(t, chain[3].Hash, res.LastHash)
+	})
+
+	t.Run("time range", func(t *testing.T) {
+		srv, chain := newServer(t)
+
+		res, err := srv.VerifyEvidenceChain(context.Background(), &evaluation.VerifyEvidenceChainRequest{
+			ServiceId: testutil.DefaultServiceID,
+			From:      chain[2].ReceivedAt,
+			To:        timestamppb.New(time.Now().Add(time.Minute)),
+		})
+		assert.NoError(t, err)
+		assert.True(t, res.Valid)
+		assert.Equal(t, int64(2), res.Evidences)
+	})
+
+	t.Run("modified evidence", func(t *testing.T) {
+		srv, chain := newServer(t)
+
+		chain[1].TargetResource = "modified"
+		assert.NoError(t, srv.storage.Save(chain[1], "id = ?", chain[1].Id))
+
+		res, err := srv.VerifyEvidenceChain(context.Background(),
+			&evaluation.VerifyEvidenceChainRequest{ServiceId: testutil.DefaultServiceID})
+		assert.NoError(t, err)
+		assert.False(t, res.Valid)
+		assert.Equal(t, chain[1].Id, res.InvalidEvidenceId)
+		assert.Equal(t, int64(1), res.Evidences)
+	})
+
+	t.Run("modified evidence with recomputed chain", func(t *testing.T) {
+		srv, chain := newServer(t)
+
+		// Without the key of the collection module, the signature cannot be forged
+		chain[3].TargetResource = "modified"
+		chain[3].Hash, _ = chain[3].ChainHash()
+		assert.NoError(t, srv.storage.Save(chain[3], "id = ?", chain[3].Id))
+
+		res, err := srv.VerifyEvidenceChain(context.Background(),
+			&evaluation.VerifyEvidenceChainRequest{ServiceId: testutil.DefaultServiceID})
+		assert.NoError(t, err)
+		assert.False(t, res.Valid)
+		assert.Equal(t, chain[3].Id, res.InvalidEvidenceId)
+		assert.Contains(t, res.Reason, "signature")
+	})
+
+	t.Run("deleted evidence", func(t *testing.T) {
+		srv, chain := newServer(t)
+
+		assert.NoError(t, srv.storage.Delete(&common.Evidence{}, "id = ?", chain[1].Id))
+
+		res, err := srv.VerifyEvidenceChain(context.Background(),
+			&evaluation.VerifyEvidenceChainRequest{ServiceId: testutil.DefaultServiceID})
+		assert.NoError(t, err)
+		assert.False(t, res.Valid)
+		assert.Equal(t, chain[2].Id, res.InvalidEvidenceId)
+
+		// The predecessor of a time range is checked as well
+		res, err = srv.VerifyEvidenceChain(context.Background(), &evaluation.VerifyEvidenceChainRequest{
+			ServiceId: testutil.DefaultServiceID,
+			From:      chain[2].ReceivedAt,
+		})
+		assert.NoError(t, err)
+		assert.False(t, res.Valid)
+		assert.Equal(t, chain[2].Id, res.InvalidEvidenceId)
+	})
+
+	t.Run("other tenant", func(t *testing.T) {
+		srv, chain := newServer(t)
+
+		// The chain of a service of another tenant is not found
+		_, err := srv.VerifyEvidenceChain(auditorOf("other"),
+			&evaluation.VerifyEvidenceChainRequest{ServiceId: testutil.DefaultServiceID})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		// Neither is the predecessor of a time range, if it belongs to another tenant
+		chain[1].TenantId = "other"
+		assert.NoError(t, srv.storage.Save(chain[1], "id = ?", chain[1].Id))
+
+		res, err := srv.VerifyEvidenceChain(auditorOf(""), &evaluation.VerifyEvidenceChainRequest{
+			ServiceId: testutil.DefaultServiceID,
+			From:      chain[2].ReceivedAt,
+		})
+		assert.NoError(t, err)
+		assert.False(t, res.Valid)
+		assert.Equal(t, chain[2].Id, res.InvalidEvidenceId)
+		assert.Equal(t, "preceding evidence 2 is missing", res.Reason)
+	})
+
+	t.Run("missing service ID", func(t *testing.T) {
+		srv, _ := newServer(t)
+
+		_, err := srv.VerifyEvidenceChain(context.Background(), &evaluation.VerifyEvidenceChainRequest{})
+		assert.Error(t, err)
+	})
+}
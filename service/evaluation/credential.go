@@ -0,0 +1,456 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package evaluation
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"clouditor.io/clouditor/persistence"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/square/go-jose.v2"
+
+	"github.com/eclipse-xfsc/cam/api/evaluation"
+	"github.com/eclipse-xfsc/cam/internal/authz"
+	"github.com/eclipse-xfsc/cam/internal/protobuf"
+	"github.com/eclipse-xfsc/cam/internal/signing"
+	"github.com/eclipse-xfsc/cam/service"
+)
+
+// DefaultCredentialValidity is the default validity period of compliance credentials
+const DefaultCredentialValidity = 30 * 24 * time.Hour
+
+// credentialContext is the JSON-LD context of compliance credentials. Terms, which are not defined by the W3C
+// credentials context, belong to the CAM vocabulary.
+var credentialContext = []interface{}{
+	"https://www.w3.org/2018/credentials/v1",
+	map[string]string{"@vocab": "https://gitlab.eclipse.org/eclipse/xfsc/cam#"},
+}
+
+// credentialClaims are the claims of a compliance credential, which is a W3C Verifiable Credential (data model 1.1)
+// secured as JWT
+type credentialClaims struct {
+	jwt.RegisteredClaims
+	VC verifiableCredential `json:"vc"`
+}
+
+type verifiableCredential struct {
+	Context           []interface{}     `json:"@context"`
+	ID                string            `json:"id"`
+	Type              []string          `json:"type"`
+	Issuer            string            `json:"issuer"`
+	IssuanceDate      time.Time         `json:"issuanceDate"`
+	ExpirationDate    time.Time         `json:"expirationDate"`
+	CredentialSubject complianceSubject `json:"credentialSubject"`
+}
+
+// complianceSubject is the current compliance of a service
+type complianceSubject struct {
+	// ID is the ID of the service
+	ID        string `json:"id"`
+	Compliant bool   `json:"compliant"`
+	// EvaluationWindow is the period of time, in which the covered evaluation results have been created
+	EvaluationWindow evaluationWindow    `json:"evaluationWindow"`
+	Controls         []credentialControl `json:"controls"`
+}
+
+type evaluationWindow struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
+
+type credentialControl struct {
+	ID          string             `json:"id"`
+	Compliant   bool               `json:"compliant"`
+	EvaluatedAt time.Time          `json:"evaluatedAt"`
+	Metrics     []credentialMetric `json:"metrics"`
+}
+
+type credentialMetric struct {
+	ID          string    `json:"id"`
+	Compliant   bool      `json:"compliant"`
+	EvaluatedAt time.Time `json:"evaluatedAt"`
+	EvidenceID  string    `json:"evidenceId"`
+	// EvidenceHash is the hash of the evidence in the hash chain of the service
+	EvidenceHash string `json:"evidenceHash"`
+}
+
+// WithCredentialIssuer is an option to issue compliance credentials as the issuer (e.g., a DID of the operator of
+// CAM), which are signed with the private key. The key ID is included in the credentials and identifies the key in the
+// issuer keys. The key should be checked with CheckCredentialIssuerKey before, since an unusable key is only logged.
+func WithCredentialIssuer(issuer string, key crypto.PrivateKey, keyID string) service.ServiceOption[Server] {
+	return func(srv *Server) {
+		method, pub, err := credentialSigningMethod(key)
+		if err != nil {
+			log.Errorf("Could not use credential issuer key: %v", err)
+			return
+		}
+
+		srv.credentialIssuer = issuer
+		srv.credentialKey = key
+		srv.credentialKeyID = keyID
+		srv.credentialMethod = method
+		srv.addCredentialKey(keyID, pub)
+	}
+}
+
+// CheckCredentialIssuerKey checks that compliance credentials can be signed with the private key
+func CheckCredentialIssuerKey(key crypto.PrivateKey) (err error) {
+	_, _, err = credentialSigningMethod(key)
+
+	return
+}
+
+// credentialSigningMethod returns the JWT signing method and the public key of the private key
+func credentialSigningMethod(key crypto.PrivateKey) (method jwt.SigningMethod, pub crypto.PublicKey, err error) {
+	pub, err = signing.PublicKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	alg, err := signing.Algorithm(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	method = jwt.GetSigningMethod(string(alg))
+	if method == nil {
+		return nil, nil, fmt.Errorf("%w: no JWT signing method for %s", signing.ErrUnsupportedKey, alg)
+	}
+
+	return method, pub, nil
+}
+
+// WithTrustedCredentialKeys is an option to additionally verify compliance credentials with the public keys,
+// indexed by their key ID, e.g., previous keys of the issuer after a key rotation
+func WithTrustedCredentialKeys(keys map[string]crypto.PublicKey) service.ServiceOption[Server] {
+	return func(srv *Server) {
+		for kid, key := range keys {
+			srv.addCredentialKey(kid, key)
+		}
+	}
+}
+
+// WithCredentialValidity is an option to set the validity period of compliance credentials
+func WithCredentialValidity(validity time.Duration) service.ServiceOption[Server] {
+	return func(srv *Server) {
+		srv.credentialValidity = validity
+	}
+}
+
+func (srv *Server) addCredentialKey(kid string, key crypto.PublicKey) {
+	if srv.credentialKeys == nil {
+		srv.credentialKeys = make(map[string]crypto.PublicKey)
+	}
+
+	srv.credentialKeys[kid] = key
+}
+
+// IssueComplianceCredential issues a compliance credential over the current compliance of the service, i.e., the most
+// recent compliance result of each control. It contains the evaluation results of each control and the hashes of
+// their evidences.
+func (srv *Server) IssueComplianceCredential(ctx context.Context, req *evaluation.IssueComplianceCredentialRequest) (
+	res *evaluation.ComplianceCredential, err error) {
+	if srv.credentialKey == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no credential issuer key configured")
+	}
+	if req.ServiceId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Service ID is missing")
+	}
+
+	compliances, err := srv.currentCompliance(ctx, req.ServiceId, req.ControlIds)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: %v", DatabaseErrorMsg, err)
+	}
+	if len(compliances) == 0 {
+		return nil, status.Error(codes.NotFound, ComplianceResultNotFoundErrorMsg)
+	}
+
+	subject, err := srv.complianceSubject(req.ServiceId, compliances)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		id       = "urn:uuid:" + uuid.NewString()
+		now      = time.Now().UTC().Truncate(time.Second)
+		validity = srv.credentialValidity
+	)
+
+	if validity <= 0 {
+		validity = DefaultCredentialValidity
+	}
+
+	claims := credentialClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        id,
+			Issuer:    srv.credentialIssuer,
+			Subject:   req.ServiceId,
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(validity)),
+		},
+		VC: verifiableCredential{
+			Context:           credentialContext,
+			ID:                id,
+			Type:              []string{"VerifiableCredential", "ComplianceCredential"},
+			Issuer:            srv.credentialIssuer,
+			IssuanceDate:      now,
+			ExpirationDate:    now.Add(validity),
+			CredentialSubject: *subject,
+		},
+	}
+
+	jwtToken := jwt.NewWithClaims(srv.credentialMethod, claims)
+	jwtToken.Header["kid"] = srv.credentialKeyID
+
+	token, err := jwtToken.SignedString(srv.credentialKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not sign credential: %v", err)
+	}
+
+	log.Infof("Issued compliance credential %s for service %s (compliant: %v)", id, req.ServiceId, subject.Compliant)
+
+	return &evaluation.ComplianceCredential{
+		Id:         id,
+		ServiceId:  req.ServiceId,
+		Credential: token,
+		IssuedAt:   timestamppb.New(now),
+		ExpiresAt:  timestamppb.New(now.Add(validity)),
+	}, nil
+}
+
+// currentCompliance returns the most recent compliance result of each control of the service. If controlIDs is not
+// empty, only these controls are considered.
+func (srv *Server) currentCompliance(ctx context.Context, serviceID string, controlIDs []string) (
+	current []*evaluation.Compliance, err error) {
+	var (
+		list  []*evaluation.Compliance
+		seen  = make(map[string]bool)
+		query = "service_id = ? AND time = (SELECT MAX(c.time) FROM compliances c " +
+			"WHERE c.service_id = compliances.service_id AND c.control_id = compliances.control_id)"
+		args = []interface{}{serviceID}
+	)
+
+	// Only the most recent compliance result of each control is retrieved, instead of the whole history
+	if len(controlIDs) > 0 {
+		query += " AND control_id IN ?"
+		args = append(args, controlIDs)
+	}
+
+	err = srv.storage.List(&list, "time", false, 0, -1,
+		authz.TenantConds(ctx, append([]interface{}{query}, args...)...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Results of a control with the same time are reduced to one
+	for _, c := range list {
+		if seen[c.ControlId] {
+			continue
+		}
+
+		seen[c.ControlId] = true
+		current = append(current, c)
+	}
+
+	sort.Slice(current, func(i, j int) bool {
+		return current[i].ControlId < current[j].ControlId
+	})
+
+	return
+}
+
+// complianceSubject creates the credential subject out of the compliance results
+func (srv *Server) complianceSubject(serviceID string, compliances []*evaluation.Compliance) (
+	subject *complianceSubject, err error) {
+	subject = &complianceSubject{ID: serviceID, Compliant: true}
+
+	for _, c := range compliances {
+		control := credentialControl{
+			ID:          c.ControlId,
+			Compliant:   c.Status,
+			EvaluatedAt: c.Time.AsTime(),
+			Metrics:     []credentialMetric{},
+		}
+
+		for _, eval := range c.Evaluations {
+			hash, err := srv.evidenceHash(eval.EvidenceId)
+			if err != nil {
+				return nil, err
+			}
+
+			control.Metrics = append(control.Metrics, credentialMetric{
+				ID:           eval.MetricId,
+				Compliant:    eval.Status,
+				EvaluatedAt:  eval.Time.AsTime(),
+				EvidenceID:   eval.EvidenceId,
+				EvidenceHash: hash,
+			})
+
+			subject.EvaluationWindow.extend(eval.Time.AsTime())
+		}
+
+		subject.Compliant = subject.Compliant && c.Status
+		subject.Controls = append(subject.Controls, control)
+	}
+
+	return
+}
+
+// evidenceHash returns the hash of the evidence in the hash chain. Evidences stored before the introduction of the
+// chain are hashed on the fly.
+func (srv *Server) evidenceHash(evidenceID string) (hash string, err error) {
+	e, err := srv.getEvidence(evidenceID)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return "", status.Errorf(codes.FailedPrecondition, "%s: %s", EvidenceNotFoundErrorMsg, evidenceID)
+	} else if err != nil {
+		return "", status.Errorf(codes.Internal, "%s: %v", DatabaseErrorMsg, err)
+	}
+
+	if e.Hash != "" {
+		return e.Hash, nil
+	}
+
+	hash, err = e.ChainHash()
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "could not hash evidence: %v", err)
+	}
+
+	return
+}
+
+// extend extends the window to include t
+func (w *evaluationWindow) extend(t time.Time) {
+	if w.From.IsZero() || t.Before(w.From) {
+		w.From = t
+	}
+	if t.After(w.To) {
+		w.To = t
+	}
+}
+
+// VerifyComplianceCredential verifies the signature of the compliance credential with the issuer keys and checks its
+// validity period. Invalid credentials are reported in the response, not as error.
+func (srv *Server) VerifyComplianceCredential(_ context.Context, req *evaluation.VerifyComplianceCredentialRequest) (
+	res *evaluation.VerifyComplianceCredentialResponse, err error) {
+	var claims credentialClaims
+
+	res = new(evaluation.VerifyComplianceCredentialResponse)
+
+	if req.Credential == "" {
+		return nil, status.Error(codes.InvalidArgument, "credential is missing")
+	}
+
+	_, err = jwt.ParseWithClaims(req.Credential, &claims, srv.credentialKeyFunc)
+	if err != nil {
+		res.Reason = fmt.Sprintf("invalid credential: %v", err)
+		return res, nil
+	}
+
+	value, err := protobuf.ToValue(claims.VC)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not convert credential: %v", err)
+	}
+
+	res.Valid = true
+	res.VerifiableCredential = value.GetStructValue()
+
+	return res, nil
+}
+
+// credentialKeyFunc returns the issuer key, with which the credential has been signed, according to its key ID. The
+// algorithm of the credential must match the key, so that the signature cannot be verified with another algorithm.
+func (srv *Server) credentialKeyFunc(token *jwt.Token) (key interface{}, err error) {
+	kid, _ := token.Header["kid"].(string)
+
+	key, ok := srv.credentialKeys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown issuer key '%s'", kid)
+	}
+
+	alg, err := signing.Algorithm(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if token.Method.Alg() != string(alg) {
+		return nil, fmt.Errorf("algorithm %s does not match issuer key '%s'", token.Method.Alg(), kid)
+	}
+
+	return key, nil
+}
+
+// ListCredentialIssuerKeys lists the public keys, with which compliance credentials are verified, so that they can
+// also be verified outside of CAM
+func (srv *Server) ListCredentialIssuerKeys(_ context.Context, _ *evaluation.ListCredentialIssuerKeysRequest) (
+	res *evaluation.JSONWebKeySet, err error) {
+	res = new(evaluation.JSONWebKeySet)
+
+	for kid, key := range srv.credentialKeys {
+		jwk, err := newJWK(key, kid)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not convert key %s: %v", kid, err)
+		}
+
+		res.Keys = append(res.Keys, jwk)
+	}
+
+	sort.Slice(res.Keys, func(i, j int) bool {
+		return res.Keys[i].Kid < res.Keys[j].Kid
+	})
+
+	return res, nil
+}
+
+// newJWK returns the JSON Web Key (RFC 7517) of the public issuer key
+func newJWK(key crypto.PublicKey, kid string) (jwk *evaluation.JSONWebKey, err error) {
+	alg, err := signing.Algorithm(key)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := jose.JSONWebKey{Key: key, KeyID: kid, Algorithm: string(alg), Use: "sig"}.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	var params map[string]string
+	if err = json.Unmarshal(b, &params); err != nil {
+		return nil, err
+	}
+
+	return &evaluation.JSONWebKey{
+		Kty: params["kty"],
+		Kid: params["kid"],
+		Alg: params["alg"],
+		Use: params["use"],
+		Crv: params["crv"],
+		X:   params["x"],
+		Y:   params["y"],
+		N:   params["n"],
+		E:   params["e"],
+	}, nil
+}
@@ -0,0 +1,284 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package evaluation
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	cl_api_assessment "clouditor.io/clouditor/api/assessment"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eclipse-xfsc/cam/api/evaluation"
+	"github.com/eclipse-xfsc/cam/internal/signing"
+	"github.com/eclipse-xfsc/cam/internal/testutil"
+	"github.com/eclipse-xfsc/cam/service"
+)
+
+// newCompliantServer returns a server with a compliance result of Control1, which is based on one evidence
+func newCompliantServer(t *testing.T, opts ...service.ServiceOption[Server]) (srv *Server, evidenceHash string) {
+	srv = &Server{
+		storage:            testutil.NewInMemoryStorage(t),
+		requirementsSource: TestRequirementsSource,
+	}
+	for _, o := range opts {
+		o(srv)
+	}
+
+	evidence := newSignedEvidence(t, nil)
+	assert.NoError(t, srv.storeInChain(evidence))
+
+	srv.createEvaluationResult(&cl_api_assessment.AssessmentResult{
+		Id:         "10000000-0000-0000-0000-000000000001",
+		MetricId:   "Metric1",
+		Compliant:  true,
+		EvidenceId: evidence.Id,
+		ServiceId:  testutil.DefaultServiceID,
+	}, nil)
+
+	_, err := srv.CalculateCompliance(context.Background(), &evaluation.CalculateComplianceRequest{
+		ServiceId:  testutil.DefaultServiceID,
+		ControlIds: []string{"Control1"},
+	})
+	assert.NoError(t, err)
+
+	return srv, evidence.Hash
+}
+
+func TestServer_IssueComplianceCredential(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	srv, hash := newCompliantServer(t, WithCredentialIssuer("did:web:cam.example.com", key, "key-1"))
+
+	cred, err := srv.IssueComplianceCredential(context.Background(),
+		&evaluation.IssueComplianceCredentialRequest{ServiceId: testutil.DefaultServiceID})
+	assert.NoError(t, err)
+	assert.Equal(t, testutil.DefaultServiceID, cred.ServiceId)
+	assert.True(t, strings.HasPrefix(cred.Id, "urn:uuid:"))
+	assert.Equal(t, DefaultCredentialValidity, cred.ExpiresAt.AsTime().Sub(cred.IssuedAt.AsTime()))
+
+	res, err := srv.VerifyComplianceCredential(context.Background(),
+		&evaluation.VerifyComplianceCredentialRequest{Credential: cred.Credential})
+	assert.NoError(t, err)
+	assert.True(t, res.Valid, res.Reason)
+
+	vc := res.VerifiableCredential.AsMap()
+	assert.Equal(t, "did:web:cam.example.com", vc["issuer"])
+	assert.Equal(t, []interface{}{"VerifiableCredential", "ComplianceCredential"}, vc["type"])
+
+	subject := vc["credentialSubject"].(map[string]interface{})
+	assert.Equal(t, testutil.DefaultServiceID, subject["id"])
+	assert.Equal(t, true, subject["compliant"])
+	assert.NotEmpty(t, subject["evaluationWindow"])
+
+	controls := subject["controls"].([]interface{})
+	assert.Len(t, controls, 1)
+
+	metrics := controls[0].(map[string]interface{})["metrics"].([]interface{})
+	assert.Len(t, metrics, 1)
+	assert.Equal(t, "Metric1", metrics[0].(map[string]interface{})["id"])
+	assert.Equal(t, hash, metrics[0].(map[string]interface{})["evidenceHash"])
+
+	// Only controls with a compliance result can be covered
+	_, err = srv.IssueComplianceCredential(context.Background(), &evaluation.IssueComplianceCredentialRequest{
+		ServiceId:  testutil.DefaultServiceID,
+		ControlIds: []string{"Control2"},
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Without issuer key, no credentials are issued
+	srv, _ = newCompliantServer(t)
+	_, err = srv.IssueComplianceCredential(context.Background(),
+		&evaluation.IssueComplianceCredentialRequest{ServiceId: testutil.DefaultServiceID})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestCheckCredentialIssuerKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	assert.NoError(t, CheckCredentialIssuerKey(key))
+
+	// There is no JWS algorithm for P-224
+	key, err = ecdsa.GenerateKey(elliptic.P224(), rand.Reader)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, CheckCredentialIssuerKey(key), signing.ErrUnsupportedKey)
+}
+
+func TestServer_currentCompliance(t *testing.T) {
+	srv := &Server{storage: testutil.NewInMemoryStorage(t)}
+	now := time.Now()
+
+	// The history of compliance results of two controls
+	for i, c := range []struct {
+		control string
+		status  bool
+		age     time.Duration
+	}{
+		{"Control1", false, 3 * time.Hour},
+		{"Control1", true, time.Hour},
+		{"Control1", false, 2 * time.Hour},
+		{"Control2", false, time.Hour},
+		{"Control2", true, 2 * time.Hour},
+	} {
+		assert.NoError(t, srv.storage.Create(&evaluation.Compliance{
+			Id:        fmt.Sprintf("00000000-0000-0000-0000-00000000000%d", i),
+			ServiceId: testutil.DefaultServiceID,
+			ControlId: c.control,
+			Status:    c.status,
+			Time:      timestamppb.New(now.Add(-c.age)),
+		}))
+	}
+
+	current, err := srv.currentCompliance(context.Background(), testutil.DefaultServiceID, nil)
+	assert.NoError(t, err)
+	assert.Len(t, current, 2)
+	assert.Equal(t, "Control1", current[0].ControlId)
+	assert.True(t, current[0].Status)
+	assert.Equal(t, "Control2", current[1].ControlId)
+	assert.False(t, current[1].Status)
+
+	current, err = srv.currentCompliance(context.Background(), testutil.DefaultServiceID, []string{"Control2"})
+	assert.NoError(t, err)
+	assert.Len(t, current, 1)
+	assert.Equal(t, "Control2", current[0].ControlId)
+
+	// Compliance results of other tenants are not considered
+	current, err = srv.currentCompliance(auditorOf("other"), testutil.DefaultServiceID, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, current)
+}
+
+func TestServer_VerifyComplianceCredential(t *testing.T) {
+	oldKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	_, newKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	// issue returns a credential of the issuer with the key
+	issue := func(key crypto.PrivateKey, kid string, validity time.Duration) string {
+		srv, _ := newCompliantServer(t, WithCredentialIssuer("cam", key, kid), WithCredentialValidity(validity))
+
+		cred, err := srv.IssueComplianceCredential(context.Background(),
+			&evaluation.IssueComplianceCredentialRequest{ServiceId: testutil.DefaultServiceID})
+		assert.NoError(t, err)
+
+		return cred.Credential
+	}
+
+	// The issuer key has been rotated, the old key is still trusted
+	srv := NewServer(
+		WithStorage(testutil.NewInMemoryStorage(t)),
+		WithEvidenceReportInterval(0),
+		WithCredentialIssuer("cam", newKey, "key-2"),
+		WithTrustedCredentialKeys(map[string]crypto.PublicKey{"key-1": &oldKey.PublicKey}),
+	)
+
+	unknownKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	// The service claims to be compliant with the controls, although it is not
+	parts := strings.Split(issue(newKey, "key-2", 0), ".")
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	assert.NoError(t, err)
+	payload = bytes.ReplaceAll(payload, []byte(`"compliant":true`), []byte(`"compliant":false`))
+	tampered := parts[0] + "." + base64.RawURLEncoding.EncodeToString(payload) + "." + parts[2]
+
+	tests := []struct {
+		name       string
+		credential string
+		wantValid  bool
+		wantReason string
+	}{
+		{
+			name:       "current key",
+			credential: issue(newKey, "key-2", 0),
+			wantValid:  true,
+		},
+		{
+			name:       "previous key",
+			credential: issue(oldKey, "key-1", 0),
+			wantValid:  true,
+		},
+		{
+			name:       "unknown key",
+			credential: issue(unknownKey, "key-3", 0),
+			wantReason: "unknown issuer key",
+		},
+		{
+			name:       "wrong key",
+			credential: issue(unknownKey, "key-1", 0),
+			wantReason: "verification error",
+		},
+		{
+			name:       "algorithm of other key",
+			credential: issue(newKey, "key-1", 0),
+			wantReason: "algorithm EdDSA does not match issuer key 'key-1'",
+		},
+		{
+			name:       "tampered",
+			credential: tampered,
+			wantReason: "verification error",
+		},
+		{
+			name:       "expired",
+			credential: issue(newKey, "key-2", time.Nanosecond),
+			wantReason: "expired",
+		},
+		{
+			name:       "malformed",
+			credential: "not a credential",
+			wantReason: "invalid number of segments",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := srv.VerifyComplianceCredential(context.Background(),
+				&evaluation.VerifyComplianceCredentialRequest{Credential: tt.credential})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantValid, res.Valid)
+			assert.Contains(t, res.Reason, tt.wantReason)
+		})
+	}
+
+	keys, err := srv.ListCredentialIssuerKeys(context.Background(), &evaluation.ListCredentialIssuerKeysRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, keys.Keys, 2)
+	assert.Equal(t, "key-1", keys.Keys[0].Kid)
+	assert.Equal(t, "ES256", keys.Keys[0].Alg)
+	assert.Equal(t, "EC", keys.Keys[0].Kty)
+	assert.Equal(t, "P-256", keys.Keys[0].Crv)
+	assert.Equal(t, "sig", keys.Keys[0].Use)
+	// Coordinates of EC keys have a fixed length
+	assert.Len(t, keys.Keys[0].X, 43)
+	assert.Len(t, keys.Keys[0].Y, 43)
+	assert.Equal(t, "OKP", keys.Keys[1].Kty)
+	assert.Equal(t, "Ed25519", keys.Keys[1].Crv)
+	assert.Equal(t, "EdDSA", keys.Keys[1].Alg)
+}
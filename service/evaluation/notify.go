@@ -0,0 +1,56 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package evaluation
+
+import (
+	"github.com/eclipse-xfsc/cam/api/evaluation"
+	"github.com/eclipse-xfsc/cam/internal/notification"
+	"github.com/eclipse-xfsc/cam/service"
+)
+
+// WithNotifier is an option to notify about changes of the compliance status of controls using the dispatcher
+func WithNotifier(notifier *notification.Dispatcher) service.ServiceOption[Server] {
+	return func(srv *Server) {
+		srv.notifier = notifier
+	}
+}
+
+// previousCompliance returns the most recent compliance result of the control of the service or nil, if there is none
+func (srv *Server) previousCompliance(serviceID, controlID, tenantID string) (*evaluation.Compliance, error) {
+	var list []*evaluation.Compliance
+
+	err := srv.storage.List(&list, "time", false, 0, 1, "service_id = ? AND control_id = ? AND tenant_id = ?",
+		serviceID, controlID, tenantID)
+	if err != nil || len(list) == 0 {
+		return nil, err
+	}
+
+	return list[0], nil
+}
+
+// notifyChange notifies about the compliance result, if its status differs from the previous result. The first result
+// of a control is no change.
+func (srv *Server) notifyChange(previous *evaluation.Compliance, compliance *evaluation.Compliance) {
+	if previous == nil || previous.Status == compliance.Status {
+		return
+	}
+
+	log.Infof("Compliance status of control %s of service %s changed to %v", compliance.ControlId,
+		compliance.ServiceId, compliance.Status)
+
+	srv.notifier.Notify(notification.NewComplianceChangedEvent(compliance.ServiceId, compliance.ControlId,
+		compliance.TenantId, compliance.Status, compliance.Id, previous.Id))
+}
@@ -0,0 +1,109 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package evaluation
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eclipse-xfsc/cam/api/evaluation"
+	"github.com/eclipse-xfsc/cam/internal/notification"
+	"github.com/eclipse-xfsc/cam/internal/testutil"
+)
+
+func TestServer_notifyChange(t *testing.T) {
+	var (
+		secret = []byte("my-secret")
+		mutex  sync.Mutex
+		events []*notification.Event
+	)
+
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.True(t, notification.VerifySignature(secret, r.Header.Get(notification.HeaderTimestamp), body,
+			r.Header.Get(notification.HeaderSignature)))
+
+		var e notification.Event
+		assert.NoError(t, json.Unmarshal(body, &e))
+
+		mutex.Lock()
+		events = append(events, &e)
+		mutex.Unlock()
+	}))
+	defer hook.Close()
+
+	notifier := notification.NewDispatcher(nil, 1, 0, notification.NewWebhook(hook.URL, secret))
+
+	srv := &Server{
+		storage:            testutil.NewInMemoryStorage(t),
+		requirementsSource: TestRequirementsSource,
+		notifier:           notifier,
+	}
+
+	// evaluate stores an evaluation result of Metric1 and calculates the compliance of Control1
+	evaluate := func(compliant bool) *evaluation.Compliance {
+		assert.NoError(t, srv.storage.Create(&evaluation.EvaluationResult{
+			Id:        uuid.NewString(),
+			ServiceId: testutil.DefaultServiceID,
+			MetricId:  "Metric1",
+			Status:    compliant,
+			Time:      timestamppb.Now(),
+			TenantId:  "alice",
+		}))
+
+		compliance, err := srv.calculateComplianceInternal(context.Background(), testutil.DefaultServiceID, "Control1", "alice")
+		assert.NoError(t, err)
+
+		// Compliance results are ordered by time
+		time.Sleep(time.Millisecond)
+
+		return compliance
+	}
+
+	evaluate(true)
+	evaluate(true)
+	compliant := evaluate(true)
+	nonCompliant := evaluate(false)
+	evaluate(false)
+	compliantAgain := evaluate(true)
+
+	notifier.Close()
+
+	// Only the changes are notified, not the first result
+	assert.Len(t, events, 2)
+
+	assert.Equal(t, notification.EventComplianceChanged, events[0].Type)
+	assert.Equal(t, testutil.DefaultServiceID, events[0].ServiceID)
+	assert.Equal(t, "Control1", events[0].ControlID)
+	assert.Equal(t, "alice", events[0].TenantID)
+	assert.False(t, events[0].Compliant)
+	assert.True(t, events[0].PreviouslyCompliant)
+	assert.Equal(t, nonCompliant.Id, events[0].ComplianceID)
+	assert.Equal(t, compliant.Id, events[0].PreviousComplianceID)
+
+	assert.True(t, events[1].Compliant)
+	assert.Equal(t, compliantAgain.Id, events[1].ComplianceID)
+}
@@ -0,0 +1,142 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package evaluation
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"clouditor.io/clouditor/api"
+	"google.golang.org/grpc"
+
+	"github.com/eclipse-xfsc/cam/api/configuration"
+)
+
+// DefaultEvidenceReportInterval is the default interval, in which the number of received evidences is reported to the
+// Requirements Manager
+const DefaultEvidenceReportInterval = 10 * time.Second
+
+// reportKey identifies the evidences of a collection module for a service, which were gathered in a collection run
+type reportKey struct {
+	serviceID string
+	moduleID  string
+	runID     string
+}
+
+// evidenceReporter counts the received evidences per service, collection module and run and periodically reports them to
+// the Requirements Manager, which shows them in the monitoring status. Counts that could not be reported are kept for
+// the next report.
+type evidenceReporter struct {
+	mutex  sync.Mutex
+	counts map[reportKey]int64
+
+	// dial creates the connection to the Requirements Manager
+	dial func() (*grpc.ClientConn, error)
+	conn *grpc.ClientConn
+}
+
+// add counts an evidence of the collection module for the service, which was gathered in the run
+func (r *evidenceReporter) add(serviceID string, moduleID string, runID string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.counts[reportKey{serviceID, moduleID, runID}]++
+}
+
+// run reports the counts in the given interval until ctx is done. Reporting is disabled, if the interval is not
+// positive.
+func (r *evidenceReporter) run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.flush(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// flush reports the counts to the Requirements Manager
+func (r *evidenceReporter) flush(ctx context.Context) {
+	r.mutex.Lock()
+	counts := r.counts
+	r.counts = make(map[reportKey]int64)
+	r.mutex.Unlock()
+
+	if len(counts) == 0 {
+		return
+	}
+
+	client, err := r.client()
+	if err != nil {
+		log.Warnf("Could not connect to Requirements Manager to report evidences: %v", err)
+		r.restore(counts)
+		return
+	}
+
+	for key, count := range counts {
+		_, err = client.ReportEvidences(ctx, &configuration.ReportEvidencesRequest{
+			ServiceId: key.serviceID,
+			ModuleId:  key.moduleID,
+			Count:     count,
+			RunId:     key.runID,
+		})
+		if err != nil {
+			log.Debugf("Could not report evidences of collection module %s: %v", key.moduleID, err)
+			r.restore(map[reportKey]int64{key: count})
+		}
+	}
+}
+
+// restore adds counts, which could not be reported, to the current counts
+func (r *evidenceReporter) restore(counts map[reportKey]int64) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for key, count := range counts {
+		r.counts[key] += count
+	}
+}
+
+// client returns a client for the Requirements Manager. The connection is created once and re-used.
+func (r *evidenceReporter) client() (client configuration.ConfigurationClient, err error) {
+	if r.conn == nil {
+		if r.conn, err = r.dial(); err != nil {
+			return
+		}
+	}
+
+	return configuration.NewConfigurationClient(r.conn), nil
+}
+
+// newEvidenceReporter creates a reporter, which reports to the Requirements Manager of the server
+func (srv *Server) newEvidenceReporter() *evidenceReporter {
+	return &evidenceReporter{
+		counts: make(map[reportKey]int64),
+		dial: func() (*grpc.ClientConn, error) {
+			return grpc.Dial(srv.reqManagerAddress.target,
+				api.DefaultGrpcDialOptions(srv.reqManagerAddress.target, srv, srv.reqManagerAddress.opts...)...)
+		},
+	}
+}
@@ -0,0 +1,114 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package evaluation
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/eclipse-xfsc/cam/api/configuration"
+)
+
+// mockConfigurationServer records the reported evidences. It fails, as long as failing is set.
+type mockConfigurationServer struct {
+	configuration.UnimplementedConfigurationServer
+
+	mutex   sync.Mutex
+	failing bool
+	counts  map[reportKey]int64
+}
+
+func (m *mockConfigurationServer) ReportEvidences(_ context.Context, req *configuration.ReportEvidencesRequest) (
+	*emptypb.Empty, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.failing {
+		return nil, status.Error(codes.Unavailable, "not ready")
+	}
+
+	m.counts[reportKey{req.ServiceId, req.ModuleId, req.RunId}] += req.Count
+
+	return &emptypb.Empty{}, nil
+}
+
+func Test_evidenceReporter_flush(t *testing.T) {
+	var (
+		lis  = bufconn.Listen(1024 * 1024)
+		mock = &mockConfigurationServer{failing: true, counts: make(map[reportKey]int64)}
+		dial = func() (*grpc.ClientConn, error) {
+			return grpc.Dial("bufnet",
+				grpc.WithTransportCredentials(insecure.NewCredentials()),
+				grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+					return lis.DialContext(ctx)
+				}))
+		}
+		ctx = context.Background()
+	)
+
+	srv := grpc.NewServer()
+	configuration.RegisterConfigurationServer(srv, mock)
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	t.Cleanup(srv.Stop)
+
+	r := &evidenceReporter{counts: make(map[reportKey]int64)}
+
+	// Nothing is reported, if the connection cannot be established
+	r.dial = func() (*grpc.ClientConn, error) {
+		return nil, errors.New("connection refused")
+	}
+	r.add("service-1", "CM1", "run-1")
+	r.flush(ctx)
+	assert.Equal(t, int64(1), r.counts[reportKey{"service-1", "CM1", "run-1"}])
+
+	// Counts are kept, if the Requirements Manager rejects the report
+	r.dial = dial
+	r.add("service-1", "CM1", "run-1")
+	r.add("service-1", "CM2", "run-1")
+	r.flush(ctx)
+	assert.Equal(t, int64(2), r.counts[reportKey{"service-1", "CM1", "run-1"}])
+	assert.Equal(t, int64(1), r.counts[reportKey{"service-1", "CM2", "run-1"}])
+	assert.Empty(t, mock.counts)
+
+	// All counts are reported once the Requirements Manager accepts the report
+	mock.mutex.Lock()
+	mock.failing = false
+	mock.mutex.Unlock()
+	r.add("service-2", "CM1", "run-1")
+	r.add("service-2", "CM1", "run-2")
+	r.flush(ctx)
+	assert.Empty(t, r.counts)
+	assert.Equal(t, map[reportKey]int64{
+		{"service-1", "CM1", "run-1"}: 2,
+		{"service-1", "CM2", "run-1"}: 1,
+		{"service-2", "CM1", "run-1"}: 1,
+		{"service-2", "CM1", "run-2"}: 1,
+	}, mock.counts)
+	assert.NoError(t, r.conn.Close())
+}
@@ -17,15 +17,21 @@ package evaluation
 
 import (
 	"context"
+	"crypto"
 	"errors"
 	"fmt"
 	"io"
+	"sync"
 	"time"
 
 	"golang.org/x/oauth2/clientcredentials"
 
 	"github.com/eclipse-xfsc/cam/api/common"
 	"github.com/eclipse-xfsc/cam/api/evaluation"
+	"github.com/eclipse-xfsc/cam/internal/authz"
+	"github.com/eclipse-xfsc/cam/internal/metrics"
+	"github.com/eclipse-xfsc/cam/internal/notification"
+	"github.com/eclipse-xfsc/cam/internal/tracing"
 	"github.com/eclipse-xfsc/cam/service"
 
 	"clouditor.io/clouditor/api"
@@ -36,8 +42,13 @@ import (
 	"clouditor.io/clouditor/policies"
 	cl_service "clouditor.io/clouditor/service"
 	cl_service_assessment "clouditor.io/clouditor/service/assessment"
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -56,6 +67,57 @@ var (
 	log = logrus.WithField("component", "evaluation")
 
 	DefaultRequirementsManagerAddress = grpcTarget{target: "127.0.0.1:50100"}
+
+	evidencesReceived = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cam_evidences_received_total",
+		Help: "Number of evidences received from the collection modules",
+	}, []string{"tool"})
+	evidencesLastReceived = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "cam_evidences_last_received_timestamp_seconds",
+		Help: "Time of the last evidence received from a collection module",
+	}, []string{"tool"})
+	evidencesRejected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cam_evidences_rejected_total",
+		Help: "Number of received evidences, which are invalid, whose signature is not valid or which could not be stored",
+	}, []string{"tool", "reason"})
+	evidencesStored = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cam_evidences_stored_total",
+		Help: "Number of evidences stored in the hash chain of their service",
+	}, []string{"tool"})
+	assessmentDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "cam_assessment_duration_seconds",
+		Help:    "Duration of the assessment of an evidence",
+		Buckets: metrics.DefaultBuckets,
+	}, []string{"tool"})
+	assessmentsFailed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cam_assessments_failed_total",
+		Help: "Number of evidences, which could not be assessed",
+	}, []string{"tool"})
+	evaluationResults = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cam_evaluation_results_total",
+		Help: "Number of evaluation results created out of assessment results",
+	}, []string{"status"})
+	complianceCalculations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cam_compliance_calculations_total",
+		Help: "Number of compliance calculations of controls by their result",
+	}, []string{"status"})
+	complianceDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "cam_compliance_calculation_duration_seconds",
+		Help:    "Duration of the compliance calculation of a control",
+		Buckets: metrics.DefaultBuckets,
+	})
+)
+
+const (
+	// Reasons for rejecting an evidence
+	rejectReasonInvalid   = "invalid"
+	rejectReasonSignature = "signature"
+	rejectReasonStorage   = "storage"
+
+	// Results of compliance calculations and evaluation results
+	statusCompliant    = "compliant"
+	statusNotCompliant = "not_compliant"
+	statusError        = "error"
 )
 
 type grpcTarget struct {
@@ -78,6 +140,31 @@ type Server struct {
 	storage persistence.Storage
 
 	authorizer api.Authorizer
+
+	// reporter reports the number of received evidences to the requirements manager in the interval reportInterval
+	reporter       *evidenceReporter
+	reportInterval time.Duration
+
+	// evidenceKeys contains the public keys of the collection modules, with which their evidences are verified
+	evidenceKeys map[string]crypto.PublicKey
+	// requireSignatures specifies whether unsigned evidences are rejected
+	requireSignatures bool
+
+	// chainMutex protects the hash chains of the evidences. It only serializes the evidences received by this process,
+	// so the Evaluation Manager must not be replicated.
+	chainMutex sync.Mutex
+
+	// credentialIssuer issues compliance credentials signed with credentialKey
+	credentialIssuer   string
+	credentialKey      crypto.PrivateKey
+	credentialKeyID    string
+	credentialMethod   jwt.SigningMethod
+	credentialValidity time.Duration
+	// credentialKeys contains the public keys, with which compliance credentials are verified, indexed by their key ID
+	credentialKeys map[string]crypto.PublicKey
+
+	// notifier notifies about changes of the compliance status of controls
+	notifier *notification.Dispatcher
 }
 
 // WithRequirementsManagerAddress is a Server option for setting the address of the Requirements Manager
@@ -104,6 +191,14 @@ func WithOAuth2Authorizer(config *clientcredentials.Config) service.ServiceOptio
 	}
 }
 
+// WithEvidenceReportInterval is an option to set the interval, in which the number of received evidences is reported
+// to the Requirements Manager
+func WithEvidenceReportInterval(interval time.Duration) service.ServiceOption[Server] {
+	return func(srv *Server) {
+		srv.reportInterval = interval
+	}
+}
+
 // SetAuthorizer implements UsesAuthorizer
 func (srv *Server) SetAuthorizer(auth api.Authorizer) {
 	srv.authorizer = auth
@@ -118,6 +213,7 @@ func (srv *Server) Authorizer() api.Authorizer {
 func NewServer(opts ...service.ServiceOption[Server]) (srv *Server) {
 	srv = &Server{
 		reqManagerAddress: DefaultRequirementsManagerAddress,
+		reportInterval:    DefaultEvidenceReportInterval,
 	}
 
 	// Apply any options
@@ -145,18 +241,18 @@ func NewServer(opts ...service.ServiceOption[Server]) (srv *Server) {
 
 	srv.requirementsSource = CachedRequirementsSource(srv.Service)
 
+	srv.reporter = srv.newEvidenceReporter()
+	go srv.reporter.run(context.Background(), srv.reportInterval)
+
 	return
 }
 
-// SendEvidences stores and evaluates evidences sent by a SendEvidencesClient via a stream
+// SendEvidences stores and evaluates evidences sent by a SendEvidencesClient via a stream. The signatures of the
+// evidences are verified and the evidences are appended to the hash chain of their service.
 func (srv *Server) SendEvidences(stream evaluation.Evaluation_SendEvidencesServer) (err error) {
 	var (
 		// Evidence in CAM format
 		evidence *common.Evidence
-		// Evidence in Clouditor format
-		clouditorEvidence *cl_api_evidence.Evidence
-		// Evidence including error is stored but not evaluated
-		isEvidenceWithError = false
 	)
 
 	// Loop through stream for receiving evidences
@@ -176,62 +272,107 @@ func (srv *Server) SendEvidences(stream evaluation.Evaluation_SendEvidencesServe
 			return
 		}
 
-		log.Infof("Received evidence %s from collection module %s", evidence.Id, evidence.ToolId)
-
-		// Validate evidence
-		// TODO(lebogg): Directly return since it is likely that the following evidences will also be invalid
-		if err = evidence.Validate(); err != nil {
-			if errors.Is(err, common.ErrEvidenceWithError) {
-				log.Info("Error contains error and, thus, is not evaluated.")
-				isEvidenceWithError = true
-			} else {
-				log.Errorf("Evidence is not valid: %v", err)
-				continue
-			}
-		}
+		// Errors of individual evidences are logged, since we still want to evaluate new incoming evidences
+		_ = srv.handleEvidence(evidence)
+	}
+}
 
-		// Use `AssessEvidence` of Clouditor's assessment service to evaluate the evidence
-		// First we transform a CAM evidence into a Clouditor evidence
-		clouditorEvidence = &cl_api_evidence.Evidence{
-			Id:        evidence.Id,
-			Timestamp: evidence.GatheredAt,
-			ServiceId: evidence.TargetService,
-			ToolId:    evidence.ToolId,
-			Raw:       evidence.RawEvidence,
-			Resource:  evidence.Value,
-		}
+// handleEvidence validates, stores and evaluates an evidence received from a collection module. The evidence is
+// traced as part of the trace of its collection, if the collection module propagated it.
+func (srv *Server) handleEvidence(evidence *common.Evidence) (err error) {
+	var (
+		// Evidence in Clouditor format
+		clouditorEvidence *cl_api_evidence.Evidence
+		// Evidence including error is stored but not evaluated
+		isEvidenceWithError = false
+	)
 
-		err = srv.storage.Create(evidence)
-		if err != nil {
-			err = fmt.Errorf("couldn't store evidence: %w", err)
-			log.Error(err)
-			continue
+	ctx, span := tracing.Start(tracing.ContextWithTraceParent(context.Background(), evidence.TraceParent),
+		"evaluation.ReceiveEvidence", trace.WithAttributes(attribute.String("evidence.id", evidence.Id),
+			attribute.String("service.id", evidence.TargetService), attribute.String("collection.tool", evidence.ToolId)))
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	log.Infof("Received evidence %s from collection module %s", evidence.Id, evidence.ToolId)
+	evidencesReceived.WithLabelValues(evidence.ToolId).Inc()
+	evidencesLastReceived.WithLabelValues(evidence.ToolId).SetToCurrentTime()
+
+	// Validate evidence
+	// TODO(lebogg): Directly return since it is likely that the following evidences will also be invalid
+	if err = evidence.Validate(); err != nil {
+		if errors.Is(err, common.ErrEvidenceWithError) {
+			log.Info("Error contains error and, thus, is not evaluated.")
+			isEvidenceWithError = true
+		} else {
+			log.Errorf("Evidence is not valid: %v", err)
+			evidencesRejected.WithLabelValues(evidence.ToolId, rejectReasonInvalid).Inc()
+			return
 		}
-		log.Tracef("Stored evidence: %v", evidence)
+	}
 
-		// Evidence that includes error won't be evaluated
-		if isEvidenceWithError {
-			isEvidenceWithError = false
-			continue
-		}
+	// Verify the signature of the collection module
+	if _, err = srv.verifySignature(evidence); err != nil {
+		log.Errorf("Signature of evidence %s of collection module %s is not valid: %v", evidence.Id,
+			evidence.ToolId, err)
+		evidencesRejected.WithLabelValues(evidence.ToolId, rejectReasonSignature).Inc()
+		return
+	}
 
-		// Use transformed evidence to evaluate evidence ("to assess" in Clouditor terminology).
-		// We discard response since it is used in the streaming case `AssessEvidences`
-		_, err = srv.Service.AssessEvidence(
-			context.TODO(),
-			&cl_api_assessment.AssessEvidenceRequest{Evidence: clouditorEvidence})
-		// Log error and continue since we still want to evaluate new incoming evidences
-		if err != nil {
-			err = fmt.Errorf("couldn't evaluate evidence: %w", err)
-			log.Error(err)
-			continue
-		}
-		log.Infof("Assessed new evidence")
+	// Use `AssessEvidence` of Clouditor's assessment service to evaluate the evidence
+	// First we transform a CAM evidence into a Clouditor evidence
+	clouditorEvidence = &cl_api_evidence.Evidence{
+		Id:        evidence.Id,
+		Timestamp: evidence.GatheredAt,
+		ServiceId: evidence.TargetService,
+		ToolId:    evidence.ToolId,
+		Raw:       evidence.RawEvidence,
+		Resource:  evidence.Value,
+	}
+
+	err = srv.storeInChain(evidence)
+	if err != nil {
+		err = fmt.Errorf("couldn't store evidence: %w", err)
+		log.Error(err)
+		evidencesRejected.WithLabelValues(evidence.ToolId, rejectReasonStorage).Inc()
+		return
+	}
+	log.Tracef("Stored evidence: %v", evidence)
+	evidencesStored.WithLabelValues(evidence.ToolId).Inc()
+	span.SetAttributes(attribute.Int64("evidence.sequence", evidence.Sequence))
+
+	if srv.reporter != nil {
+		srv.reporter.add(evidence.TargetService, evidence.ToolId, evidence.RunId)
+	}
+
+	// Evidence that includes error won't be evaluated
+	if isEvidenceWithError {
+		return nil
 	}
+
+	// Use transformed evidence to evaluate evidence ("to assess" in Clouditor terminology).
+	// We discard response since it is used in the streaming case `AssessEvidences`
+	start := time.Now()
+	ctx, assessment := tracing.Start(ctx, "assessment.AssessEvidence")
+	_, err = srv.Service.AssessEvidence(
+		ctx,
+		&cl_api_assessment.AssessEvidenceRequest{Evidence: clouditorEvidence})
+	tracing.End(assessment, err)
+	assessmentDuration.WithLabelValues(evidence.ToolId).Observe(time.Since(start).Seconds())
+	// Log error and return since we still want to evaluate new incoming evidences
+	if err != nil {
+		err = fmt.Errorf("couldn't evaluate evidence: %w", err)
+		log.Error(err)
+		assessmentsFailed.WithLabelValues(evidence.ToolId).Inc()
+		return
+	}
+	log.Infof("Assessed new evidence")
+
+	return
 }
 
-// GetEvidence returns the evidence given by evidence_id.
-func (srv *Server) GetEvidence(_ context.Context, req *evaluation.GetEvidenceRequest) (e *common.Evidence, err error) {
+// GetEvidence returns the evidence given by evidence_id, if the roles of the caller apply to its service.
+func (srv *Server) GetEvidence(ctx context.Context, req *evaluation.GetEvidenceRequest) (e *common.Evidence, err error) {
 	e, err = srv.getEvidence(req.EvidenceId)
 	// Check if error occurred and, if so, wrap it into an error in gRPC format
 	if errors.Is(err, persistence.ErrRecordNotFound) {
@@ -243,11 +384,19 @@ func (srv *Server) GetEvidence(_ context.Context, req *evaluation.GetEvidenceReq
 		err = status.Errorf(codes.Internal, "%s: %v", DatabaseErrorMsg, err)
 		return
 	}
+	if !authz.TenantAllowed(ctx, e.TenantId) {
+		err = status.Errorf(codes.NotFound, "%v: %v", EvidenceNotFoundErrorMsg, persistence.ErrRecordNotFound)
+		return nil, err
+	}
+	if !authz.ServiceAllowed(ctx, e.TargetService) {
+		return nil, status.Error(codes.PermissionDenied, "not permitted to access evidences of this service")
+	}
 	return
 }
 
-// ListEvidences returns evidences. The amount of evidences per request and the scope are determined by the query parameters.
-func (srv *Server) ListEvidences(_ context.Context, req *evaluation.ListEvidencesRequest) (
+// ListEvidences returns evidences of the tenant of the caller. The amount of evidences per request and the scope are
+// determined by the query parameters.
+func (srv *Server) ListEvidences(ctx context.Context, req *evaluation.ListEvidencesRequest) (
 	res *evaluation.ListEvidencesResponse, err error) {
 	res = new(evaluation.ListEvidencesResponse)
 	if req.ServiceId == "" {
@@ -271,7 +420,7 @@ func (srv *Server) ListEvidences(_ context.Context, req *evaluation.ListEvidence
 
 	res.Evidences, res.NextPageToken, err = cl_service.PaginateStorage[*common.Evidence](req, srv.storage,
 		cl_service.DefaultPaginationOpts,
-		"target_service = ? AND gathered_at BETWEEN ? AND ?", req.ServiceId, before, now)
+		authz.TenantConds(ctx, "target_service = ? AND gathered_at BETWEEN ? AND ?", req.ServiceId, before, now)...)
 	if err != nil {
 		err = status.Errorf(codes.Internal, "%s: %v", DatabaseErrorMsg, err)
 		return
@@ -280,13 +429,14 @@ func (srv *Server) ListEvidences(_ context.Context, req *evaluation.ListEvidence
 	return
 }
 
-// GetEvaluation returns the most recent evaluation result for given service and metric IDs
-func (srv *Server) GetEvaluation(_ context.Context, request *evaluation.GetEvaluationRequest) (eval *evaluation.EvaluationResult, err error) {
+// GetEvaluation returns the most recent evaluation result for given service and metric IDs of the tenant of the caller
+func (srv *Server) GetEvaluation(ctx context.Context, request *evaluation.GetEvaluationRequest) (eval *evaluation.EvaluationResult, err error) {
 	var list []*evaluation.EvaluationResult
 
 	// We need to use List because Get does not have any ordering. We limit the
 	// list to 1 (which is essentially what Get does anyway)
-	err = srv.storage.List(&list, "time", false, 0, 1, "metric_id = ? AND service_id = ?", request.MetricId, request.ServiceId)
+	err = srv.storage.List(&list, "time", false, 0, 1,
+		authz.TenantConds(ctx, "metric_id = ? AND service_id = ?", request.MetricId, request.ServiceId)...)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "database error: %v", err)
 	}
@@ -300,13 +450,14 @@ func (srv *Server) GetEvaluation(_ context.Context, request *evaluation.GetEvalu
 	return
 }
 
-// GetCompliance returns the most recent compliance result for a particular control of a service
-func (srv *Server) GetCompliance(_ context.Context, request *evaluation.GetComplianceRequest) (compliance *evaluation.Compliance, err error) {
+// GetCompliance returns the most recent compliance result for a particular control of a service of the tenant of the
+// caller
+func (srv *Server) GetCompliance(ctx context.Context, request *evaluation.GetComplianceRequest) (compliance *evaluation.Compliance, err error) {
 	var list []*evaluation.Compliance
 
 	// We need to use List because Get does not have any ordering. We limit the
 	// list to 1 (which is essentially what Get does anyway)
-	err = srv.storage.List(&list, "time", false, 0, 1, "service_id = ?", request.ServiceId)
+	err = srv.storage.List(&list, "time", false, 0, 1, authz.TenantConds(ctx, "service_id = ?", request.ServiceId)...)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "database error: %v", err)
 	}
@@ -320,8 +471,8 @@ func (srv *Server) GetCompliance(_ context.Context, request *evaluation.GetCompl
 	return
 }
 
-// ListCompliance returns all current compliance results for a particular service
-func (srv *Server) ListCompliance(_ context.Context, req *evaluation.ListComplianceRequest) (
+// ListCompliance returns all current compliance results for a particular service of the tenant of the caller
+func (srv *Server) ListCompliance(ctx context.Context, req *evaluation.ListComplianceRequest) (
 	res *evaluation.ListComplianceResponse, err error) {
 	res = new(evaluation.ListComplianceResponse)
 	days := req.Days
@@ -334,7 +485,7 @@ func (srv *Server) ListCompliance(_ context.Context, req *evaluation.ListComplia
 
 	res.ComplianceResults, res.NextPageToken, err = cl_service.PaginateStorage[*evaluation.Compliance](
 		req, srv.storage, cl_service.DefaultPaginationOpts,
-		"service_id = ? AND time BETWEEN ?  AND ?", req.ServiceId, before, now)
+		authz.TenantConds(ctx, "service_id = ? AND time BETWEEN ?  AND ?", req.ServiceId, before, now)...)
 	if err != nil {
 		err = status.Errorf(codes.Internal, "%s: %v", DatabaseErrorMsg, err)
 		return
@@ -344,10 +495,16 @@ func (srv *Server) ListCompliance(_ context.Context, req *evaluation.ListComplia
 }
 
 // CalculateCompliance triggers the compliance calculation for a particular services and a set of controls. This will
-// most likely be triggered by the Requirements Manager.
-func (srv *Server) CalculateCompliance(_ context.Context, req *evaluation.CalculateComplianceRequest) (empty *emptypb.Empty, err error) {
+// most likely be triggered by the Requirements Manager. Callers, which are restricted to a tenant, can only calculate the
+// compliance for their own tenant.
+func (srv *Server) CalculateCompliance(ctx context.Context, req *evaluation.CalculateComplianceRequest) (empty *emptypb.Empty, err error) {
+	tenantID := req.TenantId
+	if tenant, restricted := authz.TenantFromContext(ctx); restricted {
+		tenantID = tenant
+	}
+
 	for _, controlID := range req.ControlIds {
-		_, err = srv.calculateComplianceInternal(req.ServiceId, controlID)
+		_, err = srv.calculateComplianceInternal(ctx, req.ServiceId, controlID, tenantID)
 		if err != nil {
 			log.Errorf("Error while calculating compliance for service %s: %v. Compliance results will only be partially available.", req.ServiceId, err)
 		}
@@ -358,9 +515,23 @@ func (srv *Server) CalculateCompliance(_ context.Context, req *evaluation.Calcul
 	return
 }
 
-// calculateCompliance by checking the status of each evaluation result in newestResults
-func (srv *Server) calculateComplianceInternal(serviceID, controlID string) (compliance *evaluation.Compliance, err error) {
+// calculateCompliance by checking the status of each evaluation result in newestResults. The calculation is traced as
+// part of the trace in ctx, e.g., the one of the Requirements Manager triggering it, and linked to the traces of the
+// evaluation results.
+func (srv *Server) calculateComplianceInternal(ctx context.Context, serviceID, controlID, tenantID string) (
+	compliance *evaluation.Compliance, err error) {
 	log.Infof("Calculating compliance for service '%s' and control '%s'", serviceID, controlID)
+
+	start := time.Now()
+	_, span := tracing.Start(ctx, "evaluation.CalculateCompliance", trace.WithAttributes(
+		attribute.String("service.id", serviceID), attribute.String("control.id", controlID)))
+	defer func() {
+		complianceDuration.Observe(time.Since(start).Seconds())
+		complianceCalculations.WithLabelValues(complianceStatus(compliance, err)).Inc()
+		span.SetAttributes(attribute.String("compliance.status", complianceStatus(compliance, err)))
+		tracing.End(span, err)
+	}()
+
 	var requirements []*orchestrator.Requirement
 	var control *orchestrator.Requirement
 
@@ -372,6 +543,7 @@ func (srv *Server) calculateComplianceInternal(serviceID, controlID string) (com
 		Status:      true,
 		Time:        timestamppb.Now(),
 		ServiceId:   serviceID,
+		TenantId:    tenantID,
 	}
 
 	// Fetch requirements (controls) from our source
@@ -391,11 +563,18 @@ func (srv *Server) calculateComplianceInternal(serviceID, controlID string) (com
 		return nil, fmt.Errorf("control not found")
 	}
 
+	// Retrieve the previous compliance result to detect a change of the status
+	previous, err := srv.previousCompliance(serviceID, controlID, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("error while retrieving compliance result from storage: %w", err)
+	}
+
 	// For each metric (of this control), get latest evaluation result and calculate the compliance
 	// TODO(oxisto): We should actually get the latest evaluation result _per_ discovered resource
 	for _, m := range control.Metrics {
 		var result []*evaluation.EvaluationResult
-		err = srv.storage.List(&result, "time", false, 0, 1, "metric_id = ? AND service_id = ?", m.Id, serviceID)
+		err = srv.storage.List(&result, "time", false, 0, 1, "metric_id = ? AND service_id = ? AND tenant_id = ?",
+			m.Id, serviceID, tenantID)
 		if err != nil {
 			return nil, fmt.Errorf("error while retrieving evaluation result from storage: %w", err)
 		}
@@ -418,6 +597,10 @@ func (srv *Server) calculateComplianceInternal(serviceID, controlID string) (com
 		return nil, fmt.Errorf("database error: %w", err)
 	}
 	log.Debugf("Is compliant: %v", compliance.Status)
+
+	srv.notifyChange(previous, compliance)
+	traceCompliance(span, previous, compliance)
+
 	return
 }
 
@@ -443,13 +626,23 @@ func (srv *Server) createEvaluationResult(result *cl_api_assessment.AssessmentRe
 		return
 	}
 
+	// The evaluation result continues the trace of the evidence
+	ctx, span := tracing.Start(tracing.ContextWithTraceParent(context.Background(), evidence.TraceParent),
+		"evaluation.CreateEvaluationResult", trace.WithAttributes(attribute.String("evidence.id", evidence.Id),
+			attribute.String("metric.id", result.MetricId), attribute.Bool("compliant", result.Compliant)))
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	eval := &evaluation.EvaluationResult{
-		Id:         uuid.NewString(),
-		MetricId:   result.MetricId,
-		ServiceId:  evidence.TargetService,
-		EvidenceId: evidence.Id,
-		Status:     result.Compliant,
-		Time:       timestamppb.Now(),
+		Id:          uuid.NewString(),
+		MetricId:    result.MetricId,
+		ServiceId:   evidence.TargetService,
+		EvidenceId:  evidence.Id,
+		Status:      result.Compliant,
+		Time:        timestamppb.Now(),
+		TenantId:    evidence.TenantId,
+		TraceParent: tracing.TraceParent(ctx),
 	}
 
 	err = srv.storage.Create(&eval)
@@ -458,6 +651,21 @@ func (srv *Server) createEvaluationResult(result *cl_api_assessment.AssessmentRe
 		log.Errorf("Could not save result into database: %v", err)
 		return
 	}
+
+	evaluationResults.WithLabelValues(complianceStatus(eval, nil)).Inc()
+}
+
+// complianceStatus returns the status of a compliance or evaluation result for the metrics. It is statusError, if the
+// result could not be created due to err.
+func complianceStatus(result interface{ GetStatus() bool }, err error) string {
+	switch {
+	case err != nil:
+		return statusError
+	case result.GetStatus():
+		return statusCompliant
+	default:
+		return statusNotCompliant
+	}
 }
 
 // getEvidence returns the Evidence for a given evidenceID. Errors are returned with wrapped error constants s.t. the
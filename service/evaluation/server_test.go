@@ -275,7 +275,7 @@ func Test_Server_calculateComplianceInternal(t *testing.T) {
 				storage:            tt.fields.storage,
 			}
 
-			gotCompliance, err := s.calculateComplianceInternal(tt.args.serviceID, tt.args.controlID)
+			gotCompliance, err := s.calculateComplianceInternal(context.Background(), tt.args.serviceID, tt.args.controlID, "")
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Server.calculateCompliance() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -651,3 +651,9 @@ func TestServer_createEvaluationResult(t *testing.T) {
 		})
 	}
 }
+
+func Test_complianceStatus(t *testing.T) {
+	assert.Equal(t, statusCompliant, complianceStatus(&evaluation.Compliance{Status: true}, nil))
+	assert.Equal(t, statusNotCompliant, complianceStatus(&evaluation.EvaluationResult{Status: false}, nil))
+	assert.Equal(t, statusError, complianceStatus((*evaluation.Compliance)(nil), errors.New("database error")))
+}
@@ -0,0 +1,165 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package evaluation
+
+import (
+	"context"
+	"testing"
+
+	cl_api_assessment "clouditor.io/clouditor/api/assessment"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eclipse-xfsc/cam/api/common"
+	"github.com/eclipse-xfsc/cam/api/evaluation"
+	"github.com/eclipse-xfsc/cam/internal/authz"
+	"github.com/eclipse-xfsc/cam/internal/testutil"
+	"github.com/eclipse-xfsc/cam/service"
+)
+
+// auditorOf returns the context of an auditor of all services of the tenant
+func auditorOf(tenant string) context.Context {
+	return service.ContextWithClaims(context.Background(), &service.Claims{
+		Roles:    []string{string(authz.RoleAuditor)},
+		Services: []string{authz.AllServices},
+		Tenant:   tenant,
+	})
+}
+
+func TestServer_TenantIsolation(t *testing.T) {
+	var (
+		alice = auditorOf("alice")
+		bob   = auditorOf("bob")
+	)
+
+	srv := &Server{
+		storage:            testutil.NewInMemoryStorage(t),
+		requirementsSource: TestRequirementsSource,
+	}
+
+	// Evidences keep the tenant set by the collection module, evaluation results inherit it
+	evidence := &common.Evidence{
+		Id:            "00000000-0000-0000-0000-000000000001",
+		TargetService: testutil.DefaultServiceID,
+		TenantId:      "alice",
+		GatheredAt:    timestamppb.Now(),
+	}
+	assert.NoError(t, srv.storage.Create(evidence))
+
+	srv.createEvaluationResult(&cl_api_assessment.AssessmentResult{
+		Id:         "10000000-0000-0000-0000-000000000001",
+		MetricId:   "Metric1",
+		Compliant:  true,
+		EvidenceId: evidence.Id,
+		ServiceId:  testutil.DefaultServiceID,
+	}, nil)
+
+	// Compliance results are assigned to the tenant of the request
+	_, err := srv.CalculateCompliance(context.Background(), &evaluation.CalculateComplianceRequest{
+		ServiceId:  testutil.DefaultServiceID,
+		ControlIds: []string{"Control1"},
+		TenantId:   "alice",
+	})
+	assert.NoError(t, err)
+
+	// Alice sees everything
+	e, err := srv.GetEvidence(alice, &evaluation.GetEvidenceRequest{EvidenceId: evidence.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", e.TenantId)
+
+	evidences, err := srv.ListEvidences(alice, &evaluation.ListEvidencesRequest{ServiceId: testutil.DefaultServiceID})
+	assert.NoError(t, err)
+	assert.Len(t, evidences.Evidences, 1)
+
+	eval, err := srv.GetEvaluation(alice, &evaluation.GetEvaluationRequest{
+		ServiceId: testutil.DefaultServiceID,
+		MetricId:  "Metric1",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", eval.TenantId)
+
+	compliance, err := srv.GetCompliance(alice, &evaluation.GetComplianceRequest{ServiceId: testutil.DefaultServiceID})
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", compliance.TenantId)
+	assert.Len(t, compliance.Evaluations, 1)
+
+	list, err := srv.ListCompliance(alice, &evaluation.ListComplianceRequest{ServiceId: testutil.DefaultServiceID})
+	assert.NoError(t, err)
+	assert.Len(t, list.ComplianceResults, 1)
+
+	// Bob sees nothing of the same service
+	_, err = srv.GetEvidence(bob, &evaluation.GetEvidenceRequest{EvidenceId: evidence.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	evidences, err = srv.ListEvidences(bob, &evaluation.ListEvidencesRequest{ServiceId: testutil.DefaultServiceID})
+	assert.NoError(t, err)
+	assert.Empty(t, evidences.Evidences)
+
+	_, err = srv.GetEvaluation(bob, &evaluation.GetEvaluationRequest{
+		ServiceId: testutil.DefaultServiceID,
+		MetricId:  "Metric1",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = srv.GetCompliance(bob, &evaluation.GetComplianceRequest{ServiceId: testutil.DefaultServiceID})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	list, err = srv.ListCompliance(bob, &evaluation.ListComplianceRequest{ServiceId: testutil.DefaultServiceID})
+	assert.NoError(t, err)
+	assert.Empty(t, list.ComplianceResults)
+
+	// Bob cannot calculate the compliance in the name of alice and his compliance does not include her results
+	_, err = srv.CalculateCompliance(bob, &evaluation.CalculateComplianceRequest{
+		ServiceId:  testutil.DefaultServiceID,
+		ControlIds: []string{"Control1"},
+		TenantId:   "alice",
+	})
+	assert.NoError(t, err)
+
+	compliance, err = srv.GetCompliance(bob, &evaluation.GetComplianceRequest{ServiceId: testutil.DefaultServiceID})
+	assert.NoError(t, err)
+	assert.Equal(t, "bob", compliance.TenantId)
+	assert.Empty(t, compliance.Evaluations)
+
+	list, err = srv.ListCompliance(alice, &evaluation.ListComplianceRequest{ServiceId: testutil.DefaultServiceID})
+	assert.NoError(t, err)
+	assert.Len(t, list.ComplianceResults, 1)
+}
+
+func TestServer_TenantIsolation_unsecured(t *testing.T) {
+	srv := &Server{storage: testutil.NewInMemoryStorage(t)}
+
+	assert.NoError(t, srv.storage.Create(&common.Evidence{
+		Id:            "00000000-0000-0000-0000-000000000001",
+		TargetService: testutil.DefaultServiceID,
+		TenantId:      "alice",
+		GatheredAt:    timestamppb.Now(),
+	}))
+
+	// Without claims, e.g., if the API is not secured, all tenants are visible
+	evidences, err := srv.ListEvidences(context.Background(),
+		&evaluation.ListEvidencesRequest{ServiceId: testutil.DefaultServiceID})
+	assert.NoError(t, err)
+	assert.Len(t, evidences.Evidences, 1)
+
+	// Callers without tenant only see data without tenant
+	evidences, err = srv.ListEvidences(auditorOf(""),
+		&evaluation.ListEvidencesRequest{ServiceId: testutil.DefaultServiceID})
+	assert.NoError(t, err)
+	assert.Empty(t, evidences.Evidences)
+}
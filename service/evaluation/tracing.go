@@ -0,0 +1,48 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package evaluation
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/eclipse-xfsc/cam/api/evaluation"
+	"github.com/eclipse-xfsc/cam/internal/tracing"
+)
+
+// traceCompliance records the compliance calculation in the traces of the evaluation results, which are newer than
+// the previous compliance result. The compliance calculation is triggered independently of the evidences, so that
+// these spans complete the lifecycle of the evidences in their traces. They link to the span of the calculation.
+func traceCompliance(span trace.Span, previous *evaluation.Compliance, compliance *evaluation.Compliance) {
+	for _, result := range compliance.Evaluations {
+		if previous != nil && !result.Time.AsTime().After(previous.Time.AsTime()) {
+			continue
+		}
+
+		// Evaluation results without a trace, e.g., created before tracing was introduced, are skipped
+		if !tracing.SpanContextFromTraceParent(result.TraceParent).IsValid() {
+			continue
+		}
+
+		_, s := tracing.Start(tracing.ContextWithTraceParent(context.Background(), result.TraceParent),
+			"evaluation.IncludeInCompliance", trace.WithLinks(trace.Link{SpanContext: span.SpanContext()}),
+			trace.WithAttributes(attribute.String("compliance.id", compliance.Id),
+				attribute.String("control.id", compliance.ControlId), attribute.Bool("compliant", compliance.Status)))
+		s.End()
+	}
+}
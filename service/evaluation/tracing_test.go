@@ -0,0 +1,114 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Contributors:
+//	Fraunhofer AISEC
+
+package evaluation
+
+import (
+	"context"
+	"testing"
+
+	cl_api_assessment "clouditor.io/clouditor/api/assessment"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eclipse-xfsc/cam/api/common"
+	"github.com/eclipse-xfsc/cam/api/evaluation"
+	"github.com/eclipse-xfsc/cam/internal/testutil"
+	"github.com/eclipse-xfsc/cam/internal/tracing"
+)
+
+// TestServer_tracing follows the trace of a collection through the Evaluation Manager
+func TestServer_tracing(t *testing.T) {
+	recorder := testutil.RecordSpans(t)
+
+	srv := &Server{
+		storage:            testutil.NewInMemoryStorage(t),
+		requirementsSource: TestRequirementsSource,
+	}
+
+	ctx, collect := tracing.Start(context.Background(), "collection.Collect")
+	collect.End()
+
+	// The evidence reports an error, so that it is stored, but not assessed
+	evidence := &common.Evidence{
+		Id:            uuid.NewString(),
+		TargetService: testutil.DefaultServiceID,
+		ToolId:        testToolID,
+		GatheredAt:    timestamppb.Now(),
+		Error:         &common.Error{Description: "unavailable"},
+		TraceParent:   tracing.TraceParent(ctx),
+	}
+	assert.NoError(t, srv.handleEvidence(evidence))
+
+	// Invalid evidences are rejected in a trace of their own
+	assert.Error(t, srv.handleEvidence(&common.Evidence{}))
+
+	spans := testutil.FindSpans(recorder, "evaluation.ReceiveEvidence")
+	assert.Len(t, spans, 2)
+	assert.Equal(t, collect.SpanContext().TraceID(), spans[0].SpanContext().TraceID())
+	assert.Equal(t, collect.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	assert.Equal(t, evidence.Id, testutil.SpanAttribute(spans[0], "evidence.id").AsString())
+	assert.Equal(t, int64(1), testutil.SpanAttribute(spans[0], "evidence.sequence").AsInt64())
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	assert.NotEqual(t, collect.SpanContext().TraceID(), spans[1].SpanContext().TraceID())
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+
+	// The evaluation result continues the trace of the evidence
+	srv.createEvaluationResult(&cl_api_assessment.AssessmentResult{
+		Id:         uuid.NewString(),
+		MetricId:   "Metric1",
+		Compliant:  true,
+		EvidenceId: evidence.Id,
+		ServiceId:  testutil.DefaultServiceID,
+	}, nil)
+
+	spans = testutil.FindSpans(recorder, "evaluation.CreateEvaluationResult")
+	assert.Len(t, spans, 1)
+	assert.Equal(t, collect.SpanContext().SpanID(), spans[0].Parent().SpanID())
+
+	result, err := srv.GetEvaluation(context.Background(), &evaluation.GetEvaluationRequest{
+		ServiceId: testutil.DefaultServiceID,
+		MetricId:  "Metric1",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, spans[0].SpanContext().WithRemote(true), tracing.SpanContextFromTraceParent(result.TraceParent))
+
+	// The compliance calculation is part of the trace of the caller
+	ctx, trigger := tracing.Start(context.Background(), "configuration.TriggerComplianceCalculation")
+	compliance, err := srv.calculateComplianceInternal(ctx, testutil.DefaultServiceID, "Control1", "")
+	assert.NoError(t, err)
+	trigger.End()
+
+	spans = testutil.FindSpans(recorder, "evaluation.CalculateCompliance")
+	assert.Len(t, spans, 1)
+	assert.Equal(t, trigger.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	assert.Equal(t, statusCompliant, testutil.SpanAttribute(spans[0], "compliance.status").AsString())
+
+	// The trace of the evidence ends with its inclusion in the compliance result, which links to the calculation
+	included := testutil.FindSpans(recorder, "evaluation.IncludeInCompliance")
+	assert.Len(t, included, 1)
+	assert.Equal(t, collect.SpanContext().TraceID(), included[0].SpanContext().TraceID())
+	assert.Equal(t, compliance.Id, testutil.SpanAttribute(included[0], "compliance.id").AsString())
+	assert.Equal(t, []trace.SpanContext{spans[0].SpanContext()}, testutil.SpanLinks(included[0]))
+
+	// Recalculations do not include the evaluation result again
+	_, err = srv.calculateComplianceInternal(context.Background(), testutil.DefaultServiceID, "Control1", "")
+	assert.NoError(t, err)
+	assert.Len(t, testutil.FindSpans(recorder, "evaluation.CalculateCompliance"), 2)
+	assert.Len(t, testutil.FindSpans(recorder, "evaluation.IncludeInCompliance"), 1)
+}